@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/urfave/cli"
+)
+
+// AuditCommand contains the definition of the `audit` command-line
+// subcommand and its own subcommands.
+var AuditCommand cli.Command
+
+const auditVerifyDescription = `Reads the audit log of key generation and signing
+   operations stored in the data directory and verifies the chain of hashes
+   of its entries. The command exits with an error if any entry has been
+   modified, removed or reordered.`
+
+const auditExportDescription = `Verifies the audit log stored in the data
+   directory and exports its entries as a JSON array. Entries can be limited
+   to the given keep. If the output file is not provided, entries are written
+   to the standard output.`
+
+// auditLogDir is the name of the directory within the data directory where
+// the audit log is stored.
+const auditLogDir = "audit"
+
+// auditLogFile is the name of the audit log file.
+const auditLogFile = "audit.log"
+
+func init() {
+	AuditCommand = cli.Command{
+		Name:  "audit",
+		Usage: "Provides tools for the audit log of key and signature operations",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "verify",
+				Usage:       "Verifies integrity of the audit log",
+				Description: auditVerifyDescription,
+				Action:      VerifyAuditLog,
+			},
+			{
+				Name:        "export",
+				Usage:       "Exports entries of the audit log",
+				Description: auditExportDescription,
+				Action:      ExportAuditLog,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "keep",
+						Usage: "Address of the keep to export entries for",
+					},
+					cli.StringFlag{
+						Name:  "output-file,o",
+						Usage: "Output file for the exported entries",
+					},
+				},
+			},
+		},
+	}
+}
+
+// VerifyAuditLog verifies the chain of hashes of the audit log entries.
+func VerifyAuditLog(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	entries, err := audit.Read(auditLogPath(config))
+	if err != nil {
+		return fmt.Errorf("audit log verification failed: [%v]", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("audit log is empty")
+		return nil
+	}
+
+	lastEntry := entries[len(entries)-1]
+	fmt.Printf(
+		"verified [%d] entries of the audit log; "+
+			"last entry recorded at [%v] with hash [%s]\n",
+		len(entries),
+		lastEntry.Time,
+		lastEntry.Hash,
+	)
+
+	return nil
+}
+
+// ExportAuditLog verifies the audit log and exports its entries as JSON.
+func ExportAuditLog(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	entries, err := audit.Read(auditLogPath(config))
+	if err != nil {
+		return fmt.Errorf("audit log verification failed: [%v]", err)
+	}
+
+	if keep := c.String("keep"); len(keep) > 0 {
+		if !common.IsHexAddress(keep) {
+			return fmt.Errorf("invalid keep address [%s]", keep)
+		}
+		keepAddress := common.HexToAddress(keep)
+
+		keepEntries := make([]*audit.Entry, 0)
+		for _, entry := range entries {
+			if common.HexToAddress(entry.KeepAddress) == keepAddress {
+				keepEntries = append(keepEntries, entry)
+			}
+		}
+		entries = keepEntries
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not serialize entries: [%v]", err)
+	}
+
+	return outputData(c, append(data, '\n'), 0600)
+}
+
+// openAuditLog opens the audit log stored in a separate directory within the
+// data directory.
+func openAuditLog(config *config.Config) (*audit.Log, error) {
+	logDir := filepath.Join(config.Storage.DataDir, auditLogDir)
+
+	if err := os.MkdirAll(logDir, 0700); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create audit log directory [%v]: [%v]",
+			logDir,
+			err,
+		)
+	}
+
+	return audit.Open(auditLogPath(config))
+}
+
+func auditLogPath(config *config.Config) string {
+	return filepath.Join(config.Storage.DataDir, auditLogDir, auditLogFile)
+}
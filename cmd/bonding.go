@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/urfave/cli"
+)
+
+// BondingCommand contains the definition of the `bonding` command-line
+// subcommand and its own subcommands.
+var BondingCommand cli.Command
+
+const bondingStatusDescription = `Reports the unbonded value of the operator, the
+   part of it available for bonding in the sortition pool of each sanctioned
+   application, and the value bonded for each keep of the operator.
+
+   Keeps are read from the data directory unless they are provided with the
+   --keep flag. All values are reported in wei and ether.`
+
+const bondingDepositDescription = `Deposits the given value in wei from the
+   operator account as unbonded value of the operator. The value can be
+   deposited for another operator with the --operator flag.`
+
+const bondingWithdrawDescription = `Withdraws the given amount in wei of the
+   operator's unbonded value. Withdrawn value is transferred to the
+   beneficiary of the operator, not to the operator account. Only the operator
+   or the owner of the stake is allowed to withdraw.`
+
+// weiPerEther is the number of wei in one ether.
+var weiPerEther = big.NewFloat(1e18)
+
+func init() {
+	BondingCommand = cli.Command{
+		Name:  "bonding",
+		Usage: "Provides tools for managing ETH bonded by the operator",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "status",
+				Usage:       "Reports unbonded and bonded value of the operator",
+				Description: bondingStatusDescription,
+				Action:      BondingStatus,
+				Flags: []cli.Flag{
+					cli.StringSliceFlag{
+						Name:  "keep",
+						Usage: "Address of the keep to report the bond for",
+					},
+				},
+			},
+			{
+				Name:        "deposit",
+				Usage:       "Deposits unbonded value for the operator",
+				Description: bondingDepositDescription,
+				ArgsUsage:   "[value in wei]",
+				Action:      DepositBond,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "operator",
+						Usage: "Address of the operator to deposit for",
+					},
+				},
+			},
+			{
+				Name:        "withdraw",
+				Usage:       "Withdraws unbonded value of the operator",
+				Description: bondingWithdrawDescription,
+				ArgsUsage:   "[amount in wei]",
+				Action:      WithdrawBond,
+			},
+		},
+	}
+}
+
+// BondingStatus prints unbonded value of the operator available in sortition
+// pools and values bonded for the operator's keeps.
+func BondingStatus(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	applications, err := config.SanctionedApplications.Addresses()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get sanctioned applications addresses: [%v]",
+			err,
+		)
+	}
+
+	keeps, err := parseAddresses(c.StringSlice("keep"))
+	if err != nil {
+		return err
+	}
+
+	ethereumChain, err := connectEthereum(config)
+	if err != nil {
+		return err
+	}
+
+	if len(keeps) == 0 {
+		keepsPersistence, err := openKeepsPersistence(
+			config,
+			config.Storage.Backend,
+		)
+		if err != nil {
+			return fmt.Errorf("failed while opening keeps storage: [%v]", err)
+		}
+
+		keeps = keepsPersistence.loadRegistry().GetKeepsAddresses()
+		keepsPersistence.close()
+	}
+
+	operator := ethereumChain.Address()
+
+	unbondedValue, err := ethereumChain.UnbondedValue(operator)
+	if err != nil {
+		return fmt.Errorf("could not get unbonded value: [%v]", err)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(writer, "operator:\t%s\n", operator.Hex())
+	fmt.Fprintf(writer, "unbonded value:\t%s\n\n", formatWei(unbondedValue))
+
+	fmt.Fprintln(writer, "APPLICATION\tAVAILABLE FOR BONDING")
+	for _, application := range applications {
+		available, err := ethereumChain.AvailableUnbondedValue(
+			operator,
+			application,
+		)
+		if err != nil {
+			fmt.Fprintf(writer, "%s\terror: %v\n", application.Hex(), err)
+			continue
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\n", application.Hex(), formatWei(available))
+	}
+
+	totalBonded := big.NewInt(0)
+
+	fmt.Fprintln(writer, "\nKEEP\tBONDED")
+	for _, keep := range keeps {
+		bonded, err := ethereumChain.BondAmount(operator, keep)
+		if err != nil {
+			fmt.Fprintf(writer, "%s\terror: %v\n", keep.Hex(), err)
+			continue
+		}
+
+		totalBonded.Add(totalBonded, bonded)
+		fmt.Fprintf(writer, "%s\t%s\n", keep.Hex(), formatWei(bonded))
+	}
+
+	fmt.Fprintf(writer, "\ntotal bonded:\t%s\n", formatWei(totalBonded))
+
+	return writer.Flush()
+}
+
+// DepositBond deposits unbonded value for the operator.
+func DepositBond(c *cli.Context) error {
+	value, err := parseWei(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	ethereumChain, err := connectEthereum(config)
+	if err != nil {
+		return err
+	}
+
+	operator := ethereumChain.Address()
+	if operatorFlag := c.String("operator"); len(operatorFlag) > 0 {
+		if !common.IsHexAddress(operatorFlag) {
+			return fmt.Errorf("invalid operator address [%s]", operatorFlag)
+		}
+		operator = common.HexToAddress(operatorFlag)
+	}
+
+	transactionHash, err := ethereumChain.DepositUnbondedValue(operator, value)
+	if err != nil {
+		return fmt.Errorf("could not deposit unbonded value: [%v]", err)
+	}
+
+	fmt.Printf(
+		"submitted deposit of [%s] for operator [%s] in transaction [%s]\n",
+		formatWei(value),
+		operator.Hex(),
+		transactionHash.Hex(),
+	)
+
+	return nil
+}
+
+// WithdrawBond withdraws unbonded value of the operator to the operator's
+// beneficiary.
+func WithdrawBond(c *cli.Context) error {
+	amount, err := parseWei(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	ethereumChain, err := connectEthereum(config)
+	if err != nil {
+		return err
+	}
+
+	operator := ethereumChain.Address()
+
+	unbondedValue, err := ethereumChain.UnbondedValue(operator)
+	if err != nil {
+		return fmt.Errorf("could not get unbonded value: [%v]", err)
+	}
+
+	if unbondedValue.Cmp(amount) < 0 {
+		return fmt.Errorf(
+			"amount [%s] exceeds unbonded value [%s]",
+			formatWei(amount),
+			formatWei(unbondedValue),
+		)
+	}
+
+	transactionHash, err := ethereumChain.WithdrawUnbondedValue(operator, amount)
+	if err != nil {
+		return fmt.Errorf("could not withdraw unbonded value: [%v]", err)
+	}
+
+	fmt.Printf(
+		"submitted withdrawal of [%s] for operator [%s] in transaction [%s]\n",
+		formatWei(amount),
+		operator.Hex(),
+		transactionHash.Hex(),
+	)
+
+	return nil
+}
+
+func parseWei(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("value in wei is required")
+	}
+
+	wei, ok := new(big.Int).SetString(value, 10)
+	if !ok || wei.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid value in wei [%s]", value)
+	}
+
+	return wei, nil
+}
+
+func parseAddresses(values []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(values))
+	for _, value := range values {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address [%s]", value)
+		}
+		addresses = append(addresses, common.HexToAddress(value))
+	}
+
+	return addresses, nil
+}
+
+func formatWei(wei *big.Int) string {
+	ether := new(big.Float).Quo(new(big.Float).SetInt(wei), weiPerEther)
+	return fmt.Sprintf("%s wei (%s ETH)", wei.String(), ether.Text('f', 6))
+}
@@ -0,0 +1,340 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
+	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/urfave/cli"
+)
+
+// KeepsCommand contains the definition of the `keeps` command-line
+// subcommand and its own subcommands.
+var KeepsCommand cli.Command
+
+const verifyDescription = `Loads all key shares stored in the data directory
+   and compares them with keeps on-chain. For each key share it checks whether
+   its public key matches the public key published by the keep and whether the
+   operator is a member of the keep. It also looks for active keeps the operator
+   is a member of which have a public key published but no key share stored.
+
+   The command exits with an error if any issue has been found.`
+
+const backupDescription = `Encrypts key shares of all keeps stored in the data
+   directory to the recipient public key using ECIES over secp256k1 and writes
+   them into a single versioned backup archive.
+
+   The recipient public key is expected as a hexadecimal string of compressed
+   or uncompressed secp256k1 public key. Only the owner of the corresponding
+   private key is able to restore the backup.`
+
+const restoreDescription = `Decrypts the backup archive using the recipient key,
+   validates all key shares it contains and imports them into the data
+   directory. The data directory must not contain any key shares.
+
+   The recipient key is expected to be provided in an Ethereum key file
+   encrypted with a password. The password is read only from the
+   ` + backupKeyPasswordEnvVariable + ` environment variable; the command fails
+   if the variable is not set.`
+
+// backupKeyPasswordEnvVariable is the name of the environment variable with
+// the password of the key file used to decrypt the backup archive.
+const backupKeyPasswordEnvVariable = "KEEP_BACKUP_KEY_PASSWORD"
+
+func init() {
+	KeepsCommand = cli.Command{
+		Name:  "keeps",
+		Usage: "Provides tools for managing keeps of the operator",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "verify",
+				Usage:       "Verifies stored key shares against keeps on-chain",
+				Description: verifyDescription,
+				Action:      VerifyKeeps,
+				Flags: []cli.Flag{
+					cli.DurationFlag{
+						Name: "lookback",
+						Usage: "Look-back period for keeps checked for missing " +
+							"key shares; all keeps are checked if not set",
+					},
+				},
+			},
+			{
+				Name:        "backup",
+				Usage:       "Creates an encrypted backup of stored key shares",
+				Description: backupDescription,
+				Action:      BackupKeeps,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "recipient,r",
+						Usage: "Public key the backup is encrypted to",
+					},
+					cli.StringFlag{
+						Name:  "output-file,o",
+						Usage: "Output file for the backup archive",
+					},
+				},
+			},
+			{
+				Name:        "restore",
+				Usage:       "Restores key shares from an encrypted backup",
+				Description: restoreDescription,
+				Action:      RestoreKeeps,
+				ArgsUsage:   "[backup-file]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name: "recipient-key-file,k",
+						Usage: "Path to the ethereum key file of the recipient; " +
+							"the key file password is read from the " +
+							backupKeyPasswordEnvVariable + " environment variable",
+					},
+				},
+			},
+		},
+	}
+}
+
+// VerifyKeeps verifies key shares stored by the operator against keeps
+// on-chain and prints all found issues.
+func VerifyKeeps(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	ethereumChain, err := connectEthereum(config)
+	if err != nil {
+		return err
+	}
+
+	keepsPersistence, err := openKeepsPersistence(config, config.Storage.Backend)
+	if err != nil {
+		return fmt.Errorf("failed while opening keeps storage: [%v]", err)
+	}
+	defer keepsPersistence.close()
+
+	keepsRegistry := keepsPersistence.loadRegistry()
+
+	fmt.Printf(
+		"verifying [%d] stored key shares\n",
+		len(keepsRegistry.GetKeepsAddresses()),
+	)
+
+	issues, err := client.VerifyKeyShares(
+		ethereumChain,
+		keepsRegistry,
+		c.Duration("lookback"),
+	)
+	if err != nil {
+		return fmt.Errorf("could not verify key shares: [%v]", err)
+	}
+
+	for _, issue := range issues {
+		fmt.Println(issue.String())
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("found [%d] key share issues", len(issues))
+	}
+
+	fmt.Println("all stored key shares match keeps on-chain")
+
+	return nil
+}
+
+// BackupKeeps encrypts key shares stored by the operator to the recipient
+// public key and writes them into a backup archive.
+func BackupKeeps(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	recipient, err := parsePublicKey(c.String("recipient"))
+	if err != nil {
+		return fmt.Errorf("invalid recipient public key: [%v]", err)
+	}
+
+	outputFilePath := c.String("output-file")
+	if len(outputFilePath) == 0 {
+		return fmt.Errorf("output file is required")
+	}
+
+	if _, err := os.Stat(outputFilePath); !os.IsNotExist(err) {
+		return fmt.Errorf(
+			"could not write backup to a file; file [%s] already exists",
+			outputFilePath,
+		)
+	}
+
+	keepsPersistence, err := openKeepsPersistence(config, config.Storage.Backend)
+	if err != nil {
+		return fmt.Errorf("failed while opening keeps storage: [%v]", err)
+	}
+	defer keepsPersistence.close()
+
+	archive, summary, err := registry.CreateBackup(
+		keepsPersistence.signers,
+		recipient,
+	)
+	if err != nil {
+		return fmt.Errorf("could not create backup: [%v]", err)
+	}
+
+	if err := ioutil.WriteFile(outputFilePath, archive, 0600); err != nil {
+		return fmt.Errorf(
+			"failed to write backup to file [%s]: [%v]",
+			outputFilePath,
+			err,
+		)
+	}
+
+	fmt.Printf(
+		"backup of [%d] key shares of [%d] keeps written to file: %s\n",
+		summary.Memberships,
+		len(summary.Keeps),
+		outputFilePath,
+	)
+
+	return nil
+}
+
+// RestoreKeeps decrypts the backup archive with the recipient key and imports
+// key shares it contains into the data directory.
+func RestoreKeeps(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	backupFilePath := c.Args().First()
+	if len(backupFilePath) == 0 {
+		return fmt.Errorf("backup file is required")
+	}
+
+	recipientKeyFilePath := c.String("recipient-key-file")
+	if len(recipientKeyFilePath) == 0 {
+		return fmt.Errorf("recipient key file is required")
+	}
+
+	recipientKeyPassword, ok := os.LookupEnv(backupKeyPasswordEnvVariable)
+	if !ok || len(recipientKeyPassword) == 0 {
+		return fmt.Errorf(
+			"password of the recipient key file is required; "+
+				"please set the [%s] environment variable",
+			backupKeyPasswordEnvVariable,
+		)
+	}
+
+	recipientKey, err := ethutil.DecryptKeyFile(
+		recipientKeyFilePath,
+		recipientKeyPassword,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to read key file [%s]: [%v]",
+			recipientKeyFilePath,
+			err,
+		)
+	}
+
+	archive, err := ioutil.ReadFile(backupFilePath)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to read backup file [%s]: [%v]",
+			backupFilePath,
+			err,
+		)
+	}
+
+	keepsPersistence, err := openKeepsPersistence(config, config.Storage.Backend)
+	if err != nil {
+		return fmt.Errorf("failed while opening keeps storage: [%v]", err)
+	}
+	defer keepsPersistence.close()
+
+	summary, err := registry.RestoreBackup(
+		archive,
+		recipientKey.PrivateKey,
+		keepsPersistence.signers,
+	)
+	if err != nil {
+		return fmt.Errorf("could not restore backup: [%v]", err)
+	}
+
+	for _, keepAddress := range summary.Keeps {
+		fmt.Printf("restored key shares of keep [%s]\n", keepAddress.String())
+	}
+
+	fmt.Printf(
+		"restored [%d] key shares of [%d] keeps from backup created at [%v]\n",
+		summary.Memberships,
+		len(summary.Keeps),
+		summary.CreatedAt,
+	)
+
+	return nil
+}
+
+// parsePublicKey parses hexadecimal representation of compressed or
+// uncompressed secp256k1 public key.
+func parsePublicKey(publicKeyHex string) (*ecdsa.PublicKey, error) {
+	publicKeyBytes, err := hex.DecodeString(strings.TrimPrefix(publicKeyHex, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(publicKeyBytes) == 33 {
+		return crypto.DecompressPubkey(publicKeyBytes)
+	}
+
+	return crypto.UnmarshalPubkey(publicKeyBytes)
+}
+
+// connectEthereum decrypts the operator key and connects to the Ethereum
+// chain using the provided config.
+func connectEthereum(config *config.Config) (*ethereum.EthereumChain, error) {
+	ethereumKey, err := ethutil.DecryptKeyFile(
+		config.Ethereum.Account.KeyFile,
+		config.Ethereum.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read key file [%s]: [%v]",
+			config.Ethereum.Account.KeyFile,
+			err,
+		)
+	}
+
+	ethereumChain, err := ethereum.Connect(
+		ethereumKey,
+		&config.Ethereum,
+		config.Backoff.GetChainCallsPolicy(),
+		config.Backoff.GetChainCallsMaxAttempts(),
+		config.Backoff.GetSubscriptionsPolicy(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to ethereum node: [%v]", err)
+	}
+
+	if err := setKeepFactoryTypes(ethereumChain, config); err != nil {
+		return nil, err
+	}
+
+	return ethereumChain, nil
+}
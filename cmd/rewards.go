@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/rewards"
+	"github.com/urfave/cli"
+)
+
+// RewardsCommand contains the definition of the `rewards` command-line
+// subcommand and its own subcommands.
+var RewardsCommand cli.Command
+
+const rewardsListDescription = `Lists KEEP rewards allocated to the beneficiary
+   of the operator, already withdrawn and still withdrawable in each rewards
+   interval of the ECDSARewards contract, up to the current interval. Rewards
+   of an interval are allocated once it is finished and a keep created in it
+   receives its reward.`
+
+const rewardsWithdrawDescription = `Withdraws KEEP rewards of the operator from
+   all finished rewards intervals with withdrawable rewards. A separate
+   transaction is submitted for each interval. Withdrawn rewards are
+   transferred to the beneficiary of the operator, not to the operator
+   account.`
+
+// keepPerToken is the number of the smallest KEEP units in one KEEP.
+var keepPerToken = big.NewFloat(1e18)
+
+func init() {
+	RewardsCommand = cli.Command{
+		Name:  "rewards",
+		Usage: "Provides tools for KEEP rewards of the operator",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "list",
+				Usage:       "Lists rewards of the operator per interval",
+				Description: rewardsListDescription,
+				Action:      ListRewards,
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print rewards intervals as a JSON array",
+					},
+				},
+			},
+			{
+				Name:        "withdraw",
+				Usage:       "Withdraws rewards of the operator from all intervals",
+				Description: rewardsWithdrawDescription,
+				Action:      WithdrawRewards,
+			},
+		},
+	}
+}
+
+// ListRewards prints rewards of the operator in each rewards interval.
+func ListRewards(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	ethereumChain, err := connectEthereum(config)
+	if err != nil {
+		return err
+	}
+
+	intervals, err := rewards.Intervals(ethereumChain, ethereumChain.Address())
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		data, err := json.MarshalIndent(intervals, "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not serialize rewards intervals: [%v]", err)
+		}
+
+		_, err = os.Stdout.Write(append(data, '\n'))
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "INTERVAL\tFINISHED\tALLOCATED\tWITHDRAWN\tWITHDRAWABLE")
+
+	totalWithdrawable := big.NewInt(0)
+	for _, interval := range intervals {
+		totalWithdrawable.Add(totalWithdrawable, interval.Withdrawable)
+
+		fmt.Fprintf(
+			writer,
+			"%d\t%t\t%s\t%s\t%s\n",
+			interval.Number,
+			interval.Finished,
+			formatKEEP(interval.Allocated),
+			formatKEEP(interval.Withdrawn),
+			formatKEEP(interval.Withdrawable),
+		)
+	}
+
+	fmt.Fprintf(writer, "\ntotal withdrawable:\t%s\n", formatKEEP(totalWithdrawable))
+
+	return writer.Flush()
+}
+
+// WithdrawRewards withdraws rewards of the operator from all finished rewards
+// intervals to the operator's beneficiary.
+func WithdrawRewards(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	ethereumChain, err := connectEthereum(config)
+	if err != nil {
+		return err
+	}
+
+	operator := ethereumChain.Address()
+
+	withdrawals, err := rewards.WithdrawAll(ethereumChain, operator)
+	for _, withdrawal := range withdrawals {
+		fmt.Printf(
+			"submitted withdrawal of [%s] for interval [%d] of operator [%s] "+
+				"in transaction [%s]\n",
+			formatKEEP(withdrawal.Amount),
+			withdrawal.Interval,
+			operator.Hex(),
+			withdrawal.TransactionHash.Hex(),
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(withdrawals) == 0 {
+		fmt.Println("no rewards to withdraw")
+	}
+
+	return nil
+}
+
+func formatKEEP(amount *big.Int) string {
+	tokens := new(big.Float).Quo(new(big.Float).SetInt(amount), keepPerToken)
+	return fmt.Sprintf("%s KEEP", tokens.Text('f', 6))
+}
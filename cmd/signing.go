@@ -20,9 +20,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-ecdsa/internal/config"
-	"github.com/keep-network/keep-ecdsa/pkg/registry"
 	"github.com/urfave/cli"
 )
 
@@ -77,22 +75,13 @@ func DecryptKeyShare(c *cli.Context) error {
 
 	keepAddress := common.HexToAddress(keepAddressHex)
 
-	handle, err := persistence.NewDiskHandle(config.Storage.DataDir)
+	keepsPersistence, err := openKeepsPersistence(config, config.Storage.Backend)
 	if err != nil {
-		return fmt.Errorf(
-			"failed while creating a storage disk handler: [%v]",
-			err,
-		)
+		return fmt.Errorf("failed while opening keeps storage: [%v]", err)
 	}
+	defer keepsPersistence.close()
 
-	persistence := persistence.NewEncryptedPersistence(
-		handle,
-		config.Ethereum.Account.KeyFilePassword,
-	)
-
-	keepRegistry := registry.NewKeepsRegistry(persistence)
-
-	keepRegistry.LoadExistingKeeps()
+	keepRegistry := keepsPersistence.loadRegistry()
 
 	signer, err := keepRegistry.GetSigner(keepAddress)
 	if err != nil {
@@ -219,8 +208,17 @@ func SignDigest(c *cli.Context) error {
 	close(signingOutcomesChannel)
 
 	signatures := make(map[string]int)
+	signingSignersCount := 0
 
 	for signingOutcome := range signingOutcomesChannel {
+		// When more key shares than required by the threshold are provided,
+		// only some of the signers calculate the signature.
+		if signingOutcome.err == tss.ErrNotInSigningGroup {
+			continue
+		}
+
+		signingSignersCount++
+
 		if signingOutcome.err != nil {
 			_, _ = fmt.Fprintf(
 				os.Stderr,
@@ -246,9 +244,9 @@ func SignDigest(c *cli.Context) error {
 	}
 
 	for signature, signersCount := range signatures {
-		if signersCount != len(signers) {
+		if signersCount != signingSignersCount {
 			return fmt.Errorf(
-				"signing failed; all signers should support the signature",
+				"signing failed; all signing signers should support the signature",
 			)
 		}
 
@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/keep-network/keep-ecdsa/pkg/metrics"
@@ -14,6 +19,8 @@ import (
 	coreMetrics "github.com/keep-network/keep-core/pkg/metrics"
 	"github.com/keep-network/keep-core/pkg/net"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ipfs/go-log"
 
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
@@ -25,10 +32,15 @@ import (
 	"github.com/keep-network/keep-core/pkg/operator"
 
 	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/admin"
+	"github.com/keep-network/keep-ecdsa/pkg/backoff"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
 	"github.com/keep-network/keep-ecdsa/pkg/firewall"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/rewards"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
 
 	"github.com/urfave/cli"
 )
@@ -82,6 +94,24 @@ const (
 	defaultBalanceMonitoringTick = 10 * time.Minute
 )
 
+// signingJournalDir is the name of the directory within the data directory
+// where the journal of signing requests is stored. It must not be placed in
+// the directory of the keeps registry which expects only keeps' signers.
+const signingJournalDir = "signing_journal"
+
+// signerSnapshotsDir is the name of the directory within the data directory
+// where snapshots of signers are stored. Snapshots are kept apart from the
+// keeps registry so signers which were not registered can be recovered.
+const signerSnapshotsDir = "signer_snapshots"
+
+// blockCheckpointFile is the name of the file within the data directory where
+// the last block whose events have been processed by the client is stored.
+const blockCheckpointFile = "block_checkpoint"
+
+// transactionJournalDir is the name of the directory within the data directory
+// where the journal of submitted transactions is stored.
+const transactionJournalDir = "transaction_journal"
+
 func init() {
 	StartCommand =
 		cli.Command{
@@ -92,48 +122,181 @@ func init() {
 		}
 }
 
-// Start starts a client.
+// Start starts a client. A separate client instance is started for each
+// operator configured. Operators share the Ethereum connection and the
+// metrics server but have their own network identity, data directory and
+// pool of TSS pre-parameters.
 func Start(c *cli.Context) error {
 	config, err := config.ReadConfig(c.GlobalString("config"))
 	if err != nil {
 		return fmt.Errorf("failed while reading config file: [%v]", err)
 	}
 
-	ctx := context.Background()
+	if err := config.Client.SigningPolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid signing policy configuration: [%v]", err)
+	}
 
-	ethereumKey, err := ethutil.DecryptKeyFile(
-		config.Ethereum.Account.KeyFile,
-		config.Ethereum.Account.KeyFilePassword,
-	)
+	operatorConfigs, err := config.OperatorConfigs()
 	if err != nil {
-		return fmt.Errorf(
-			"failed to read key file [%s]: [%v]",
-			config.Ethereum.Account.KeyFile,
-			err,
-		)
+		return fmt.Errorf("invalid operators configuration: [%v]", err)
 	}
 
-	ethereumChain, err := ethereum.Connect(ethereumKey, &config.Ethereum)
+	sanctionedApplications, err := config.SanctionedApplications.Addresses()
 	if err != nil {
-		return fmt.Errorf("failed to connect to ethereum node: [%v]", err)
+		return fmt.Errorf("failed to get sanctioned applications addresses: [%v]", err)
 	}
 
+	ctx, cancelCtx := withShutdownSignals(context.Background())
+	defer cancelCtx()
+
+	// Network has to keep operating while in-flight key generation and signing
+	// are completing on shutdown, so it is not bound to the root context.
+	networkCtx, cancelNetworkCtx := context.WithCancel(context.Background())
+	defer cancelNetworkCtx()
+
+	var ethereumConnection *ethereum.EthereumChain
+	operators := make([]*operatorInstance, 0, len(operatorConfigs))
+
+	defer func() {
+		for _, operator := range operators {
+			operator.close()
+		}
+	}()
+
+	for _, operatorConfig := range operatorConfigs {
+		ethereumKey, err := ethutil.DecryptKeyFile(
+			operatorConfig.Ethereum.Account.KeyFile,
+			operatorConfig.Ethereum.Account.KeyFilePassword,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to read key file [%s]: [%v]",
+				operatorConfig.Ethereum.Account.KeyFile,
+				err,
+			)
+		}
+
+		var ethereumChain *ethereum.EthereumChain
+		if ethereumConnection == nil {
+			ethereumChain, err = ethereum.Connect(
+				ethereumKey,
+				&config.Ethereum,
+				config.Backoff.GetChainCallsPolicy(),
+				config.Backoff.GetChainCallsMaxAttempts(),
+				config.Backoff.GetSubscriptionsPolicy(),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to connect to ethereum node: [%v]", err)
+			}
+			if err := setKeepFactoryTypes(ethereumChain, config); err != nil {
+				return err
+			}
+			ethereumConnection = ethereumChain
+		} else {
+			ethereumChain, err = ethereumConnection.WithAccount(ethereumKey)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to initialize ethereum handle for operator [%s]: [%v]",
+					ethereumKey.Address.String(),
+					err,
+				)
+			}
+		}
+
+		operator, err := startOperator(
+			ctx,
+			networkCtx,
+			operatorConfig,
+			ethereumKey,
+			ethereumChain,
+			sanctionedApplications,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to start operator [%s]: [%v]",
+				ethereumKey.Address.String(),
+				err,
+			)
+		}
+
+		operators = append(operators, operator)
+	}
+
+	// Metrics and diagnostics servers are shared by all operators. Client
+	// metrics are summed up for all operators while network and Ethereum
+	// connectivity metrics are reported for the first operator.
+	clientHandles := make([]*client.Handle, len(operators))
+	for i, operator := range operators {
+		clientHandles[i] = operator.clientHandle
+	}
+
+	initializeMetrics(
+		ctx,
+		config,
+		operators[0].networkProvider,
+		operators[0].stakeMonitor,
+		operators[0].address.Hex(),
+		ethereumConnection,
+		clientHandles,
+	)
+	initializeDiagnostics(config, operators[0].networkProvider)
+
+	logger.Info("client started")
+
+	<-ctx.Done()
+
+	var wg sync.WaitGroup
+	for _, operator := range operators {
+		wg.Add(1)
+		go func(clientHandle *client.Handle) {
+			defer wg.Done()
+			clientHandle.Stop()
+		}(operator.clientHandle)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// operatorInstance is a client started for a single operator account.
+type operatorInstance struct {
+	address         common.Address
+	clientHandle    *client.Handle
+	networkProvider net.Provider
+	stakeMonitor    chain.StakeMonitor
+
+	// close releases resources of the operator once the client is stopped.
+	close func()
+}
+
+// startOperator starts a client for the operator account using the operator's
+// configuration and a handle of the Ethereum connection bound to the operator
+// account.
+func startOperator(
+	ctx context.Context,
+	networkCtx context.Context,
+	config *config.Config,
+	ethereumKey *keystore.Key,
+	ethereumChain *ethereum.EthereumChain,
+	sanctionedApplications []common.Address,
+) (*operatorInstance, error) {
 	stakeMonitor, err := ethereumChain.StakeMonitor()
 	if err != nil {
-		return fmt.Errorf("error obtaining stake monitor handle: [%v]", err)
+		return nil, fmt.Errorf("error obtaining stake monitor handle: [%v]", err)
 	}
 	hasMinimumStake, err := stakeMonitor.HasMinimumStake(
 		ethereumKey.Address.Hex(),
 	)
 	if err != nil {
-		return fmt.Errorf("could not check the stake: [%v]", err)
+		return nil, fmt.Errorf("could not check the stake: [%v]", err)
 	}
 	if !hasMinimumStake {
 		logger.Errorf(
-			"no minimum KEEP stake or operator is not authorized to use it; " +
-				"please make sure the operator address in the configuration " +
-				"is correct and it has KEEP tokens delegated and the operator " +
+			"no minimum KEEP stake or operator [%s] is not authorized to use it; "+
+				"please make sure the operator address in the configuration "+
+				"is correct and it has KEEP tokens delegated and the operator "+
 				"contract has been authorized to operate on the stake",
+			ethereumKey.Address.String(),
 		)
 	}
 
@@ -144,67 +307,215 @@ func Start(c *cli.Context) error {
 	)
 
 	networkProvider, err := libp2p.Connect(
-		ctx,
+		networkCtx,
 		config.LibP2P,
 		networkPrivateKey,
 		libp2p.ProtocolECDSA,
 		firewall.NewStakeOrActiveKeepPolicy(ethereumChain, stakeMonitor),
-		retransmission.NewTimeTicker(ctx, 1*time.Second),
+		retransmission.NewTimeTicker(networkCtx, 1*time.Second),
 		libp2p.WithRoutingTableRefreshPeriod(routingTableRefreshPeriod),
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	nodeHeader(networkProvider.ConnectionManager().AddrStrings(), config.LibP2P.Port)
 
-	handle, err := persistence.NewDiskHandle(config.Storage.DataDir)
+	keepsPersistence, err := openKeepsPersistence(config, config.Storage.Backend)
 	if err != nil {
-		return fmt.Errorf("failed while creating a storage disk handler: [%v]", err)
+		return nil, fmt.Errorf("failed while opening keeps storage: [%v]", err)
 	}
-	persistence := persistence.NewEncryptedPersistence(
-		handle,
-		config.Ethereum.Account.KeyFilePassword,
-	)
 
-	sanctionedApplications, err := config.SanctionedApplications.Addresses()
+	// Snapshots made by previous versions of the client are stored next to
+	// signers. They are copied to the snapshots storage, so signers can be
+	// recovered from them on initialization.
+	if err := keepsPersistence.migrateLegacySnapshots(); err != nil {
+		logger.Errorf("failed to migrate legacy snapshots of signers: [%v]", err)
+	}
+
+	signingJournal, err := newSigningJournal(config)
 	if err != nil {
-		return fmt.Errorf("failed to get sanctioned applications addresses: [%v]", err)
+		keepsPersistence.close()
+		return nil, fmt.Errorf("failed while creating a signing journal: [%v]", err)
+	}
+
+	auditLog, err := openAuditLog(config)
+	if err != nil {
+		keepsPersistence.close()
+		return nil, fmt.Errorf("failed while opening audit log: [%v]", err)
 	}
 
+	transactionJournal, err := newTransactionJournal(config)
+	if err != nil {
+		auditLog.Close()
+		keepsPersistence.close()
+		return nil, fmt.Errorf(
+			"failed while creating a transaction journal: [%v]",
+			err,
+		)
+	}
+	transactionJournal.Load()
+	ethereumChain.EnableTransactionJournal(ctx, transactionJournal)
+
 	clientHandle := client.Initialize(
 		ctx,
 		operatorPublicKey,
 		ethereumChain,
 		networkProvider,
-		persistence,
+		keepsPersistence.storage(),
+		signingJournal,
+		client.NewBlockCheckpoint(
+			filepath.Join(config.Storage.DataDir, blockCheckpointFile),
+		),
 		sanctionedApplications,
 		&config.Client,
 		&config.TSS,
+		&node.RetryPolicies{
+			KeyGeneration: config.Backoff.GetKeyGenerationPolicy(),
+			Signing:       config.Backoff.GetSigningPolicy(),
+			Publication:   config.Backoff.GetPublicationPolicy(),
+			ChainCalls:    config.Backoff.GetChainCallsPolicy(),
+		},
+		auditLog,
 	)
 	logger.Debugf("initialized operator with address: [%s]", ethereumKey.Address.String())
 
-	initializeExtensions(ctx, config.Extensions, ethereumChain)
-	initializeMetrics(ctx, config, networkProvider, stakeMonitor, ethereumKey.Address.Hex(), clientHandle)
-	initializeDiagnostics(config, networkProvider)
+	initializeExtensions(
+		ctx,
+		config.Extensions,
+		ethereumChain,
+		config.Client.GetBlockConfirmations(),
+		config.Backoff.GetTBTCPolicy(),
+	)
+	initializeAdmin(ctx, config, clientHandle, transactionJournal)
 	initializeBalanceMonitoring(ctx, ethereumChain, config, ethereumKey.Address.Hex())
+	initializeRewardsAutoClaim(ctx, ethereumChain, config)
+
+	return &operatorInstance{
+		address:         ethereumKey.Address,
+		clientHandle:    clientHandle,
+		networkProvider: networkProvider,
+		stakeMonitor:    stakeMonitor,
+		close: func() {
+			auditLog.Close()
+			keepsPersistence.close()
+		},
+	}, nil
+}
 
-	logger.Info("client started")
+// newSigningJournal creates a journal of signing requests stored in a separate
+// directory within the data directory. Entries are encrypted the same way as
+// the keeps' key shares.
+func newSigningJournal(config *config.Config) (*signing.Journal, error) {
+	journalDir := filepath.Join(config.Storage.DataDir, signingJournalDir)
 
-	select {
-	case <-ctx.Done():
-		if err != nil {
-			return err
-		}
+	if err := os.MkdirAll(journalDir, 0700); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create journal directory [%v]: [%v]",
+			journalDir,
+			err,
+		)
+	}
+
+	handle, err := persistence.NewDiskHandle(journalDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed while creating a journal disk handler: [%v]", err)
+	}
+
+	return signing.NewJournal(
+		persistence.NewEncryptedPersistence(
+			handle,
+			config.StoragePassphrase(),
+		),
+	), nil
+}
+
+// newTransactionJournal creates a journal of transactions submitted by the
+// operator stored in a separate directory within the data directory.
+// Transactions contain no secrets so they are stored unencrypted and can be
+// read without the storage passphrase.
+func newTransactionJournal(
+	config *config.Config,
+) (*ethereum.TransactionJournal, error) {
+	journalDir := filepath.Join(config.Storage.DataDir, transactionJournalDir)
+
+	if err := os.MkdirAll(journalDir, 0700); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create transaction journal directory [%v]: [%v]",
+			journalDir,
+			err,
+		)
+	}
 
-		return fmt.Errorf("unexpected context cancellation")
+	handle, err := persistence.NewDiskHandle(journalDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed while creating a transaction journal disk handler: [%v]",
+			err,
+		)
 	}
+
+	return ethereum.NewTransactionJournal(handle), nil
+}
+
+// newSnapshotPersistence creates a persistence handle for snapshots of signers
+// stored in a separate directory within the data directory. Snapshots are
+// encrypted the same way as the keeps' key shares.
+func newSnapshotPersistence(config *config.Config) (persistence.Handle, error) {
+	snapshotsDir := filepath.Join(config.Storage.DataDir, signerSnapshotsDir)
+
+	if err := os.MkdirAll(snapshotsDir, 0700); err != nil {
+		return nil, fmt.Errorf(
+			"failed to create snapshots directory [%v]: [%v]",
+			snapshotsDir,
+			err,
+		)
+	}
+
+	handle, err := persistence.NewDiskHandle(snapshotsDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed while creating a snapshots disk handler: [%v]",
+			err,
+		)
+	}
+
+	return persistence.NewEncryptedPersistence(
+		handle,
+		config.StoragePassphrase(),
+	), nil
+}
+
+// withShutdownSignals returns a copy of the parent context which is cancelled
+// when the process receives an interrupt or termination signal.
+func withShutdownSignals(
+	parentCtx context.Context,
+) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parentCtx)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		defer signal.Stop(signals)
+
+		select {
+		case receivedSignal := <-signals:
+			logger.Infof("received [%v] signal; shutting down", receivedSignal)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
 }
 
 func initializeExtensions(
 	ctx context.Context,
 	config config.Extensions,
 	ethereumChain *ethereum.EthereumChain,
+	blockConfirmations uint64,
+	retryPolicy backoff.Policy,
 ) {
 	if len(config.TBTC.TBTCSystem) > 0 {
 		tbtcEthereumChain, err := ethereum.WithTBTCExtension(
@@ -219,7 +530,12 @@ func initializeExtensions(
 			return
 		}
 
-		err = tbtc.Initialize(ctx, tbtcEthereumChain)
+		err = tbtc.Initialize(
+			ctx,
+			tbtcEthereumChain,
+			blockConfirmations,
+			retryPolicy,
+		)
 		if err != nil {
 			logger.Errorf(
 				"could not initialize tbtc extension: [%v]",
@@ -236,7 +552,8 @@ func initializeMetrics(
 	netProvider net.Provider,
 	stakeMonitor chain.StakeMonitor,
 	ethereumAddres string,
-	clientHandle *client.Handle,
+	ethereumChain *ethereum.EthereumChain,
+	clientHandles []*client.Handle,
 ) {
 	registry, isConfigured := coreMetrics.Initialize(
 		config.Metrics.Port,
@@ -277,7 +594,42 @@ func initializeMetrics(
 	metrics.ObserveTSSPreParamsPoolSize(
 		ctx,
 		registry,
-		clientHandle,
+		clientHandles,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveActiveKeeps(
+		ctx,
+		registry,
+		clientHandles,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObservePendingSignatures(
+		ctx,
+		registry,
+		clientHandles,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveProtocolMetrics(
+		ctx,
+		registry,
+		clientHandles,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveSigningPolicyMetrics(
+		ctx,
+		registry,
+		clientHandles,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveEventSubscriptions(
+		ctx,
+		registry,
+		ethereumChain,
 		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
 	)
 }
@@ -303,6 +655,96 @@ func initializeDiagnostics(
 	diagnostics.RegisterClientInfoSource(registry, netProvider)
 }
 
+func initializeAdmin(
+	ctx context.Context,
+	config *config.Config,
+	clientHandle *client.Handle,
+	transactionJournal *ethereum.TransactionJournal,
+) {
+	isConfigured, err := admin.Initialize(
+		ctx,
+		config.Admin.Host,
+		config.Admin.Port,
+		clientHandle,
+		transactionJournal,
+	)
+	if err != nil {
+		logger.Errorf("could not start admin endpoint: [%v]", err)
+		return
+	}
+	if !isConfigured {
+		logger.Infof("admin endpoint is not configured")
+		return
+	}
+
+	logger.Infof(
+		"enabled admin endpoint on port [%v]",
+		config.Admin.Port,
+	)
+}
+
+// setKeepFactoryTypes configures the keep factory type of each application
+// listed in the sanctioned applications' factory types. Factory types are
+// shared by all accounts operating on the Ethereum connection.
+func setKeepFactoryTypes(
+	ethereumChain *ethereum.EthereumChain,
+	config *config.Config,
+) error {
+	factoryTypes, err := config.SanctionedApplications.FactoryTypes()
+	if err != nil {
+		return fmt.Errorf("failed to get applications factory types: [%v]", err)
+	}
+
+	for application, factoryTypeName := range factoryTypes {
+		factoryType, err := ethereum.ParseKeepFactoryType(factoryTypeName)
+		if err != nil {
+			return fmt.Errorf(
+				"invalid factory type for application [%s]: [%v]",
+				application.Hex(),
+				err,
+			)
+		}
+
+		if err := ethereumChain.SetKeepFactoryType(application, factoryType); err != nil {
+			return err
+		}
+
+		logger.Infof(
+			"using [%s] keep factory for application [%s]",
+			factoryType,
+			application.Hex(),
+		)
+	}
+
+	return nil
+}
+
+func initializeRewardsAutoClaim(
+	ctx context.Context,
+	ethereumChain *ethereum.EthereumChain,
+	config *config.Config,
+) {
+	period := config.Rewards.AutoClaimPeriod.ToDuration()
+	if period == 0 {
+		return
+	}
+
+	if _, err := config.Ethereum.ContractAddress(
+		ethereum.ECDSARewardsContractName,
+	); err != nil {
+		logger.Errorf("could not start rewards auto-claim: [%v]", err)
+		return
+	}
+
+	go rewards.AutoClaim(ctx, ethereumChain, ethereumChain.Address(), period)
+
+	logger.Infof(
+		"started rewards auto-claim for operator [%s] with period [%v]",
+		ethereumChain.Address().Hex(),
+		period,
+	)
+}
+
 func initializeBalanceMonitoring(
 	ctx context.Context,
 	ethereumChain *ethereum.EthereumChain,
@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/storage"
+	"github.com/urfave/cli"
+)
+
+// StorageCommand contains the definition of the `storage` command-line
+// subcommand and its own subcommands.
+var StorageCommand cli.Command
+
+// #nosec G101 (look for hardcoded credentials)
+// These lines don't contain any credentials.
+// They're just the names of the environment variables.
+const (
+	oldStoragePassphraseEnvVariable = "KEEP_STORAGE_OLD_PASSPHRASE"
+	newStoragePassphraseEnvVariable = "KEEP_STORAGE_NEW_PASSPHRASE"
+)
+
+const rekeyDescription = `Re-encrypts all data stored in the data directory,
+   including archived key shares, with a new passphrase. Files are decrypted
+   with the old passphrase provided as ` + oldStoragePassphraseEnvVariable + `
+   environment variable and encrypted with the new passphrase provided as
+   ` + newStoragePassphraseEnvVariable + ` environment variable.
+
+   The data directory is replaced only if all files have been re-encrypted
+   successfully. The previous content of the data directory is moved aside and
+   should be removed once the client is confirmed to work with the new
+   passphrase. The client must be stopped during re-encryption.
+
+   After re-encryption, the new passphrase must be provided to the client as
+   ` + config.StoragePassphraseEnvVariable + ` environment variable, unless it is
+   the same as the ethereum key file password.`
+
+const migrateDescription = `Copies signers of keeps, their snapshots and
+   archived signers from the storage backend configured in the config file to
+   the target storage backend. The target storage must not contain any
+   signers. If the migration fails, signers already copied to the target
+   storage are archived there, so the migration can be retried. The source
+   storage is left untouched.
+
+   Supported backends are "` + directoryStorageBackend + `", storing signers of each
+   keep in a separate directory, and "` + fileStorageBackend + `", storing all signers
+   in a single key/value store file within the data directory.
+
+   After migration, the target backend has to be set in the config file. The
+   client must be stopped during migration.`
+
+// Storage backends of keeps' signers.
+const (
+	directoryStorageBackend = "directory"
+	fileStorageBackend      = "file"
+)
+
+// keyValueStoreFile is the name of the key/value store file within the data
+// directory used by the file storage backend.
+const keyValueStoreFile = "keeps.db"
+
+// Namespaces of the key/value store used by the file storage backend.
+const (
+	signersNamespace   = "signers"
+	snapshotsNamespace = "snapshots"
+)
+
+func init() {
+	StorageCommand = cli.Command{
+		Name:  "storage",
+		Usage: "Provides tools for managing data stored on disk",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "rekey",
+				Usage:       "Re-encrypts stored data with a new passphrase",
+				Description: rekeyDescription,
+				Action:      RekeyStorage,
+			},
+			{
+				Name:        "migrate",
+				Usage:       "Migrates signers of keeps to another storage backend",
+				Description: migrateDescription,
+				Action:      MigrateStorage,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "to",
+						Usage: "Target storage backend",
+					},
+				},
+			},
+		},
+	}
+}
+
+// RekeyStorage re-encrypts all data stored in the data directory with a new
+// passphrase.
+func RekeyStorage(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	oldPassphrase := os.Getenv(oldStoragePassphraseEnvVariable)
+	if len(oldPassphrase) == 0 {
+		return fmt.Errorf(
+			"old passphrase is required; set [%s] environment variable",
+			oldStoragePassphraseEnvVariable,
+		)
+	}
+
+	newPassphrase := os.Getenv(newStoragePassphraseEnvVariable)
+	if len(newPassphrase) == 0 {
+		return fmt.Errorf(
+			"new passphrase is required; set [%s] environment variable",
+			newStoragePassphraseEnvVariable,
+		)
+	}
+
+	if oldPassphrase == newPassphrase {
+		return fmt.Errorf("new passphrase must differ from the old one")
+	}
+
+	result, err := storage.Rekey(
+		config.Storage.DataDir,
+		oldPassphrase,
+		newPassphrase,
+	)
+	if err != nil {
+		return fmt.Errorf("could not re-encrypt data directory: [%v]", err)
+	}
+
+	fmt.Printf(
+		"re-encrypted [%d] files in data directory [%s]\n"+
+			"previous content of the data directory has been moved to [%s]; "+
+			"remove it once the client is confirmed to work with the new passphrase\n",
+		result.Files,
+		config.Storage.DataDir,
+		result.PreviousDataDir,
+	)
+
+	return nil
+}
+
+// MigrateStorage copies signers of keeps, their snapshots and archived
+// signers from the configured storage backend to the target storage backend.
+func MigrateStorage(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	sourceBackend := config.Storage.Backend
+	if len(sourceBackend) == 0 {
+		sourceBackend = directoryStorageBackend
+	}
+
+	targetBackend := c.String("to")
+	if len(targetBackend) == 0 {
+		return fmt.Errorf("target storage backend is required")
+	}
+
+	if sourceBackend == targetBackend {
+		return fmt.Errorf(
+			"target storage backend must differ from the configured one [%s]",
+			sourceBackend,
+		)
+	}
+
+	source, err := openKeepsPersistence(config, sourceBackend)
+	if err != nil {
+		return fmt.Errorf("failed while opening source storage: [%v]", err)
+	}
+	defer source.close()
+
+	if err := source.migrateLegacySnapshots(); err != nil {
+		return fmt.Errorf("failed while migrating legacy snapshots: [%v]", err)
+	}
+
+	target, err := openKeepsPersistence(config, targetBackend)
+	if err != nil {
+		return fmt.Errorf("failed while opening target storage: [%v]", err)
+	}
+	defer target.close()
+
+	result, err := registry.MigrateStorage(source.storage(), target.storage())
+	if err != nil {
+		return fmt.Errorf("could not migrate storage: [%v]", err)
+	}
+
+	fmt.Printf(
+		"migrated [%d] signers, [%d] snapshots and [%d] archived signers "+
+			"from [%s] to [%s] backend; "+
+			"set Storage.Backend to [%s] in the config file\n",
+		result.Signers,
+		result.Snapshots,
+		result.Archived,
+		sourceBackend,
+		targetBackend,
+		targetBackend,
+	)
+
+	return nil
+}
+
+// keepsPersistence contains persistence handles of keeps' signers, their
+// snapshots and archived signers for the selected storage backend. Snapshots
+// made by the legacy disk persistence next to signers are read with a separate
+// handle, if the backend can contain them.
+type keepsPersistence struct {
+	signers         persistence.Handle
+	snapshots       persistence.Handle
+	archive         persistence.Handle
+	legacySnapshots persistence.Handle
+	close           func()
+}
+
+// openKeepsPersistence opens persistence handles of keeps' signers, their
+// snapshots and archived signers for the given storage backend. The default backend is used if
+// no backend is given. Handles are encrypted with the storage passphrase.
+func openKeepsPersistence(
+	config *config.Config,
+	backend string,
+) (*keepsPersistence, error) {
+	switch backend {
+	case "", directoryStorageBackend:
+		signersPersistence, err := newKeepsPersistence(config)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotPersistence, err := newSnapshotPersistence(config)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed while creating a snapshot storage: [%v]",
+				err,
+			)
+		}
+
+		return &keepsPersistence{
+			signers:   signersPersistence,
+			snapshots: snapshotPersistence,
+			archive: persistence.NewEncryptedPersistence(
+				storage.NewDiskArchiveHandle(config.Storage.DataDir),
+				config.StoragePassphrase(),
+			),
+			legacySnapshots: persistence.NewEncryptedPersistence(
+				storage.NewDiskSnapshotHandle(config.Storage.DataDir),
+				config.StoragePassphrase(),
+			),
+			close: func() {},
+		}, nil
+	case fileStorageBackend:
+		store, err := storage.OpenKeyValueStore(
+			filepath.Join(config.Storage.DataDir, keyValueStoreFile),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed while opening key/value store: [%v]",
+				err,
+			)
+		}
+
+		return &keepsPersistence{
+			signers: persistence.NewEncryptedPersistence(
+				store.Handle(signersNamespace),
+				config.StoragePassphrase(),
+			),
+			snapshots: persistence.NewEncryptedPersistence(
+				store.Handle(snapshotsNamespace),
+				config.StoragePassphrase(),
+			),
+			archive: persistence.NewEncryptedPersistence(
+				store.ArchiveHandle(signersNamespace),
+				config.StoragePassphrase(),
+			),
+			close: func() {
+				if err := store.Close(); err != nil {
+					logger.Errorf("failed to close key/value store: [%v]", err)
+				}
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend [%s]", backend)
+	}
+}
+
+// storage returns keeps storage using the persistence handles.
+func (kp *keepsPersistence) storage() registry.Storage {
+	return registry.NewPersistentStorageWithArchive(
+		kp.signers,
+		kp.snapshots,
+		kp.archive,
+	)
+}
+
+// migrateLegacySnapshots copies snapshots made by the legacy disk persistence
+// to the snapshots storage, so signers can be recovered from them. Nothing is
+// copied if the backend can not contain legacy snapshots.
+func (kp *keepsPersistence) migrateLegacySnapshots() error {
+	if kp.legacySnapshots == nil {
+		return nil
+	}
+
+	migrated, err := registry.MigrateLegacySnapshots(
+		kp.legacySnapshots,
+		kp.storage(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if migrated > 0 {
+		logger.Infof("migrated [%d] legacy snapshots of signers", migrated)
+	}
+
+	return nil
+}
+
+// loadRegistry creates a keeps registry with signers loaded from the storage.
+func (kp *keepsPersistence) loadRegistry() *registry.Keeps {
+	keepsRegistry := registry.NewKeepsRegistry(kp.storage())
+
+	keepsRegistry.LoadExistingKeeps()
+
+	return keepsRegistry
+}
+
+// newKeepsPersistence creates a persistence handle for key shares stored in
+// the data directory.
+func newKeepsPersistence(config *config.Config) (persistence.Handle, error) {
+	handle, err := persistence.NewDiskHandle(config.Storage.DataDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed while creating a storage disk handler: [%v]",
+			err,
+		)
+	}
+
+	return persistence.NewEncryptedPersistence(
+		handle,
+		config.StoragePassphrase(),
+	), nil
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
+	"github.com/urfave/cli"
+)
+
+// TxCommand contains the definition of the `tx` command-line subcommand and
+// its own subcommands.
+var TxCommand cli.Command
+
+const txListDescription = `Reads the journal of transactions submitted by the
+   client from the data directory and lists them with their purpose, nonce,
+   submissions and status. When multiple operators are configured, journals
+   of all operators are listed. Transactions can be limited to the given status:
+   pending, mined, reverted or dropped. Pending transactions submitted long ago
+   are marked as stuck.`
+
+func init() {
+	TxCommand = cli.Command{
+		Name:  "tx",
+		Usage: "Provides tools for transactions submitted by the client",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "list",
+				Usage:       "Lists transactions from the transaction journal",
+				Description: txListDescription,
+				Action:      ListTransactions,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "status",
+						Usage: "Status of transactions to list",
+					},
+					cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print transactions as a JSON array",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ListTransactions prints transactions noted in the transaction journal.
+func ListTransactions(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	operatorConfigs, err := config.OperatorConfigs()
+	if err != nil {
+		return fmt.Errorf("invalid operators configuration: [%v]", err)
+	}
+
+	status := c.String("status")
+
+	transactions := make([]*ethereum.Transaction, 0)
+	for _, operatorConfig := range operatorConfigs {
+		journalDir := filepath.Join(
+			operatorConfig.Storage.DataDir,
+			transactionJournalDir,
+		)
+
+		if _, err := os.Stat(journalDir); os.IsNotExist(err) {
+			continue
+		}
+
+		handle, err := persistence.NewDiskHandle(journalDir)
+		if err != nil {
+			return fmt.Errorf(
+				"failed while creating a transaction journal disk handler: [%v]",
+				err,
+			)
+		}
+
+		journal := ethereum.NewTransactionJournal(handle)
+		journal.Load()
+
+		for _, transaction := range journal.Transactions() {
+			if len(status) > 0 && transaction.Status.String() != status {
+				continue
+			}
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	if c.Bool("json") {
+		data, err := json.MarshalIndent(transactions, "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not serialize transactions: [%v]", err)
+		}
+
+		_, err = os.Stdout.Write(append(data, '\n'))
+		return err
+	}
+
+	if len(transactions) == 0 {
+		fmt.Println("no transactions found")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(
+		writer,
+		"FROM\tNONCE\tPURPOSE\tSUBJECT\tSUBMISSIONS\tGAS PRICE\tSTATUS\tHASH",
+	)
+
+	for _, transaction := range transactions {
+		lastSubmission := transaction.Submissions[len(transaction.Submissions)-1]
+
+		hash := lastSubmission.Hash
+		if transaction.Status == ethereum.TransactionMined ||
+			transaction.Status == ethereum.TransactionReverted {
+			hash = transaction.MinedHash
+		}
+
+		status := transaction.Status.String()
+		if transaction.IsStuck(time.Now()) {
+			status = "stuck"
+		}
+
+		fmt.Fprintf(
+			writer,
+			"%s\t%d\t%s\t%s\t%d\t%v\t%s\t%s\n",
+			transaction.From.Hex(),
+			transaction.Nonce,
+			transaction.Purpose,
+			transaction.Subject,
+			len(transaction.Submissions),
+			lastSubmission.GasPrice,
+			status,
+			hash.Hex(),
+		)
+	}
+
+	return writer.Flush()
+}
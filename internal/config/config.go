@@ -3,11 +3,15 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
+	configtime "github.com/keep-network/keep-ecdsa/internal/config/time"
+	"github.com/keep-network/keep-ecdsa/pkg/backoff"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
@@ -18,6 +22,13 @@ import (
 // It's just the name of the environment variable.
 const PasswordEnvVariable = "KEEP_ETHEREUM_PASSWORD"
 
+// StoragePassphraseEnvVariable environment variable name for the passphrase
+// used to encrypt data stored on disk.
+// #nosec G101 (look for hardcoded credentials)
+// This line doesn't contain any credentials.
+// It's just the name of the environment variable.
+const StoragePassphraseEnvVariable = "KEEP_STORAGE_PASSPHRASE"
+
 // Config is the top level config structure.
 type Config struct {
 	Ethereum               ethereum.Config
@@ -26,15 +37,114 @@ type Config struct {
 	LibP2P                 libp2p.Config
 	Client                 client.Config
 	TSS                    tss.Config
+	Backoff                Backoff
 	Metrics                Metrics
 	Diagnostics            Diagnostics
+	Admin                  Admin
+	Rewards                Rewards
 	Extensions             Extensions
+
+	// Operators run within the client process. If not set, the client runs
+	// a single operator with the top level Ethereum account, data directory
+	// and libp2p port.
+	Operators []Operator
+}
+
+// Operator stores configuration of an operator account run within the client
+// process next to other operators. Each operator has its own Ethereum
+// account, data directory and libp2p identity. The rest of the configuration
+// is taken from the top level.
+type Operator struct {
+	// Ethereum key file of the operator account.
+	KeyFile string
+	// Name of the environment variable holding the key file password. If not
+	// set, the password is read from KEEP_ETHEREUM_PASSWORD.
+	KeyFilePasswordEnv string
+
+	// Data directory of the operator. It must be different for each operator.
+	DataDir string
+
+	// LibP2P port of the operator. It must be different for each operator.
+	Port int
+	// Addresses announced in the network. If not set, the top level LibP2P
+	// announced addresses are used.
+	AnnouncedAddresses []string
+
+	// Port of the admin endpoint of the operator. If not set, the admin
+	// endpoint is not started for the operator.
+	AdminPort int
+
+	keyFilePassword string
+}
+
+// OperatorConfigs returns configurations of all operators run within the
+// client process. Each configuration is a copy of the top level configuration
+// with the operator's account, data directory and libp2p port. If no
+// operators are configured, the top level configuration is returned as the
+// only one.
+func (c *Config) OperatorConfigs() ([]*Config, error) {
+	if len(c.Operators) == 0 {
+		return []*Config{c}, nil
+	}
+
+	dataDirs := make(map[string]bool)
+	ports := make(map[int]bool)
+
+	configs := make([]*Config, len(c.Operators))
+	for i, operator := range c.Operators {
+		if len(operator.KeyFile) == 0 {
+			return nil, fmt.Errorf("key file of operator [%v] is not set", i)
+		}
+
+		if len(operator.DataDir) == 0 {
+			return nil, fmt.Errorf("data directory of operator [%v] is not set", i)
+		}
+		dataDir := filepath.Clean(operator.DataDir)
+		if dataDirs[dataDir] {
+			return nil, fmt.Errorf(
+				"data directory [%v] is used by more than one operator",
+				operator.DataDir,
+			)
+		}
+		dataDirs[dataDir] = true
+
+		if operator.Port == 0 {
+			return nil, fmt.Errorf("port of operator [%v] is not set", i)
+		}
+		if ports[operator.Port] {
+			return nil, fmt.Errorf(
+				"port [%v] is used by more than one operator",
+				operator.Port,
+			)
+		}
+		ports[operator.Port] = true
+
+		operatorConfig := *c
+		operatorConfig.Operators = nil
+		operatorConfig.Ethereum.Account.KeyFile = operator.KeyFile
+		operatorConfig.Ethereum.Account.KeyFilePassword = operator.keyFilePassword
+		operatorConfig.Storage.DataDir = operator.DataDir
+		operatorConfig.LibP2P.Port = operator.Port
+		if len(operator.AnnouncedAddresses) > 0 {
+			operatorConfig.LibP2P.AnnouncedAddresses = operator.AnnouncedAddresses
+		}
+		operatorConfig.Admin.Port = operator.AdminPort
+
+		configs[i] = &operatorConfig
+	}
+
+	return configs, nil
 }
 
 // SanctionedApplications contains addresses of applications approved by the
-// operator.
+// operator along with types of keep factories creating keeps for them.
 type SanctionedApplications struct {
 	AddressesStrings []string `toml:"Addresses"`
+
+	// FactoryTypesStrings maps application addresses to keep factory types,
+	// `bonded` or `fully-backed`. Applications not listed use the bonded
+	// keep factory.
+	FactoryTypesStrings map[string]string `toml:"FactoryTypes"`
 }
 
 // Addresses returns list of sanctioned applications as a slice of ethereum addresses.
@@ -55,9 +165,157 @@ func (sa *SanctionedApplications) Addresses() ([]common.Address, error) {
 	return applicationsAddresses, nil
 }
 
+// FactoryTypes returns keep factory types of applications by their ethereum
+// addresses.
+func (sa *SanctionedApplications) FactoryTypes() (map[common.Address]string, error) {
+	factoryTypes := make(map[common.Address]string, len(sa.FactoryTypesStrings))
+
+	for application, factoryType := range sa.FactoryTypesStrings {
+		if !common.IsHexAddress(application) {
+			return factoryTypes, fmt.Errorf(
+				"application address [%v] is not valid hex address",
+				application,
+			)
+		}
+
+		factoryTypes[common.HexToAddress(application)] = factoryType
+	}
+
+	return factoryTypes, nil
+}
+
+// Default backoff configurations used when values are not set in the
+// configuration file.
+var (
+	defaultKeyGenerationBackoff = backoff.NewConfig(
+		1*time.Second,
+		2,
+		30*time.Second,
+		500*time.Millisecond,
+		0,
+	)
+	defaultSigningBackoff = backoff.NewConfig(
+		1*time.Second,
+		2,
+		30*time.Second,
+		500*time.Millisecond,
+		0,
+	)
+	defaultPublicationBackoff = backoff.NewConfig(
+		1*time.Minute,
+		2,
+		8*time.Minute,
+		10*time.Second,
+		0,
+	)
+	defaultChainCallsBackoff = backoff.NewConfig(
+		1*time.Second,
+		2,
+		30*time.Second,
+		500*time.Millisecond,
+		10,
+	)
+	defaultSubscriptionsBackoff = backoff.NewConfig(
+		1*time.Second,
+		2,
+		1*time.Minute,
+		1*time.Second,
+		0,
+	)
+	defaultTBTCBackoff = backoff.NewConfig(
+		2*time.Second,
+		2,
+		0,
+		100*time.Millisecond,
+		0,
+	)
+)
+
+// Backoff stores configuration of delays between retries of operations
+// of the given class.
+type Backoff struct {
+	// Retries of signer announcements and key generation protocol.
+	KeyGeneration backoff.Config
+	// Retries of signing protocol.
+	Signing backoff.Config
+	// Retries of signature submissions.
+	Publication backoff.Config
+	// Retries of failed chain calls.
+	ChainCalls backoff.Config
+	// Re-establishing of failed event subscriptions.
+	Subscriptions backoff.Config
+	// Retries of deposit transactions submitted by the tBTC extension.
+	TBTC backoff.Config
+}
+
+// GetKeyGenerationPolicy returns backoff policy for key generation retries.
+// Values which are not set are taken from defaults.
+func (b *Backoff) GetKeyGenerationPolicy() backoff.Policy {
+	return b.KeyGeneration.Policy(defaultKeyGenerationBackoff)
+}
+
+// GetSigningPolicy returns backoff policy for signing retries. Values which
+// are not set are taken from defaults.
+func (b *Backoff) GetSigningPolicy() backoff.Policy {
+	return b.Signing.Policy(defaultSigningBackoff)
+}
+
+// GetPublicationPolicy returns backoff policy for signature publication
+// retries. Values which are not set are taken from defaults.
+func (b *Backoff) GetPublicationPolicy() backoff.Policy {
+	return b.Publication.Policy(defaultPublicationBackoff)
+}
+
+// GetChainCallsPolicy returns backoff policy for chain calls retries. Values
+// which are not set are taken from defaults.
+func (b *Backoff) GetChainCallsPolicy() backoff.Policy {
+	return b.ChainCalls.Policy(defaultChainCallsBackoff)
+}
+
+// GetChainCallsMaxAttempts returns the maximum number of attempts of chain
+// calls which give up after a certain number of attempts. If a value is not
+// set it returns a default value.
+func (b *Backoff) GetChainCallsMaxAttempts() int {
+	return b.ChainCalls.GetMaxAttempts(defaultChainCallsBackoff)
+}
+
+// GetSubscriptionsPolicy returns backoff policy for re-establishing failed
+// event subscriptions. Values which are not set are taken from defaults.
+func (b *Backoff) GetSubscriptionsPolicy() backoff.Policy {
+	return b.Subscriptions.Policy(defaultSubscriptionsBackoff)
+}
+
+// GetTBTCPolicy returns backoff policy for retries of deposit transactions
+// submitted by the tBTC extension. Values which are not set are taken from
+// defaults.
+func (b *Backoff) GetTBTCPolicy() backoff.Policy {
+	return b.TBTC.Policy(defaultTBTCBackoff)
+}
+
 // Storage stores meta-info about keeping data on disk
 type Storage struct {
 	DataDir string
+
+	// Backend used to store keeps' signers. The "directory" backend, used by
+	// default, stores signers of each keep in a separate directory. The "file"
+	// backend stores all signers in a single embedded key/value store file
+	// within the data directory.
+	Backend string
+
+	// Passphrase used to encrypt data on disk. It is expected to be provided
+	// as environment variable and is never read from the config file.
+	Passphrase string `toml:"-"`
+}
+
+// StoragePassphrase returns the passphrase used to encrypt data stored on
+// disk. If no dedicated storage passphrase has been provided, the ethereum key
+// file password is used.
+func (c *Config) StoragePassphrase() string {
+	if len(c.Storage.Passphrase) > 0 {
+		return c.Storage.Passphrase
+	}
+
+	return c.Ethereum.Account.KeyFilePassword
 }
 
 // Metrics stores meta-info about metrics.
@@ -73,6 +331,20 @@ type Diagnostics struct {
 	Port int
 }
 
+// Admin stores configuration of the local admin endpoint.
+type Admin struct {
+	Host string
+	Port int
+}
+
+// Rewards stores configuration of KEEP rewards withdrawal from the
+// ECDSARewards contract.
+type Rewards struct {
+	// Period of withdrawing rewards from all finished rewards intervals. If
+	// not set, rewards are not withdrawn by the client.
+	AutoClaimPeriod configtime.Duration
+}
+
 // Extensions stores app-specific extensions configuration.
 type Extensions struct {
 	TBTC TBTC
@@ -85,7 +357,8 @@ type TBTC struct {
 }
 
 // ReadConfig reads in the configuration file in .toml format. Ethereum key file
-// password is expected to be provided as environment variable.
+// password and storage passphrase are expected to be provided as environment
+// variables.
 func ReadConfig(filePath string) (*Config, error) {
 	config := &Config{}
 	if _, err := toml.DecodeFile(filePath, config); err != nil {
@@ -93,6 +366,16 @@ func ReadConfig(filePath string) (*Config, error) {
 	}
 
 	config.Ethereum.Account.KeyFilePassword = os.Getenv(PasswordEnvVariable)
+	config.Storage.Passphrase = os.Getenv(StoragePassphraseEnvVariable)
+
+	for i := range config.Operators {
+		passwordEnv := config.Operators[i].KeyFilePasswordEnv
+		if len(passwordEnv) == 0 {
+			passwordEnv = PasswordEnvVariable
+		}
+
+		config.Operators[i].keyFilePassword = os.Getenv(passwordEnv)
+	}
 
 	return config, nil
 }
@@ -61,6 +61,12 @@ func main() {
 		cmd.StartCommand,
 		cmd.EthereumCommand,
 		cmd.SigningCommand,
+		cmd.KeepsCommand,
+		cmd.StorageCommand,
+		cmd.AuditCommand,
+		cmd.TxCommand,
+		cmd.BondingCommand,
+		cmd.RewardsCommand,
 	}
 
 	err = app.Run(os.Args)
@@ -0,0 +1,304 @@
+// Package admin provides a local HTTP endpoint which lets the operator query
+// the state of the running client. All responses are JSON documents.
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
+	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
+)
+
+var logger = log.Logger("keep-admin")
+
+// DefaultHost is the default host on which the admin endpoint listens. The
+// endpoint is meant to be available only locally by default.
+const DefaultHost = "127.0.0.1"
+
+// Time allowed for in-flight requests to complete when the server is stopping.
+const shutdownTimeout = 5 * time.Second
+
+// Source provides the state of the client exposed by the admin endpoint.
+type Source interface {
+	Keeps() []*client.KeepStatus
+	SigningRequests() []*signing.Request
+	TSSPreParamsPoolSize() int
+	RegistrationStatuses() []*client.RegistrationStatus
+	RecentErrors() []*node.ErrorRecord
+}
+
+// TransactionsSource provides transactions submitted by the client which are
+// pending for a long time.
+type TransactionsSource interface {
+	StuckTransactions() []*ethereum.Transaction
+}
+
+// Initialize starts the admin endpoint on the given host and port. If the port
+// is not set, the endpoint is not started and false is returned. An error is
+// returned if the endpoint could not listen on the given host and port. The
+// endpoint is stopped once the context is done. If the transactions source is
+// nil, no stuck transactions are reported.
+func Initialize(
+	ctx context.Context,
+	host string,
+	port int,
+	source Source,
+	transactions TransactionsSource,
+) (bool, error) {
+	if port == 0 {
+		return false, nil
+	}
+
+	if host == "" {
+		host = DefaultHost
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return false, fmt.Errorf(
+			"could not listen on [%s]: [%v]",
+			address,
+			err,
+		)
+	}
+
+	server := &http.Server{
+		Handler: newHandler(source, transactions),
+	}
+
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			logger.Errorf("admin endpoint failed: [%v]", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(),
+			shutdownTimeout,
+		)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Warningf("could not stop admin endpoint: [%v]", err)
+		}
+	}()
+
+	return true, nil
+}
+
+func newHandler(source Source, transactions TransactionsSource) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/keeps", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, r, keepsResponse(source.Keeps()))
+	})
+	mux.HandleFunc("/signing-requests", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, r, signingRequestsResponse(source.SigningRequests()))
+	})
+	mux.HandleFunc("/tss-pre-params-pool", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, r, &tssPreParamsPool{Size: source.TSSPreParamsPoolSize()})
+	})
+	mux.HandleFunc("/registrations", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, r, registrationsResponse(source.RegistrationStatuses()))
+	})
+	mux.HandleFunc("/errors", func(w http.ResponseWriter, r *http.Request) {
+		respond(w, r, errorsResponse(source.RecentErrors()))
+	})
+	mux.HandleFunc("/transactions/stuck", func(w http.ResponseWriter, r *http.Request) {
+		stuck := make([]*ethereum.Transaction, 0)
+		if transactions != nil {
+			stuck = transactions.StuckTransactions()
+		}
+		respond(w, r, transactionsResponse(stuck))
+	})
+
+	return mux
+}
+
+func respond(w http.ResponseWriter, r *http.Request, response interface{}) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		http.Error(
+			w,
+			fmt.Sprintf("could not marshal response: [%v]", err),
+			http.StatusInternalServerError,
+		)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		logger.Warningf("could not write admin response: [%v]", err)
+	}
+}
+
+type keep struct {
+	Address   string `json:"address"`
+	MemberID  string `json:"memberID"`
+	PublicKey string `json:"publicKey"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+func keepsResponse(statuses []*client.KeepStatus) []*keep {
+	keeps := make([]*keep, 0, len(statuses))
+	for _, status := range statuses {
+		k := &keep{
+			Address:  status.Address.String(),
+			MemberID: status.MemberID,
+		}
+
+		if status.PublicKey != nil {
+			k.PublicKey = hex.EncodeToString(crypto.FromECDSAPub(status.PublicKey))
+		}
+
+		switch {
+		case status.StatusError != nil:
+			k.Status = "unknown"
+			k.Error = status.StatusError.Error()
+		case status.IsActive:
+			k.Status = "active"
+		default:
+			k.Status = "inactive"
+		}
+
+		keeps = append(keeps, k)
+	}
+
+	return keeps
+}
+
+type signingRequest struct {
+	KeepAddress string    `json:"keepAddress"`
+	Digest      string    `json:"digest"`
+	State       string    `json:"state"`
+	Attempts    int       `json:"attempts"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func signingRequestsResponse(requests []*signing.Request) []*signingRequest {
+	response := make([]*signingRequest, 0, len(requests))
+	for _, request := range requests {
+		response = append(response, &signingRequest{
+			KeepAddress: request.KeepAddress.String(),
+			Digest:      hex.EncodeToString(request.Digest[:]),
+			State:       request.State.String(),
+			Attempts:    request.Attempts,
+			UpdatedAt:   request.UpdatedAt,
+		})
+	}
+
+	return response
+}
+
+type tssPreParamsPool struct {
+	Size int `json:"size"`
+}
+
+type registration struct {
+	Application string    `json:"application"`
+	State       string    `json:"state"`
+	LastError   string    `json:"lastError,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func registrationsResponse(statuses []*client.RegistrationStatus) []*registration {
+	response := make([]*registration, 0, len(statuses))
+	for _, status := range statuses {
+		response = append(response, &registration{
+			Application: status.Application.String(),
+			State:       string(status.State),
+			LastError:   status.LastError,
+			UpdatedAt:   status.UpdatedAt,
+		})
+	}
+
+	return response
+}
+
+type errorRecord struct {
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+}
+
+func errorsResponse(records []*node.ErrorRecord) []*errorRecord {
+	response := make([]*errorRecord, 0, len(records))
+	for _, record := range records {
+		response = append(response, &errorRecord{
+			Time:    record.Time,
+			Message: record.Message,
+		})
+	}
+
+	return response
+}
+
+type transactionSubmission struct {
+	Hash        string    `json:"hash"`
+	GasPrice    string    `json:"gasPrice"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+type transaction struct {
+	From        string                   `json:"from"`
+	Nonce       uint64                   `json:"nonce"`
+	Purpose     string                   `json:"purpose"`
+	Subject     string                   `json:"subject,omitempty"`
+	Status      string                   `json:"status"`
+	Submissions []*transactionSubmission `json:"submissions"`
+	UpdatedAt   time.Time                `json:"updatedAt"`
+}
+
+func transactionsResponse(transactions []*ethereum.Transaction) []*transaction {
+	response := make([]*transaction, 0, len(transactions))
+	for _, tx := range transactions {
+		submissions := make([]*transactionSubmission, 0, len(tx.Submissions))
+		for _, submission := range tx.Submissions {
+			gasPrice := ""
+			if submission.GasPrice != nil {
+				gasPrice = submission.GasPrice.String()
+			}
+
+			submissions = append(submissions, &transactionSubmission{
+				Hash:        submission.Hash.Hex(),
+				GasPrice:    gasPrice,
+				SubmittedAt: submission.SubmittedAt,
+			})
+		}
+
+		response = append(response, &transaction{
+			From:        tx.From.Hex(),
+			Nonce:       tx.Nonce,
+			Purpose:     tx.Purpose,
+			Subject:     tx.Subject,
+			Status:      tx.Status.String(),
+			Submissions: submissions,
+			UpdatedAt:   tx.UpdatedAt,
+		})
+	}
+
+	return response
+}
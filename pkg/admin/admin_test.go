@@ -0,0 +1,308 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
+	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
+)
+
+var (
+	keepAddress1 = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	keepAddress2 = common.HexToAddress("0x8B3BccB3A3994681A1C1584DE4b4E8b23ed1Ed6d")
+	application  = common.HexToAddress("0x65EA55c1f10491038425725dC00dFFEAb2A1e28A")
+	timestamp    = time.Date(2020, 11, 20, 10, 0, 0, 0, time.UTC)
+)
+
+func TestKeeps(t *testing.T) {
+	source := &sourceMock{
+		keeps: []*client.KeepStatus{
+			{Address: keepAddress1, MemberID: "01", IsActive: true},
+			{Address: keepAddress2, MemberID: "02", StatusError: fmt.Errorf("boom")},
+		},
+	}
+
+	var actual []*keep
+	get(t, source, "/keeps", &actual)
+
+	expected := []*keep{
+		{Address: keepAddress1.String(), MemberID: "01", Status: "active"},
+		{
+			Address:  keepAddress2.String(),
+			MemberID: "02",
+			Status:   "unknown",
+			Error:    "boom",
+		},
+	}
+
+	assertResponse(t, expected, actual)
+}
+
+func TestSigningRequests(t *testing.T) {
+	source := &sourceMock{
+		signingRequests: []*signing.Request{
+			{
+				KeepAddress: keepAddress1,
+				Digest:      [32]byte{1},
+				State:       signing.Signing,
+				Attempts:    3,
+				UpdatedAt:   timestamp,
+			},
+		},
+	}
+
+	var actual []*signingRequest
+	get(t, source, "/signing-requests", &actual)
+
+	expected := []*signingRequest{
+		{
+			KeepAddress: keepAddress1.String(),
+			Digest:      "0100000000000000000000000000000000000000000000000000000000000000",
+			State:       "signing",
+			Attempts:    3,
+			UpdatedAt:   timestamp,
+		},
+	}
+
+	assertResponse(t, expected, actual)
+}
+
+func TestTSSPreParamsPool(t *testing.T) {
+	source := &sourceMock{tssPreParamsPoolSize: 17}
+
+	actual := &tssPreParamsPool{}
+	get(t, source, "/tss-pre-params-pool", actual)
+
+	assertResponse(t, &tssPreParamsPool{Size: 17}, actual)
+}
+
+func TestRegistrations(t *testing.T) {
+	source := &sourceMock{
+		registrationStatuses: []*client.RegistrationStatus{
+			{
+				Application: application,
+				State:       client.RegistrationFailed,
+				LastError:   "boom",
+				UpdatedAt:   timestamp,
+			},
+		},
+	}
+
+	var actual []*registration
+	get(t, source, "/registrations", &actual)
+
+	expected := []*registration{
+		{
+			Application: application.String(),
+			State:       "failed",
+			LastError:   "boom",
+			UpdatedAt:   timestamp,
+		},
+	}
+
+	assertResponse(t, expected, actual)
+}
+
+func TestErrors(t *testing.T) {
+	source := &sourceMock{
+		recentErrors: []*node.ErrorRecord{
+			{Time: timestamp, Message: "boom"},
+		},
+	}
+
+	var actual []*errorRecord
+	get(t, source, "/errors", &actual)
+
+	expected := []*errorRecord{{Time: timestamp, Message: "boom"}}
+
+	assertResponse(t, expected, actual)
+}
+
+func TestStuckTransactions(t *testing.T) {
+	source := &sourceMock{
+		stuckTransactions: []*ethereum.Transaction{
+			{
+				From:    keepAddress2,
+				Nonce:   12,
+				Purpose: "SubmitSignature",
+				Subject: keepAddress1.Hex(),
+				Submissions: []*ethereum.TransactionSubmission{
+					{
+						Hash:        common.Hash{1},
+						GasPrice:    big.NewInt(20000000000),
+						SubmittedAt: timestamp,
+					},
+				},
+				Status:    ethereum.TransactionPending,
+				UpdatedAt: timestamp,
+			},
+		},
+	}
+
+	var actual []*transaction
+	get(t, source, "/transactions/stuck", &actual)
+
+	expected := []*transaction{
+		{
+			From:    keepAddress2.Hex(),
+			Nonce:   12,
+			Purpose: "SubmitSignature",
+			Subject: keepAddress1.Hex(),
+			Status:  "pending",
+			Submissions: []*transactionSubmission{
+				{
+					Hash:        common.Hash{1}.Hex(),
+					GasPrice:    "20000000000",
+					SubmittedAt: timestamp,
+				},
+			},
+			UpdatedAt: timestamp,
+		},
+	}
+
+	assertResponse(t, expected, actual)
+}
+
+func TestStuckTransactionsWithoutSource(t *testing.T) {
+	server := httptest.NewServer(newHandler(&sourceMock{}, nil))
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "/transactions/stuck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var actual []*transaction
+	if err := json.NewDecoder(response.Body).Decode(&actual); err != nil {
+		t.Fatal(err)
+	}
+
+	assertResponse(t, []*transaction{}, actual)
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	server := httptest.NewServer(newHandler(&sourceMock{}, nil))
+	defer server.Close()
+
+	response, err := http.Post(server.URL+"/keeps", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf(
+			"unexpected status code\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			http.StatusMethodNotAllowed,
+			response.StatusCode,
+		)
+	}
+}
+
+func TestInitialize_AddressInUse(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	isConfigured, err := Initialize(
+		ctx,
+		DefaultHost,
+		listener.Addr().(*net.TCPAddr).Port,
+		&sourceMock{},
+		nil,
+	)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if isConfigured {
+		t.Errorf("admin endpoint should not be configured")
+	}
+}
+
+func get(t *testing.T, source *sourceMock, path string, result interface{}) {
+	server := httptest.NewServer(newHandler(source, source))
+	defer server.Close()
+
+	response, err := http.Get(server.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code [%v]", response.StatusCode)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertResponse(t *testing.T, expected interface{}, actual interface{}) {
+	if !reflect.DeepEqual(expected, actual) {
+		expectedJSON, _ := json.Marshal(expected)
+		actualJSON, _ := json.Marshal(actual)
+
+		t.Errorf(
+			"unexpected response\n"+
+				"expected: [%s]\n"+
+				"actual:   [%s]",
+			expectedJSON,
+			actualJSON,
+		)
+	}
+}
+
+type sourceMock struct {
+	keeps                []*client.KeepStatus
+	signingRequests      []*signing.Request
+	tssPreParamsPoolSize int
+	registrationStatuses []*client.RegistrationStatus
+	recentErrors         []*node.ErrorRecord
+	stuckTransactions    []*ethereum.Transaction
+}
+
+func (sm *sourceMock) Keeps() []*client.KeepStatus {
+	return sm.keeps
+}
+
+func (sm *sourceMock) SigningRequests() []*signing.Request {
+	return sm.signingRequests
+}
+
+func (sm *sourceMock) TSSPreParamsPoolSize() int {
+	return sm.tssPreParamsPoolSize
+}
+
+func (sm *sourceMock) RegistrationStatuses() []*client.RegistrationStatus {
+	return sm.registrationStatuses
+}
+
+func (sm *sourceMock) RecentErrors() []*node.ErrorRecord {
+	return sm.recentErrors
+}
+
+func (sm *sourceMock) StuckTransactions() []*ethereum.Transaction {
+	return sm.stuckTransactions
+}
@@ -0,0 +1,359 @@
+// Package audit contains a tamper-evident log of key generation and signing
+// operations executed by the client.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+)
+
+var logger = log.Logger("keep-audit")
+
+// logHeader is the first line of each audit log file. It identifies the file
+// format so the file can be recognized within the data directory.
+const logHeader = "KEEPAUDIT01"
+
+// Event is a type of the operation recorded in the audit log.
+type Event string
+
+const (
+	// KeyGenerationStarted means the client started generating a signer
+	// for the keep.
+	KeyGenerationStarted Event = "key-generation-started"
+	// KeyGenerationFinished means the client generated a signer for the keep.
+	KeyGenerationFinished Event = "key-generation-finished"
+	// PublicKeySubmitted means the client submitted the public key of the
+	// generated signer to the keep.
+	PublicKeySubmitted Event = "public-key-submitted"
+	// SigningRequestAccepted means the signing request has been confirmed
+	// on-chain and the client started the signing process.
+	SigningRequestAccepted Event = "signing-request-accepted"
+	// SigningRequestRefused means the client did not start the signing process
+	// for the requested digest.
+	SigningRequestRefused Event = "signing-request-refused"
+	// SignatureProduced means the client calculated a signature over
+	// the requested digest.
+	SignatureProduced Event = "signature-produced"
+	// TransactionSubmitted means the client submitted a transaction with
+	// the calculated signature to the keep.
+	TransactionSubmitted Event = "transaction-submitted"
+)
+
+// Entry is a single record of the audit log. Each entry contains the hash of
+// the previous entry so any modification, removal or reordering of entries
+// breaks the chain of hashes.
+type Entry struct {
+	Sequence     uint64
+	Time         time.Time
+	Event        Event
+	KeepAddress  string
+	Digest       string            `json:",omitempty"`
+	Details      map[string]string `json:",omitempty"`
+	PreviousHash string
+	Hash         string
+}
+
+// computeHash calculates the hash of the entry content, including the hash of
+// the previous entry.
+func (e *Entry) computeHash() (string, error) {
+	content := *e
+	content.Hash = ""
+
+	serialized, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(serialized)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Log is an append-only, hash-chained log of operations executed by the
+// client, stored in a single file. The log is not encrypted as it contains
+// only data which are eventually published on-chain. Entries are written one
+// per line, each of them synced to the disk before the write is considered
+// done. A line not terminated by a new line character, left by a crash in
+// the middle of a write, is discarded when the log is opened.
+type Log struct {
+	mutex sync.Mutex
+
+	file         *os.File
+	lastSequence uint64
+	lastHash     string
+}
+
+// Open opens the audit log file under the given path, creating it if it does
+// not exist.
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log file: [%v]", err)
+	}
+
+	auditLog := &Log{file: file}
+
+	if err := auditLog.load(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("could not load audit log [%v]: [%v]", path, err)
+	}
+
+	return auditLog, nil
+}
+
+// load reads the position of the last entry from the file, initializing empty
+// files with a header and discarding the incomplete last line.
+func (l *Log) load() error {
+	content, err := ioutil.ReadAll(l.file)
+	if err != nil {
+		return err
+	}
+
+	header := []byte(logHeader + "\n")
+
+	// The header is incomplete if the client crashed right after the file
+	// was created.
+	if len(content) < len(header) && bytes.HasPrefix(header, content) {
+		if err := l.file.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := l.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+
+		return l.write(header)
+	}
+
+	if !bytes.HasPrefix(content, header) {
+		return fmt.Errorf("not an audit log file")
+	}
+
+	validLength := bytes.LastIndexByte(content, '\n') + 1
+	if validLength < len(content) {
+		logger.Warningf(
+			"discarding incomplete last line of the audit log [%s]",
+			l.file.Name(),
+		)
+
+		if err := l.file.Truncate(int64(validLength)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := l.file.Seek(int64(validLength), io.SeekStart); err != nil {
+		return err
+	}
+
+	lines := bytes.Split(content[:validLength-1], []byte("\n"))
+	if len(lines) == 1 {
+		return nil
+	}
+
+	lastEntry := &Entry{}
+	if err := json.Unmarshal(lines[len(lines)-1], lastEntry); err != nil {
+		return fmt.Errorf("could not parse the last entry: [%v]", err)
+	}
+
+	l.lastSequence = lastEntry.Sequence
+	l.lastHash = lastEntry.Hash
+
+	return nil
+}
+
+// Record appends a new entry for the given event to the log. The digest is
+// omitted if it is empty. Failures are only logged as they should not
+// interrupt the recorded operations. It is safe to call Record on a nil log,
+// in which case nothing is recorded.
+func (l *Log) Record(
+	event Event,
+	keepAddress common.Address,
+	digest [32]byte,
+	details map[string]string,
+) {
+	if l == nil {
+		return
+	}
+
+	if err := l.append(event, keepAddress, digest, details); err != nil {
+		logger.Errorf(
+			"failed to record [%v] event for keep [%s] in the audit log: [%v]",
+			event,
+			keepAddress.String(),
+			err,
+		)
+	}
+}
+
+func (l *Log) append(
+	event Event,
+	keepAddress common.Address,
+	digest [32]byte,
+	details map[string]string,
+) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	entry := &Entry{
+		Sequence:     l.lastSequence + 1,
+		Time:         time.Now().UTC(),
+		Event:        event,
+		KeepAddress:  keepAddress.Hex(),
+		Details:      details,
+		PreviousHash: l.lastHash,
+	}
+	if digest != [32]byte{} {
+		entry.Digest = hex.EncodeToString(digest[:])
+	}
+
+	hash, err := entry.computeHash()
+	if err != nil {
+		return fmt.Errorf("could not compute entry hash: [%v]", err)
+	}
+	entry.Hash = hash
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not serialize entry: [%v]", err)
+	}
+
+	if err := l.write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	l.lastSequence = entry.Sequence
+	l.lastHash = entry.Hash
+
+	return nil
+}
+
+func (l *Log) write(data []byte) error {
+	if _, err := l.file.Write(data); err != nil {
+		return fmt.Errorf("could not write to audit log file: [%v]", err)
+	}
+
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("could not sync audit log file: [%v]", err)
+	}
+
+	return nil
+}
+
+// Close closes the audit log file.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.file.Close()
+}
+
+// Read reads all entries of the audit log file under the given path and
+// verifies the chain of their hashes. An error is returned for the first entry
+// which does not match its hash or does not follow the previous entry. An
+// incomplete last line is ignored.
+func Read(path string) ([]*Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log file: [%v]", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	header, err := reader.ReadString('\n')
+	if err != nil || header != logHeader+"\n" {
+		return nil, fmt.Errorf("[%v] is not an audit log file", path)
+	}
+
+	entries := make([]*Entry, 0)
+	previousHash := ""
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read audit log file: [%v]", err)
+		}
+
+		sequence := uint64(len(entries) + 1)
+
+		entry := &Entry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			return nil, fmt.Errorf(
+				"could not parse entry [%v]: [%v]",
+				sequence,
+				err,
+			)
+		}
+
+		if entry.Sequence != sequence {
+			return nil, fmt.Errorf(
+				"entry [%v] has unexpected sequence number [%v]",
+				sequence,
+				entry.Sequence,
+			)
+		}
+
+		if entry.PreviousHash != previousHash {
+			return nil, fmt.Errorf(
+				"entry [%v] does not follow the previous entry",
+				sequence,
+			)
+		}
+
+		hash, err := entry.computeHash()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not compute hash of entry [%v]: [%v]",
+				sequence,
+				err,
+			)
+		}
+
+		if entry.Hash != hash {
+			return nil, fmt.Errorf(
+				"entry [%v] does not match its hash",
+				sequence,
+			)
+		}
+
+		entries = append(entries, entry)
+		previousHash = entry.Hash
+	}
+}
+
+// IsLogFile checks if the file under the given path is an audit log file.
+func IsLogFile(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	header := make([]byte, len(logHeader)+1)
+	if _, err := io.ReadFull(file, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return string(header) == logHeader+"\n", nil
+}
@@ -0,0 +1,236 @@
+package audit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	keepAddress = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	digest      = [32]byte{1, 2, 3}
+)
+
+func TestRecordAndRead(t *testing.T) {
+	path, cleanup := newLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog.Record(KeyGenerationStarted, keepAddress, [32]byte{}, nil)
+	auditLog.Record(KeyGenerationFinished, keepAddress, [32]byte{}, nil)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the log to make sure the chain is continued.
+	auditLog, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog.Record(
+		TransactionSubmitted,
+		keepAddress,
+		digest,
+		map[string]string{"transaction": "0x01"},
+	)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("unexpected number of entries: [%v]", len(entries))
+	}
+
+	expectedEvents := []Event{
+		KeyGenerationStarted,
+		KeyGenerationFinished,
+		TransactionSubmitted,
+	}
+	for i, entry := range entries {
+		if entry.Sequence != uint64(i+1) {
+			t.Errorf("unexpected sequence of entry [%v]: [%v]", i, entry.Sequence)
+		}
+		if entry.Event != expectedEvents[i] {
+			t.Errorf("unexpected event of entry [%v]: [%v]", i, entry.Event)
+		}
+		if entry.KeepAddress != keepAddress.Hex() {
+			t.Errorf("unexpected keep address of entry [%v]: [%v]", i, entry.KeepAddress)
+		}
+	}
+
+	if entries[0].Digest != "" {
+		t.Errorf("unexpected digest: [%v]", entries[0].Digest)
+	}
+	if entries[2].Digest != "0102030000000000000000000000000000000000000000000000000000000000" {
+		t.Errorf("unexpected digest: [%v]", entries[2].Digest)
+	}
+	if entries[2].Details["transaction"] != "0x01" {
+		t.Errorf("unexpected details: [%v]", entries[2].Details)
+	}
+}
+
+func TestRead_DetectsTampering(t *testing.T) {
+	path, cleanup := newLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog.Record(SigningRequestAccepted, keepAddress, digest, nil)
+	auditLog.Record(SignatureProduced, keepAddress, digest, nil)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string][]byte{
+		"modified entry": bytes.Replace(
+			content,
+			[]byte(SignatureProduced),
+			[]byte(SigningRequestRefused),
+			1,
+		),
+		"removed entry": removeLine(content, 1),
+	}
+
+	for testName, tamperedContent := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if err := ioutil.WriteFile(path, tamperedContent, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := Read(path); err == nil {
+				t.Errorf("expected verification failure")
+			}
+		})
+	}
+}
+
+func TestOpen_DiscardsIncompleteLine(t *testing.T) {
+	path, cleanup := newLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog.Record(KeyGenerationStarted, keepAddress, [32]byte{}, nil)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash in the middle of an entry write.
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write([]byte(`{"Sequence":2,"Ti`)); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	auditLog, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLog.Record(KeyGenerationFinished, keepAddress, [32]byte{}, nil)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("unexpected number of entries: [%v]", len(entries))
+	}
+	if entries[1].Event != KeyGenerationFinished {
+		t.Errorf("unexpected event: [%v]", entries[1].Event)
+	}
+}
+
+func TestRecord_NilLog(t *testing.T) {
+	var auditLog *Log
+
+	// Should not panic.
+	auditLog.Record(KeyGenerationStarted, keepAddress, [32]byte{}, nil)
+
+	if err := auditLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsLogFile(t *testing.T) {
+	path, cleanup := newLogPath(t)
+	defer cleanup()
+
+	auditLog, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auditLog.Close()
+
+	isLogFile, err := IsLogFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isLogFile {
+		t.Errorf("audit log file not recognized")
+	}
+
+	otherPath := filepath.Join(filepath.Dir(path), "other")
+	if err := ioutil.WriteFile(otherPath, []byte("other"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	isLogFile, err = IsLogFile(otherPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isLogFile {
+		t.Errorf("other file recognized as audit log file")
+	}
+}
+
+func newLogPath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "audit-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return filepath.Join(dir, "audit.log"), func() { os.RemoveAll(dir) }
+}
+
+func removeLine(content []byte, index int) []byte {
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	return bytes.Join(append(lines[:index:index], lines[index+1:]...), nil)
+}
@@ -0,0 +1,122 @@
+// Package backoff provides policies determining how long to wait between
+// subsequent attempts of a retried operation.
+package backoff
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Policy determines the delay before the given attempt of a retried operation.
+// Attempts are numbered starting from 1.
+type Policy interface {
+	Delay(attempt int) time.Duration
+}
+
+type constantPolicy struct {
+	delay time.Duration
+}
+
+// NewConstant returns a policy with the same delay for every attempt.
+func NewConstant(delay time.Duration) Policy {
+	return &constantPolicy{delay}
+}
+
+func (cp *constantPolicy) Delay(attempt int) time.Duration {
+	return cp.delay
+}
+
+type exponentialPolicy struct {
+	initialDelay time.Duration
+	multiplier   float64
+}
+
+// NewExponential returns a policy with the delay of the first attempt equal
+// to the initial delay and multiplied by the multiplier for every subsequent
+// attempt.
+func NewExponential(initialDelay time.Duration, multiplier float64) Policy {
+	return &exponentialPolicy{
+		initialDelay: initialDelay,
+		multiplier:   multiplier,
+	}
+}
+
+func (ep *exponentialPolicy) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(ep.initialDelay) * math.Pow(ep.multiplier, float64(attempt-1))
+
+	// Protect against an overflow for a high number of attempts.
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(delay)
+}
+
+type cappedPolicy struct {
+	policy   Policy
+	maxDelay time.Duration
+}
+
+// WithCap returns a policy limiting delays of the given policy to the maximum
+// delay.
+func WithCap(policy Policy, maxDelay time.Duration) Policy {
+	return &cappedPolicy{
+		policy:   policy,
+		maxDelay: maxDelay,
+	}
+}
+
+func (cp *cappedPolicy) Delay(attempt int) time.Duration {
+	delay := cp.policy.Delay(attempt)
+	if delay > cp.maxDelay {
+		return cp.maxDelay
+	}
+
+	return delay
+}
+
+type jitteredPolicy struct {
+	policy Policy
+	jitter time.Duration
+}
+
+// WithJitter returns a policy adding a random value from [0, jitter) range to
+// delays of the given policy. Jitter prevents many clients retrying the same
+// operation at the same time.
+func WithJitter(policy Policy, jitter time.Duration) Policy {
+	return &jitteredPolicy{
+		policy: policy,
+		jitter: jitter,
+	}
+}
+
+func (jp *jitteredPolicy) Delay(attempt int) time.Duration {
+	delay := jp.policy.Delay(attempt)
+	if jp.jitter <= 0 {
+		return delay
+	}
+
+	// #nosec G404 (insecure random number source (rand))
+	// No need to use secure randomness for jitter value.
+	return delay + time.Duration(rand.Int63n(int64(jp.jitter)))
+}
+
+// Wait blocks for the delay determined by the policy for the given attempt.
+// It returns earlier with the context error if the context is done.
+func Wait(ctx context.Context, policy Policy, attempt int) error {
+	timer := time.NewTimer(policy.Delay(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
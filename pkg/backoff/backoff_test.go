@@ -0,0 +1,116 @@
+package backoff
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConstant(t *testing.T) {
+	policy := NewConstant(5 * time.Second)
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		assertDelay(t, policy, attempt, 5*time.Second)
+	}
+}
+
+func TestExponential(t *testing.T) {
+	policy := NewExponential(1*time.Second, 2)
+
+	assertDelay(t, policy, 1, 1*time.Second)
+	assertDelay(t, policy, 2, 2*time.Second)
+	assertDelay(t, policy, 3, 4*time.Second)
+	assertDelay(t, policy, 4, 8*time.Second)
+}
+
+func TestExponential_Overflow(t *testing.T) {
+	policy := NewExponential(1*time.Second, 2)
+
+	delay := policy.Delay(1000)
+	if delay <= 0 {
+		t.Errorf("unexpected delay: [%v]", delay)
+	}
+}
+
+func TestWithCap(t *testing.T) {
+	policy := WithCap(NewExponential(1*time.Second, 2), 3*time.Second)
+
+	assertDelay(t, policy, 1, 1*time.Second)
+	assertDelay(t, policy, 2, 2*time.Second)
+	assertDelay(t, policy, 3, 3*time.Second)
+	assertDelay(t, policy, 10, 3*time.Second)
+}
+
+func TestWithJitter(t *testing.T) {
+	jitter := 100 * time.Millisecond
+	policy := WithJitter(NewConstant(1*time.Second), jitter)
+
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(1)
+		if delay < 1*time.Second || delay >= 1*time.Second+jitter {
+			t.Fatalf("delay [%v] out of the expected range", delay)
+		}
+	}
+}
+
+func TestConfigPolicy(t *testing.T) {
+	defaults := NewConfig(1*time.Second, 2, 10*time.Second, 0, 5)
+
+	config := &Config{Multiplier: 3}
+	policy := config.Policy(defaults)
+
+	assertDelay(t, policy, 1, 1*time.Second)
+	assertDelay(t, policy, 2, 3*time.Second)
+	assertDelay(t, policy, 3, 9*time.Second)
+	assertDelay(t, policy, 4, 10*time.Second)
+
+	if maxAttempts := config.GetMaxAttempts(defaults); maxAttempts != 5 {
+		t.Errorf(
+			"unexpected max attempts\nexpected: [%v]\nactual:   [%v]",
+			5,
+			maxAttempts,
+		)
+	}
+}
+
+func TestConfigPolicy_Constant(t *testing.T) {
+	defaults := NewConfig(1*time.Second, 2, 10*time.Second, 0, 5)
+
+	config := &Config{Multiplier: 1}
+	policy := config.Policy(defaults)
+
+	assertDelay(t, policy, 1, 1*time.Second)
+	assertDelay(t, policy, 5, 1*time.Second)
+}
+
+func TestWait_ContextDone(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	cancelCtx()
+
+	err := Wait(ctx, NewConstant(1*time.Minute), 1)
+	if err != context.Canceled {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			context.Canceled,
+			err,
+		)
+	}
+}
+
+func assertDelay(
+	t *testing.T,
+	policy Policy,
+	attempt int,
+	expectedDelay time.Duration,
+) {
+	if delay := policy.Delay(attempt); delay != expectedDelay {
+		t.Errorf(
+			"unexpected delay for attempt [%v]\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			attempt,
+			expectedDelay,
+			delay,
+		)
+	}
+}
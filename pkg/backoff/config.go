@@ -0,0 +1,87 @@
+package backoff
+
+import (
+	"time"
+
+	configtime "github.com/keep-network/keep-ecdsa/internal/config/time"
+)
+
+// Config contains configuration of a backoff policy. The delay of the first
+// attempt is equal to the initial delay and it's multiplied by the multiplier
+// for every subsequent attempt, up to the maximum delay. A random jitter is
+// added to every delay. Multiplier equal to 1 results in a constant delay.
+type Config struct {
+	InitialDelay configtime.Duration
+	Multiplier   float64
+	MaxDelay     configtime.Duration
+	Jitter       configtime.Duration
+
+	// Maximum number of attempts of the operation. Used only by operations
+	// which give up after a certain number of attempts.
+	MaxAttempts int
+}
+
+// Policy returns the configured backoff policy. Values which are not set
+// are taken from the provided defaults.
+func (c *Config) Policy(defaults *Config) Policy {
+	initialDelay := c.InitialDelay.ToDuration()
+	if initialDelay == 0 {
+		initialDelay = defaults.InitialDelay.ToDuration()
+	}
+
+	multiplier := c.Multiplier
+	if multiplier == 0 {
+		multiplier = defaults.Multiplier
+	}
+
+	maxDelay := c.MaxDelay.ToDuration()
+	if maxDelay == 0 {
+		maxDelay = defaults.MaxDelay.ToDuration()
+	}
+
+	jitter := c.Jitter.ToDuration()
+	if jitter == 0 {
+		jitter = defaults.Jitter.ToDuration()
+	}
+
+	var policy Policy
+	if multiplier == 1 {
+		policy = NewConstant(initialDelay)
+	} else {
+		policy = NewExponential(initialDelay, multiplier)
+	}
+
+	if maxDelay > 0 {
+		policy = WithCap(policy, maxDelay)
+	}
+
+	return WithJitter(policy, jitter)
+}
+
+// GetMaxAttempts returns the maximum number of attempts. If a value is not set
+// it returns the value from the provided defaults.
+func (c *Config) GetMaxAttempts(defaults *Config) int {
+	if c.MaxAttempts == 0 {
+		return defaults.MaxAttempts
+	}
+
+	return c.MaxAttempts
+}
+
+// NewConfig returns a configuration with the given values. It's meant to be
+// used for defining defaults.
+func NewConfig(
+	initialDelay time.Duration,
+	multiplier float64,
+	maxDelay time.Duration,
+	jitter time.Duration,
+	maxAttempts int,
+) *Config {
+	return &Config{
+		InitialDelay: configtime.Duration{Duration: initialDelay},
+		Multiplier:   multiplier,
+		MaxDelay:     configtime.Duration{Duration: maxDelay},
+		Jitter:       configtime.Duration{Duration: jitter},
+		MaxAttempts:  maxAttempts,
+	}
+}
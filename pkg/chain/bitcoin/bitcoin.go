@@ -0,0 +1,72 @@
+// Package bitcoin contains an interface to the Bitcoin chain used to observe
+// transactions spending funds held by keeps.
+package bitcoin
+
+import (
+	cecdsa "crypto/ecdsa"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// Supported Bitcoin networks.
+const (
+	Mainnet = "mainnet"
+	Testnet = "testnet"
+	Regtest = "regtest"
+)
+
+// Handle represents a handle to the Bitcoin chain.
+type Handle interface {
+	// SpendingTransactions returns recent transactions, confirmed or not,
+	// spending outputs locked to the given address.
+	SpendingTransactions(address string) ([]*Transaction, error)
+}
+
+// Transaction is a Bitcoin transaction along with values of the outputs spent
+// by its inputs, in the order of the inputs.
+type Transaction struct {
+	ID          string
+	Raw         []byte
+	InputValues []int64
+}
+
+// WitnessPublicKeyHashAddress returns the P2WPKH address of the given public
+// key in the given Bitcoin network. Keeps hold funds locked to such
+// addresses.
+func WitnessPublicKeyHashAddress(
+	publicKey *cecdsa.PublicKey,
+	network string,
+) (string, error) {
+	params, err := networkParams(network)
+	if err != nil {
+		return "", err
+	}
+
+	compressedPublicKey := (*btcec.PublicKey)(publicKey).SerializeCompressed()
+
+	address, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(compressedPublicKey),
+		params,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create address: [%v]", err)
+	}
+
+	return address.EncodeAddress(), nil
+}
+
+func networkParams(network string) (*chaincfg.Params, error) {
+	switch network {
+	case "", Mainnet:
+		return &chaincfg.MainNetParams, nil
+	case Testnet:
+		return &chaincfg.TestNet3Params, nil
+	case Regtest:
+		return &chaincfg.RegressionNetParams, nil
+	default:
+		return nil, fmt.Errorf("unknown bitcoin network [%s]", network)
+	}
+}
@@ -0,0 +1,66 @@
+package bitcoin
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestWitnessPublicKeyHashAddress(t *testing.T) {
+	// Public key of the private key 1, i.e. the generator point, used in
+	// BIP-173 test vectors.
+	privateKey, err := crypto.HexToECDSA(
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		network         string
+		expectedAddress string
+	}{
+		"mainnet": {
+			network:         Mainnet,
+			expectedAddress: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		},
+		"testnet": {
+			network:         Testnet,
+			expectedAddress: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			address, err := WitnessPublicKeyHashAddress(
+				&privateKey.PublicKey,
+				test.network,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if address != test.expectedAddress {
+				t.Errorf(
+					"unexpected address\nexpected: [%v]\nactual:   [%v]",
+					test.expectedAddress,
+					address,
+				)
+			}
+		})
+	}
+}
+
+func TestWitnessPublicKeyHashAddress_UnknownNetwork(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := WitnessPublicKeyHashAddress(
+		&privateKey.PublicKey,
+		"simnet",
+	); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,135 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// esploraRequestTimeout is the timeout of a single request to the Esplora
+// API.
+const esploraRequestTimeout = 30 * time.Second
+
+// esploraHandle reads Bitcoin transactions from the Esplora REST API,
+// e.g. exposed by an Electrs server.
+type esploraHandle struct {
+	url    string
+	client *http.Client
+}
+
+// NewEsploraHandle returns a handle reading Bitcoin transactions from the
+// Esplora REST API available under the given URL.
+func NewEsploraHandle(url string) Handle {
+	return &esploraHandle{
+		url: strings.TrimSuffix(url, "/"),
+		client: &http.Client{
+			Timeout: esploraRequestTimeout,
+		},
+	}
+}
+
+type esploraTransaction struct {
+	TxID string `json:"txid"`
+	Vin  []struct {
+		IsCoinbase bool `json:"is_coinbase"`
+		Prevout    *struct {
+			ScriptPubKeyAddress string `json:"scriptpubkey_address"`
+			Value               int64  `json:"value"`
+		} `json:"prevout"`
+	} `json:"vin"`
+}
+
+// SpendingTransactions returns transactions spending outputs locked to the
+// given address. Esplora returns up to 50 unconfirmed and 25 most recent
+// confirmed transactions of the address, so the address has to be checked
+// often enough not to miss any transaction.
+func (eh *esploraHandle) SpendingTransactions(
+	address string,
+) ([]*Transaction, error) {
+	var addressTransactions []*esploraTransaction
+	if err := eh.get(
+		fmt.Sprintf("/address/%s/txs", address),
+		func(body []byte) error {
+			return json.Unmarshal(body, &addressTransactions)
+		},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"failed to get transactions of address [%s]: [%v]",
+			address,
+			err,
+		)
+	}
+
+	transactions := make([]*Transaction, 0)
+
+	for _, addressTransaction := range addressTransactions {
+		isSpending := false
+		inputValues := make([]int64, len(addressTransaction.Vin))
+
+		for i, vin := range addressTransaction.Vin {
+			if vin.IsCoinbase || vin.Prevout == nil {
+				continue
+			}
+
+			inputValues[i] = vin.Prevout.Value
+			if vin.Prevout.ScriptPubKeyAddress == address {
+				isSpending = true
+			}
+		}
+
+		if !isSpending {
+			continue
+		}
+
+		var raw []byte
+		if err := eh.get(
+			fmt.Sprintf("/tx/%s/hex", addressTransaction.TxID),
+			func(body []byte) error {
+				var err error
+				raw, err = hex.DecodeString(strings.TrimSpace(string(body)))
+				return err
+			},
+		); err != nil {
+			return nil, fmt.Errorf(
+				"failed to get transaction [%s]: [%v]",
+				addressTransaction.TxID,
+				err,
+			)
+		}
+
+		transactions = append(transactions, &Transaction{
+			ID:          addressTransaction.TxID,
+			Raw:         raw,
+			InputValues: inputValues,
+		})
+	}
+
+	return transactions, nil
+}
+
+func (eh *esploraHandle) get(path string, decode func(body []byte) error) error {
+	response, err := eh.client.Get(eh.url + path)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"unexpected response status [%s]: [%s]",
+			response.Status,
+			strings.TrimSpace(string(body)),
+		)
+	}
+
+	return decode(body)
+}
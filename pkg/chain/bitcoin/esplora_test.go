@@ -0,0 +1,75 @@
+package bitcoin
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestEsploraHandle_SpendingTransactions(t *testing.T) {
+	address := "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		fmt.Sprintf("/address/%s/txs", address),
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `[
+				{
+					"txid": "spending",
+					"vin": [
+						{"prevout": {"scriptpubkey_address": "other", "value": 100}},
+						{"prevout": {"scriptpubkey_address": "`+address+`", "value": 200}}
+					]
+				},
+				{
+					"txid": "funding",
+					"vin": [
+						{"prevout": {"scriptpubkey_address": "other", "value": 300}}
+					]
+				}
+			]`)
+		},
+	)
+	mux.HandleFunc("/tx/spending/hex", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "0102ff\n")
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	transactions, err := NewEsploraHandle(server.URL + "/").SpendingTransactions(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTransactions := []*Transaction{
+		{
+			ID:          "spending",
+			Raw:         []byte{0x01, 0x02, 0xff},
+			InputValues: []int64{100, 200},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedTransactions, transactions) {
+		t.Errorf(
+			"unexpected transactions\nexpected: [%+v]\nactual:   [%+v]",
+			expectedTransactions,
+			transactions,
+		)
+	}
+}
+
+func TestEsploraHandle_SpendingTransactions_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "invalid address", http.StatusBadRequest)
+		},
+	))
+	defer server.Close()
+
+	if _, err := NewEsploraHandle(server.URL).SpendingTransactions("invalid"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
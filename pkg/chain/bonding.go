@@ -0,0 +1,45 @@
+package eth
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Bonding is an interface that provides ability to interact with the
+// KeepBonding ethereum contract holding ETH bonded by operators for keeps.
+// All values are expressed in wei.
+type Bonding interface {
+	// UnbondedValue returns the value deposited by the operator which is not
+	// bonded for any keep.
+	UnbondedValue(operator common.Address) (*big.Int, error)
+
+	// AvailableUnbondedValue returns the unbonded value of the operator
+	// which can be bonded by the sortition pool of the given application.
+	// If the pool is not authorized by the operator, zero is returned.
+	AvailableUnbondedValue(
+		operator common.Address,
+		application common.Address,
+	) (*big.Int, error)
+
+	// BondAmount returns the value bonded by the operator for the given keep.
+	BondAmount(
+		operator common.Address,
+		keepAddress common.Address,
+	) (*big.Int, error)
+
+	// DepositUnbondedValue deposits the given value as unbonded value of the
+	// operator. It returns the hash of the deposit transaction.
+	DepositUnbondedValue(
+		operator common.Address,
+		value *big.Int,
+	) (common.Hash, error)
+
+	// WithdrawUnbondedValue withdraws the given amount of the operator's
+	// unbonded value to the operator's beneficiary. It returns the hash of
+	// the withdrawal transaction.
+	WithdrawUnbondedValue(
+		operator common.Address,
+		amount *big.Int,
+	) (common.Hash, error)
+}
@@ -68,6 +68,14 @@ type BondedECDSAKeepFactory interface {
 
 	// GetKeepAtIndex returns the address of the keep at the given index.
 	GetKeepAtIndex(keepIndex *big.Int) (common.Address, error)
+
+	// PastBondedECDSAKeepCreatedEvents returns all keep created events
+	// which occurred after the provided start block. All implementations
+	// should returns those events sorted by the block number in the
+	// ascending order.
+	PastBondedECDSAKeepCreatedEvents(
+		startBlock uint64,
+	) ([]*BondedECDSAKeepCreatedEvent, error)
 }
 
 // BondedECDSAKeep is an interface that provides ability to interact with
@@ -96,15 +104,44 @@ type BondedECDSAKeep interface {
 	) (subscription.EventSubscription, error)
 
 	// SubmitKeepPublicKey submits a 64-byte serialized public key to a keep
-	// contract deployed under a given address.
-	SubmitKeepPublicKey(keepAddress common.Address, publicKey [64]byte) error // TODO: Add promise *async.KeepPublicKeySubmissionPromise
+	// contract deployed under a given address. It returns the hash of the
+	// submission transaction.
+	SubmitKeepPublicKey(keepAddress common.Address, publicKey [64]byte) (common.Hash, error) // TODO: Add promise *async.KeepPublicKeySubmissionPromise
 
 	// SubmitSignature submits a signature to a keep contract deployed under a
-	// given address.
+	// given address. It returns the hash of the submission transaction.
 	SubmitSignature(
 		keepAddress common.Address,
 		signature *ecdsa.Signature,
-	) error // TODO: Add promise *async.SignatureSubmissionPromise
+	) (common.Hash, error) // TODO: Add promise *async.SignatureSubmissionPromise
+
+	// OnSignatureSubmitted installs a callback that is invoked upon
+	// notification of a signature submitted to the given keep.
+	OnSignatureSubmitted(
+		keepAddress common.Address,
+		handler func(event *SignatureSubmittedEvent),
+	) (subscription.EventSubscription, error)
+
+	// CheckSignatureFraud checks if the given signature calculated with the
+	// keep's key over the given digest is a fraud, i.e. the keep has never
+	// been requested to sign the digest. Preimage is the value whose SHA-256
+	// hash is the signed digest.
+	CheckSignatureFraud(
+		keepAddress common.Address,
+		signature *ecdsa.Signature,
+		signedDigest [32]byte,
+		preimage []byte,
+	) (bool, error)
+
+	// SubmitSignatureFraud submits a proof that the keep's key has been used
+	// to sign a digest which the keep has never been requested to sign.
+	// Preimage is the value whose SHA-256 hash is the signed digest.
+	SubmitSignatureFraud(
+		keepAddress common.Address,
+		signature *ecdsa.Signature,
+		signedDigest [32]byte,
+		preimage []byte,
+	) error
 
 	// OnKeepClosed installs a callback that will be called on closing the
 	// given keep.
@@ -146,6 +183,24 @@ type BondedECDSAKeep interface {
 	// GetHonestThreshold returns keep's honest threshold.
 	GetHonestThreshold(keepAddress common.Address) (uint64, error)
 
+	// GetOwner returns the address of the keep's owner.
+	GetOwner(keepAddress common.Address) (common.Address, error)
+
+	// GetMemberETHBalance returns the ETH balance in wei of the keep member
+	// which can be withdrawn from the keep.
+	GetMemberETHBalance(
+		keepAddress common.Address,
+		member common.Address,
+	) (*big.Int, error)
+
+	// WithdrawMemberETHBalance withdraws the ETH balance of the keep member
+	// to the member's beneficiary. It returns the hash of the withdrawal
+	// transaction.
+	WithdrawMemberETHBalance(
+		keepAddress common.Address,
+		member common.Address,
+	) (common.Hash, error)
+
 	// GetOpenedTimestamp returns timestamp when the keep was created.
 	GetOpenedTimestamp(keepAddress common.Address) (time.Time, error)
 
@@ -157,4 +212,31 @@ type BondedECDSAKeep interface {
 		keepAddress string,
 		startBlock uint64,
 	) ([]*SignatureSubmittedEvent, error)
+
+	// PastSignatureRequestedEvents returns all signature requested events
+	// for the given keep which occurred after the provided start block.
+	// All implementations should returns those events sorted by the
+	// block number in the ascending order.
+	PastSignatureRequestedEvents(
+		keepAddress string,
+		startBlock uint64,
+	) ([]*SignatureRequestedEvent, error)
+
+	// PastKeepClosedEvents returns all keep closed events for the given keep
+	// which occurred after the provided start block. All implementations
+	// should returns those events sorted by the block number in the
+	// ascending order.
+	PastKeepClosedEvents(
+		keepAddress string,
+		startBlock uint64,
+	) ([]*KeepClosedEvent, error)
+
+	// PastKeepTerminatedEvents returns all keep terminated events for the
+	// given keep which occurred after the provided start block. All
+	// implementations should returns those events sorted by the block number
+	// in the ascending order.
+	PastKeepTerminatedEvents(
+		keepAddress string,
+		startBlock uint64,
+	) ([]*KeepTerminatedEvent, error)
 }
@@ -0,0 +1,262 @@
+package eth
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/chain/chainutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+)
+
+var logger = log.Logger("keep-chain")
+
+// ConfirmedEvents delivers events of keeps and the keep factory only after
+// the given number of blocks has been mined on top of the block in which they
+// were emitted. Before an event is delivered, it is looked up again in the
+// chain history at its block, so events removed by a chain reorganization are
+// not delivered. Events redelivered by the underlying subscriptions are
+// delivered only once.
+type ConfirmedEvents struct {
+	handle        Handle
+	confirmations uint64
+
+	mutex sync.Mutex
+	// Keys of events which are being confirmed or have been delivered.
+	seenEvents map[string]bool
+}
+
+// NewConfirmedEvents creates confirmed event streams on top of the given chain
+// handle.
+func NewConfirmedEvents(handle Handle, confirmations uint64) *ConfirmedEvents {
+	return &ConfirmedEvents{
+		handle:        handle,
+		confirmations: confirmations,
+		seenEvents:    make(map[string]bool),
+	}
+}
+
+// Confirmations returns the number of blocks after which events are
+// considered confirmed.
+func (ce *ConfirmedEvents) Confirmations() uint64 {
+	return ce.confirmations
+}
+
+// OnBondedECDSAKeepCreated installs a callback that is invoked when a new
+// bonded ECDSA keep creation has been confirmed.
+func (ce *ConfirmedEvents) OnBondedECDSAKeepCreated(
+	handler func(event *BondedECDSAKeepCreatedEvent),
+) subscription.EventSubscription {
+	return ce.handle.OnBondedECDSAKeepCreated(
+		func(event *BondedECDSAKeepCreatedEvent) {
+			go ce.confirm(
+				fmt.Sprintf("keep-created-%s", event.KeepAddress.String()),
+				event.BlockNumber,
+				func() (bool, error) {
+					pastEvents, err := ce.handle.PastBondedECDSAKeepCreatedEvents(
+						event.BlockNumber,
+					)
+					if err != nil {
+						return false, err
+					}
+
+					for _, pastEvent := range pastEvents {
+						if pastEvent.KeepAddress == event.KeepAddress &&
+							pastEvent.BlockNumber == event.BlockNumber {
+							return true, nil
+						}
+					}
+
+					return false, nil
+				},
+				func() { handler(event) },
+			)
+		},
+	)
+}
+
+// OnSignatureRequested installs a callback that is invoked when a new signing
+// request for the given keep has been confirmed.
+func (ce *ConfirmedEvents) OnSignatureRequested(
+	keepAddress common.Address,
+	handler func(event *SignatureRequestedEvent),
+) (subscription.EventSubscription, error) {
+	return ce.handle.OnSignatureRequested(
+		keepAddress,
+		func(event *SignatureRequestedEvent) {
+			go ce.confirm(
+				fmt.Sprintf(
+					"signature-requested-%s-%x-%d",
+					keepAddress.String(),
+					event.Digest,
+					event.BlockNumber,
+				),
+				event.BlockNumber,
+				func() (bool, error) {
+					pastEvents, err := ce.handle.PastSignatureRequestedEvents(
+						keepAddress.Hex(),
+						event.BlockNumber,
+					)
+					if err != nil {
+						return false, err
+					}
+
+					for _, pastEvent := range pastEvents {
+						if pastEvent.Digest == event.Digest &&
+							pastEvent.BlockNumber == event.BlockNumber {
+							return true, nil
+						}
+					}
+
+					return false, nil
+				},
+				func() { handler(event) },
+			)
+		},
+	)
+}
+
+// OnKeepClosed installs a callback that is invoked when closing of the given
+// keep has been confirmed.
+func (ce *ConfirmedEvents) OnKeepClosed(
+	keepAddress common.Address,
+	handler func(event *KeepClosedEvent),
+) (subscription.EventSubscription, error) {
+	return ce.handle.OnKeepClosed(
+		keepAddress,
+		func(event *KeepClosedEvent) {
+			go ce.confirm(
+				fmt.Sprintf(
+					"keep-closed-%s-%d",
+					keepAddress.String(),
+					event.BlockNumber,
+				),
+				event.BlockNumber,
+				func() (bool, error) {
+					pastEvents, err := ce.handle.PastKeepClosedEvents(
+						keepAddress.Hex(),
+						event.BlockNumber,
+					)
+					if err != nil {
+						return false, err
+					}
+
+					for _, pastEvent := range pastEvents {
+						if pastEvent.BlockNumber == event.BlockNumber {
+							return true, nil
+						}
+					}
+
+					return false, nil
+				},
+				func() { handler(event) },
+			)
+		},
+	)
+}
+
+// OnKeepTerminated installs a callback that is invoked when termination of
+// the given keep has been confirmed.
+func (ce *ConfirmedEvents) OnKeepTerminated(
+	keepAddress common.Address,
+	handler func(event *KeepTerminatedEvent),
+) (subscription.EventSubscription, error) {
+	return ce.handle.OnKeepTerminated(
+		keepAddress,
+		func(event *KeepTerminatedEvent) {
+			go ce.confirm(
+				fmt.Sprintf(
+					"keep-terminated-%s-%d",
+					keepAddress.String(),
+					event.BlockNumber,
+				),
+				event.BlockNumber,
+				func() (bool, error) {
+					pastEvents, err := ce.handle.PastKeepTerminatedEvents(
+						keepAddress.Hex(),
+						event.BlockNumber,
+					)
+					if err != nil {
+						return false, err
+					}
+
+					for _, pastEvent := range pastEvents {
+						if pastEvent.BlockNumber == event.BlockNumber {
+							return true, nil
+						}
+					}
+
+					return false, nil
+				},
+				func() { handler(event) },
+			)
+		},
+	)
+}
+
+// confirm waits for the confirmations of the event emitted in the given block
+// and delivers it if it is still present on-chain. Events which have already
+// been seen are ignored. If the event could not be confirmed, it is forgotten
+// so it can be delivered again, e.g. when it is included in another block
+// after a chain reorganization.
+func (ce *ConfirmedEvents) confirm(
+	eventKey string,
+	blockNumber uint64,
+	isOnChain func() (bool, error),
+	deliver func(),
+) {
+	if !ce.markSeen(eventKey) {
+		logger.Debugf("event [%s] has already been seen", eventKey)
+		return
+	}
+
+	confirmed, err := chainutil.WaitForBlockConfirmations(
+		ce.handle.BlockCounter(),
+		blockNumber,
+		ce.confirmations,
+		isOnChain,
+	)
+	if err != nil {
+		logger.Errorf(
+			"failed to confirm event [%s] emitted at block [%d]: [%v]",
+			eventKey,
+			blockNumber,
+			err,
+		)
+		ce.forget(eventKey)
+		return
+	}
+
+	if !confirmed {
+		logger.Warningf(
+			"event [%s] emitted at block [%d] has been removed "+
+				"by a chain reorganization",
+			eventKey,
+			blockNumber,
+		)
+		ce.forget(eventKey)
+		return
+	}
+
+	deliver()
+}
+
+func (ce *ConfirmedEvents) markSeen(eventKey string) bool {
+	ce.mutex.Lock()
+	defer ce.mutex.Unlock()
+
+	if ce.seenEvents[eventKey] {
+		return false
+	}
+
+	ce.seenEvents[eventKey] = true
+	return true
+}
+
+func (ce *ConfirmedEvents) forget(eventKey string) {
+	ce.mutex.Lock()
+	defer ce.mutex.Unlock()
+
+	delete(ce.seenEvents, eventKey)
+}
@@ -0,0 +1,98 @@
+package eth_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+func TestConfirmedEventsDeliversConfirmedEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := local.Connect(ctx)
+	confirmedEvents := eth.NewConfirmedEvents(chain, 2)
+
+	keepAddress := common.HexToAddress("0x41048F9B90290A2e96D07f537F3A7E97620E9e47")
+	chain.OpenKeep(keepAddress, []common.Address{})
+
+	eventChan := make(chan *eth.KeepClosedEvent, 1)
+	subscription, err := confirmedEvents.OnKeepClosed(
+		keepAddress,
+		func(event *eth.KeepClosedEvent) {
+			eventChan <- event
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Unsubscribe()
+
+	if err := chain.CloseKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-eventChan:
+		currentBlock, err := chain.BlockCounter().CurrentBlock()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if currentBlock < event.BlockNumber+confirmedEvents.Confirmations() {
+			t.Errorf(
+				"event from block [%v] delivered before confirmation; "+
+					"current block: [%v]",
+				event.BlockNumber,
+				currentBlock,
+			)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected confirmed event to be delivered")
+	}
+}
+
+func TestConfirmedEventsDeliversEventOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := local.Connect(ctx)
+	confirmedEvents := eth.NewConfirmedEvents(chain, 1)
+
+	keepAddress := common.HexToAddress("0x41048F9B90290A2e96D07f537F3A7E97620E9e47")
+	chain.OpenKeep(keepAddress, []common.Address{})
+
+	var deliveries int32
+	handler := func(event *eth.KeepClosedEvent) {
+		atomic.AddInt32(&deliveries, 1)
+	}
+
+	// The same event is received by both subscriptions, just like an event
+	// redelivered by the underlying chain subscription.
+	for i := 0; i < 2; i++ {
+		subscription, err := confirmedEvents.OnKeepClosed(keepAddress, handler)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer subscription.Unsubscribe()
+	}
+
+	if err := chain.CloseKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(3 * time.Second)
+
+	if deliveries := atomic.LoadInt32(&deliveries); deliveries != 1 {
+		t.Errorf(
+			"unexpected number of deliveries\nexpected: [%v]\nactual:   [%v]",
+			1,
+			deliveries,
+		)
+	}
+}
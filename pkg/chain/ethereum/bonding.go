@@ -0,0 +1,202 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+)
+
+// UnbondedValue returns the value deposited by the operator which is not
+// bonded for any keep.
+func (ec *EthereumChain) UnbondedValue(operator common.Address) (*big.Int, error) {
+	keepBondingContract, err := ec.getKeepBondingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	return keepBondingContract.UnbondedValue(operator)
+}
+
+// AvailableUnbondedValue returns the unbonded value of the operator which can
+// be bonded by the sortition pool of the given application. For applications
+// using the fully-backed keep factory, the value deposited in the
+// FullyBackedBonding contract is returned.
+func (ec *EthereumChain) AvailableUnbondedValue(
+	operator common.Address,
+	application common.Address,
+) (*big.Int, error) {
+	if ec.keepFactoryTypes.get(application) == FullyBackedKeepFactory {
+		return ec.fullyBackedAvailableUnbondedValue(operator, application)
+	}
+
+	keepBondingContract, err := ec.getKeepBondingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	sortitionPool, err := ec.bondedECDSAKeepFactoryContract.GetSortitionPool(
+		application,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get sortition pool for application [%s]: [%v]",
+			application.Hex(),
+			err,
+		)
+	}
+
+	factoryAddress, err := ec.config.ContractAddress(
+		BondedECDSAKeepFactoryContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return keepBondingContract.AvailableUnbondedValue(
+		operator,
+		*factoryAddress,
+		sortitionPool,
+	)
+}
+
+func (ec *EthereumChain) fullyBackedAvailableUnbondedValue(
+	operator common.Address,
+	application common.Address,
+) (*big.Int, error) {
+	fullyBackedBondingContract, err := ec.getFullyBackedBondingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	sortitionPool, err := ec.fullyBackedECDSAKeepFactoryContract.GetSortitionPool(
+		application,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get sortition pool for application [%s]: [%v]",
+			application.Hex(),
+			err,
+		)
+	}
+
+	factoryAddress, err := ec.config.ContractAddress(
+		FullyBackedECDSAKeepFactoryContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fullyBackedBondingContract.AvailableUnbondedValue(
+		operator,
+		*factoryAddress,
+		sortitionPool,
+	)
+}
+
+// BondAmount returns the value bonded by the operator for the given keep.
+// Bonds are created by the factory with the keep as the holder and the keep
+// address as the reference ID.
+func (ec *EthereumChain) BondAmount(
+	operator common.Address,
+	keepAddress common.Address,
+) (*big.Int, error) {
+	keepBondingContract, err := ec.getKeepBondingContract()
+	if err != nil {
+		return nil, err
+	}
+
+	return keepBondingContract.BondAmount(
+		operator,
+		keepAddress,
+		new(big.Int).SetBytes(keepAddress.Bytes()),
+	)
+}
+
+// DepositUnbondedValue deposits the given value as unbonded value of the
+// operator. It returns the hash of the deposit transaction.
+func (ec *EthereumChain) DepositUnbondedValue(
+	operator common.Address,
+	value *big.Int,
+) (common.Hash, error) {
+	keepBondingContract, err := ec.getKeepBondingContract()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	transaction, err := keepBondingContract.Deposit(operator, value)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	logger.Debugf("submitted Deposit transaction with hash: [%x]", transaction.Hash())
+	ec.describeTransaction(transaction, "Deposit", operator.Hex())
+
+	return transaction.Hash(), nil
+}
+
+// WithdrawUnbondedValue withdraws the given amount of the operator's unbonded
+// value to the operator's beneficiary. It returns the hash of the withdrawal
+// transaction.
+func (ec *EthereumChain) WithdrawUnbondedValue(
+	operator common.Address,
+	amount *big.Int,
+) (common.Hash, error) {
+	keepBondingContract, err := ec.getKeepBondingContract()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	transaction, err := keepBondingContract.Withdraw(amount, operator)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	logger.Debugf("submitted Withdraw transaction with hash: [%x]", transaction.Hash())
+	ec.describeTransaction(transaction, "Withdraw", operator.Hex())
+
+	return transaction.Hash(), nil
+}
+
+// getKeepBondingContract returns a handle of the KeepBonding contract. The
+// contract address is not required by the client so it is read from the
+// config only when bonding is used.
+func (ec *EthereumChain) getKeepBondingContract() (*contract.KeepBonding, error) {
+	keepBondingContractAddress, err := ec.config.ContractAddress(
+		KeepBondingContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.NewKeepBonding(
+		*keepBondingContractAddress,
+		ec.accountKey,
+		ec.client,
+		ec.nonceManager,
+		ec.miningWaiter,
+		ec.transactionMutex,
+	)
+}
+
+// getFullyBackedBondingContract returns a handle of the FullyBackedBonding
+// contract. The contract address is required only when applications using
+// the fully-backed keep factory are configured.
+func (ec *EthereumChain) getFullyBackedBondingContract() (*contract.FullyBackedBonding, error) {
+	fullyBackedBondingContractAddress, err := ec.config.ContractAddress(
+		FullyBackedBondingContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.NewFullyBackedBonding(
+		*fullyBackedBondingContractAddress,
+		ec.accountKey,
+		ec.client,
+		ec.nonceManager,
+		ec.miningWaiter,
+		ec.transactionMutex,
+	)
+}
@@ -2,5 +2,9 @@ package ethereum
 
 // Definitions of contract names.
 const (
-	BondedECDSAKeepFactoryContractName = "BondedECDSAKeepFactory"
+	BondedECDSAKeepFactoryContractName      = "BondedECDSAKeepFactory"
+	KeepBondingContractName                 = "KeepBonding"
+	ECDSARewardsContractName                = "ECDSARewards"
+	FullyBackedECDSAKeepFactoryContractName = "FullyBackedECDSAKeepFactory"
+	FullyBackedBondingContractName          = "FullyBackedBonding"
 )
@@ -12,6 +12,7 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/blockcounter"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-ecdsa/pkg/backoff"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
 )
 
@@ -40,6 +41,13 @@ type EthereumChain struct {
 	miningWaiter                   *ethutil.MiningWaiter
 	nonceManager                   *ethutil.NonceManager
 
+	// fullyBackedECDSAKeepFactoryContract is nil if the fully-backed keep
+	// factory address is not configured. keepFactoryTypes determine which
+	// factory is used for registration in the given application.
+	fullyBackedECDSAKeepFactoryContract *contract.FullyBackedECDSAKeepFactory
+	keepFactoryTypes                    *keepFactoryTypes
+	keepOpenedTimestamps                *keepOpenedTimestamps
+
 	// transactionMutex allows interested parties to forcibly serialize
 	// transaction submission.
 	//
@@ -53,17 +61,45 @@ type EthereumChain struct {
 	// nonce. Serializing submission ensures that each nonce is requested after
 	// a previous transaction has been submitted.
 	transactionMutex *sync.Mutex
+
+	// chainCallsPolicy determines delays between attempts of chain calls
+	// which are retried, up to chainCallsMaxAttempts attempts.
+	chainCallsPolicy      backoff.Policy
+	chainCallsMaxAttempts int
+
+	// subscriptionsPolicy determines delays between attempts to re-establish
+	// failed event subscriptions.
+	subscriptionsPolicy backoff.Policy
+	subscriptions       *subscriptionsTrack
+
+	// transactionJournals note transactions submitted by accounts operating
+	// on this connection.
+	transactionJournals *transactionJournals
 }
 
 // Connect performs initialization for communication with Ethereum blockchain
-// based on provided config.
-func Connect(accountKey *keystore.Key, config *ethereum.Config) (*EthereumChain, error) {
+// based on provided config. Chain calls which are retried use the provided
+// backoff policy and give up after the given maximum number of attempts.
+// Failed event subscriptions are re-established with delays determined by the
+// subscriptions backoff policy.
+func Connect(
+	accountKey *keystore.Key,
+	config *ethereum.Config,
+	chainCallsPolicy backoff.Policy,
+	chainCallsMaxAttempts int,
+	subscriptionsPolicy backoff.Policy,
+) (*EthereumChain, error) {
 	client, err := ethclient.Dial(config.URL)
 	if err != nil {
 		return nil, err
 	}
 
-	wrappedClient := addClientWrappers(config, client)
+	transactionJournals := newTransactionJournals()
+
+	wrappedClient := &journalingClient{
+		EthereumClient: addClientWrappers(config, client),
+		journals:       transactionJournals,
+	}
 
 	transactionMutex := &sync.Mutex{}
 
@@ -101,6 +137,18 @@ func Connect(accountKey *keystore.Key, config *ethereum.Config) (*EthereumChain,
 		return nil, err
 	}
 
+	fullyBackedECDSAKeepFactoryContract, err := newFullyBackedECDSAKeepFactory(
+		config,
+		accountKey,
+		wrappedClient,
+		nonceManager,
+		miningWaiter,
+		transactionMutex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	blockCounter, err := blockcounter.CreateBlockCounter(wrappedClient)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -118,6 +166,84 @@ func Connect(accountKey *keystore.Key, config *ethereum.Config) (*EthereumChain,
 		nonceManager:                   nonceManager,
 		miningWaiter:                   miningWaiter,
 		transactionMutex:               transactionMutex,
+		chainCallsPolicy:               chainCallsPolicy,
+		chainCallsMaxAttempts:          chainCallsMaxAttempts,
+		subscriptionsPolicy:            subscriptionsPolicy,
+		subscriptions:                  newSubscriptionsTrack(),
+		transactionJournals:            transactionJournals,
+
+		fullyBackedECDSAKeepFactoryContract: fullyBackedECDSAKeepFactoryContract,
+		keepFactoryTypes:                    newKeepFactoryTypes(),
+		keepOpenedTimestamps:                newKeepOpenedTimestamps(),
+	}, nil
+}
+
+// WithAccount returns a handle to the same Ethereum connection operating
+// on behalf of another account. The returned handle shares the client, block
+// counter and mining waiter with the original one but has its own nonce
+// manager and transaction serialization, so transactions of both accounts can
+// be submitted concurrently. The state of event subscriptions and keep factory
+// types of applications are shared too.
+// Transactions of the returned handle are journaled once a journal is enabled
+// for it.
+func (ec *EthereumChain) WithAccount(
+	accountKey *keystore.Key,
+) (*EthereumChain, error) {
+	transactionMutex := &sync.Mutex{}
+
+	nonceManager := ethutil.NewNonceManager(
+		accountKey.Address,
+		ec.client,
+	)
+
+	bondedECDSAKeepFactoryContractAddress, err := ec.config.ContractAddress(
+		BondedECDSAKeepFactoryContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	bondedECDSAKeepFactoryContract, err := contract.NewBondedECDSAKeepFactory(
+		*bondedECDSAKeepFactoryContractAddress,
+		accountKey,
+		ec.client,
+		nonceManager,
+		ec.miningWaiter,
+		transactionMutex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fullyBackedECDSAKeepFactoryContract, err := newFullyBackedECDSAKeepFactory(
+		ec.config,
+		accountKey,
+		ec.client,
+		nonceManager,
+		ec.miningWaiter,
+		transactionMutex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EthereumChain{
+		config:                         ec.config,
+		accountKey:                     accountKey,
+		client:                         ec.client,
+		bondedECDSAKeepFactoryContract: bondedECDSAKeepFactoryContract,
+		blockCounter:                   ec.blockCounter,
+		nonceManager:                   nonceManager,
+		miningWaiter:                   ec.miningWaiter,
+		transactionMutex:               transactionMutex,
+		chainCallsPolicy:               ec.chainCallsPolicy,
+		chainCallsMaxAttempts:          ec.chainCallsMaxAttempts,
+		subscriptionsPolicy:            ec.subscriptionsPolicy,
+		subscriptions:                  ec.subscriptions,
+		transactionJournals:            ec.transactionJournals,
+
+		fullyBackedECDSAKeepFactoryContract: fullyBackedECDSAKeepFactoryContract,
+		keepFactoryTypes:                    ec.keepFactoryTypes,
+		keepOpenedTimestamps:                ec.keepOpenedTimestamps,
 	}, nil
 }
 
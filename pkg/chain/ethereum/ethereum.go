@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 
 	"github.com/ipfs/go-log"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/chain/ethereum"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
@@ -30,9 +32,12 @@ func (ec *EthereumChain) Address() common.Address {
 }
 
 // RegisterAsMemberCandidate registers client as a candidate to be selected
-// to a keep.
+// to a keep. The candidate is registered in the factory of the application's
+// keep factory type.
 func (ec *EthereumChain) RegisterAsMemberCandidate(application common.Address) error {
-	gasEstimate, err := ec.bondedECDSAKeepFactoryContract.RegisterMemberCandidateGasEstimate(application)
+	factory := ec.keepFactoryFor(application)
+
+	gasEstimate, err := factory.RegisterMemberCandidateGasEstimate(application)
 	if err != nil {
 		return fmt.Errorf("failed to estimate gas [%v]", err)
 	}
@@ -44,7 +49,7 @@ func (ec *EthereumChain) RegisterAsMemberCandidate(application common.Address) e
 	// on a different state of the pool. We add 20% safety margin to the original
 	// gas estimation to account for that.
 	gasEstimateWithMargin := float64(gasEstimate) * float64(1.2)
-	transaction, err := ec.bondedECDSAKeepFactoryContract.RegisterMemberCandidate(
+	transaction, err := factory.RegisterMemberCandidate(
 		application,
 		ethutil.TransactionOptions{
 			GasLimit: uint64(gasEstimateWithMargin),
@@ -55,45 +60,116 @@ func (ec *EthereumChain) RegisterAsMemberCandidate(application common.Address) e
 	}
 
 	logger.Debugf("submitted RegisterMemberCandidate transaction with hash: [%x]", transaction.Hash())
+	ec.describeTransaction(transaction, "RegisterMemberCandidate", application.Hex())
 
 	return nil
 }
 
 // OnBondedECDSAKeepCreated installs a callback that is invoked when an on-chain
-// notification of a new ECDSA keep creation is seen.
+// notification of a new ECDSA keep creation is seen. Keeps created by the
+// fully-backed keep factory are notified as well if the factory is configured.
+// Failed subscription is re-established and events emitted in the meantime are
+// polled, so no event is missed.
 func (ec *EthereumChain) OnBondedECDSAKeepCreated(
 	handler func(event *eth.BondedECDSAKeepCreatedEvent),
 ) subscription.EventSubscription {
-	subscription, err := ec.bondedECDSAKeepFactoryContract.WatchBondedECDSAKeepCreated(
-		func(
-			KeepAddress common.Address,
-			Members []common.Address,
-			Owner common.Address,
-			Application common.Address,
-			HonestThreshold *big.Int,
-			blockNumber uint64,
-		) {
-			handler(&eth.BondedECDSAKeepCreatedEvent{
-				KeepAddress:     KeepAddress,
-				Members:         Members,
-				HonestThreshold: HonestThreshold.Uint64(),
-			})
-		},
-		func(err error) error {
-			return fmt.Errorf("watch keep created failed: [%v]", err)
-		},
-		nil,
-		nil,
-		nil,
+	bondedSubscription := ec.onBondedECDSAKeepCreated(handler)
+
+	if ec.fullyBackedECDSAKeepFactoryContract == nil {
+		return bondedSubscription
+	}
+
+	fullyBackedSubscription := ec.onFullyBackedECDSAKeepCreated(handler)
+
+	return subscription.NewEventSubscription(func() {
+		bondedSubscription.Unsubscribe()
+		fullyBackedSubscription.Unsubscribe()
+	})
+}
+
+func (ec *EthereumChain) onBondedECDSAKeepCreated(
+	handler func(event *eth.BondedECDSAKeepCreatedEvent),
+) subscription.EventSubscription {
+	factoryAddress, err := ec.config.ContractAddress(
+		BondedECDSAKeepFactoryContractName,
+	)
+	if err != nil {
+		logger.Errorf("could not watch BondedECDSAKeepCreated event: [%v]", err)
+		return subscription.NewEventSubscription(func() {})
+	}
+
+	factoryContract, err := abi.NewBondedECDSAKeepFactory(
+		*factoryAddress,
+		ec.client,
 	)
 	if err != nil {
 		logger.Errorf("could not watch BondedECDSAKeepCreated event: [%v]", err)
+		return subscription.NewEventSubscription(func() {})
 	}
 
-	return subscription
+	handle := func(event *abi.BondedECDSAKeepFactoryBondedECDSAKeepCreated) {
+		handler(&eth.BondedECDSAKeepCreatedEvent{
+			KeepAddress:     event.KeepAddress,
+			Members:         event.Members,
+			HonestThreshold: event.HonestThreshold.Uint64(),
+			BlockNumber:     event.Raw.BlockNumber,
+		})
+	}
+
+	return ec.watchEvents(&eventSource{
+		name: "BondedECDSAKeepCreated",
+		subscribe: func(deliver eventDelivery) (event.Subscription, error) {
+			sink := make(chan *abi.BondedECDSAKeepFactoryBondedECDSAKeepCreated)
+			eventSubscription, err := factoryContract.WatchBondedECDSAKeepCreated(
+				nil,
+				sink,
+				nil,
+				nil,
+				nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				defer eventSubscription.Unsubscribe()
+				for {
+					select {
+					case event := <-sink:
+						deliver(event.Raw, func() { handle(event) })
+					case err := <-eventSubscription.Err():
+						return err
+					case <-quit:
+						return nil
+					}
+				}
+			}), nil
+		},
+		poll: func(startBlock uint64, deliver eventDelivery) error {
+			events, err := ec.bondedECDSAKeepFactoryContract.PastBondedECDSAKeepCreatedEvents(
+				startBlock,
+				nil, // latest block
+				nil,
+				nil,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				event := event
+				deliver(event.Raw, func() { handle(event) })
+			}
+
+			return nil
+		},
+	})
 }
 
 // OnKeepClosed installs a callback that is invoked on-chain when keep is closed.
+// Failed subscription is re-established and events emitted in the meantime
+// are polled, so no event is missed.
 func (ec *EthereumChain) OnKeepClosed(
 	keepAddress common.Address,
 	handler func(event *eth.KeepClosedEvent),
@@ -102,18 +178,61 @@ func (ec *EthereumChain) OnKeepClosed(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
 	}
-	return keepContract.WatchKeepClosed(
-		func(blockNumber uint64) {
-			handler(&eth.KeepClosedEvent{BlockNumber: blockNumber})
+
+	keepABI, err := abi.NewBondedECDSAKeep(keepAddress, ec.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
+	}
+
+	handle := func(event *abi.BondedECDSAKeepKeepClosed) {
+		handler(&eth.KeepClosedEvent{BlockNumber: event.Raw.BlockNumber})
+	}
+
+	return ec.watchEvents(&eventSource{
+		name: fmt.Sprintf("KeepClosed of keep %s", keepAddress.String()),
+		subscribe: func(deliver eventDelivery) (event.Subscription, error) {
+			sink := make(chan *abi.BondedECDSAKeepKeepClosed)
+			eventSubscription, err := keepABI.WatchKeepClosed(nil, sink)
+			if err != nil {
+				return nil, err
+			}
+
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				defer eventSubscription.Unsubscribe()
+				for {
+					select {
+					case event := <-sink:
+						deliver(event.Raw, func() { handle(event) })
+					case err := <-eventSubscription.Err():
+						return err
+					case <-quit:
+						return nil
+					}
+				}
+			}), nil
 		},
-		func(err error) error {
-			return fmt.Errorf("keep closed callback failed: [%v]", err)
+		poll: func(startBlock uint64, deliver eventDelivery) error {
+			events, err := keepContract.PastKeepClosedEvents(
+				startBlock,
+				nil, // latest block
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				event := event
+				deliver(event.Raw, func() { handle(event) })
+			}
+
+			return nil
 		},
-	)
+	}), nil
 }
 
 // OnKeepTerminated installs a callback that is invoked on-chain when keep
-// is terminated.
+// is terminated. Failed subscription is re-established and events emitted
+// in the meantime are polled, so no event is missed.
 func (ec *EthereumChain) OnKeepTerminated(
 	keepAddress common.Address,
 	handler func(event *eth.KeepTerminatedEvent),
@@ -122,14 +241,56 @@ func (ec *EthereumChain) OnKeepTerminated(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
 	}
-	return keepContract.WatchKeepTerminated(
-		func(blockNumber uint64) {
-			handler(&eth.KeepTerminatedEvent{BlockNumber: blockNumber})
+
+	keepABI, err := abi.NewBondedECDSAKeep(keepAddress, ec.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
+	}
+
+	handle := func(event *abi.BondedECDSAKeepKeepTerminated) {
+		handler(&eth.KeepTerminatedEvent{BlockNumber: event.Raw.BlockNumber})
+	}
+
+	return ec.watchEvents(&eventSource{
+		name: fmt.Sprintf("KeepTerminated of keep %s", keepAddress.String()),
+		subscribe: func(deliver eventDelivery) (event.Subscription, error) {
+			sink := make(chan *abi.BondedECDSAKeepKeepTerminated)
+			eventSubscription, err := keepABI.WatchKeepTerminated(nil, sink)
+			if err != nil {
+				return nil, err
+			}
+
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				defer eventSubscription.Unsubscribe()
+				for {
+					select {
+					case event := <-sink:
+						deliver(event.Raw, func() { handle(event) })
+					case err := <-eventSubscription.Err():
+						return err
+					case <-quit:
+						return nil
+					}
+				}
+			}), nil
 		},
-		func(err error) error {
-			return fmt.Errorf("keep terminated callback failed: [%v]", err)
+		poll: func(startBlock uint64, deliver eventDelivery) error {
+			events, err := keepContract.PastKeepTerminatedEvents(
+				startBlock,
+				nil, // latest block
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				event := event
+				deliver(event.Raw, func() { handle(event) })
+			}
+
+			return nil
 		},
-	)
+	}), nil
 }
 
 // OnPublicKeyPublished installs a callback that is invoked when an on-chain
@@ -188,7 +349,8 @@ func (ec *EthereumChain) OnConflictingPublicKeySubmitted(
 }
 
 // OnSignatureRequested installs a callback that is invoked on-chain
-// when a keep's signature is requested.
+// when a keep's signature is requested. Failed subscription is re-established
+// and events emitted in the meantime are polled, so no event is missed.
 func (ec *EthereumChain) OnSignatureRequested(
 	keepAddress common.Address,
 	handler func(event *eth.SignatureRequestedEvent),
@@ -198,34 +360,77 @@ func (ec *EthereumChain) OnSignatureRequested(
 		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
 	}
 
-	return keepContract.WatchSignatureRequested(
-		func(
-			Digest [32]uint8,
-			blockNumber uint64,
-		) {
-			handler(&eth.SignatureRequestedEvent{
-				Digest:      Digest,
-				BlockNumber: blockNumber,
-			})
+	keepABI, err := abi.NewBondedECDSAKeep(keepAddress, ec.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
+	}
+
+	handle := func(event *abi.BondedECDSAKeepSignatureRequested) {
+		handler(&eth.SignatureRequestedEvent{
+			Digest:      event.Digest,
+			BlockNumber: event.Raw.BlockNumber,
+		})
+	}
+
+	return ec.watchEvents(&eventSource{
+		name: fmt.Sprintf("SignatureRequested of keep %s", keepAddress.String()),
+		subscribe: func(deliver eventDelivery) (event.Subscription, error) {
+			sink := make(chan *abi.BondedECDSAKeepSignatureRequested)
+			eventSubscription, err := keepABI.WatchSignatureRequested(
+				nil,
+				sink,
+				nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				defer eventSubscription.Unsubscribe()
+				for {
+					select {
+					case event := <-sink:
+						deliver(event.Raw, func() { handle(event) })
+					case err := <-eventSubscription.Err():
+						return err
+					case <-quit:
+						return nil
+					}
+				}
+			}), nil
 		},
-		func(err error) error {
-			return fmt.Errorf("keep signature requested callback failed: [%v]", err)
+		poll: func(startBlock uint64, deliver eventDelivery) error {
+			events, err := keepContract.PastSignatureRequestedEvents(
+				startBlock,
+				nil, // latest block
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				event := event
+				deliver(event.Raw, func() { handle(event) })
+			}
+
+			return nil
 		},
-		nil,
-	)
+	}), nil
 }
 
 // SubmitKeepPublicKey submits a public key to a keep contract deployed under
-// a given address.
+// a given address. It returns the hash of the submission transaction.
 func (ec *EthereumChain) SubmitKeepPublicKey(
 	keepAddress common.Address,
 	publicKey [64]byte,
-) error {
+) (common.Hash, error) {
 	keepContract, err := ec.getKeepContract(keepAddress)
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
+	var transactionHash common.Hash
 	submitPubKey := func() error {
 		transaction, err := keepContract.SubmitPublicKey(
 			publicKey[:],
@@ -238,6 +443,8 @@ func (ec *EthereumChain) SubmitKeepPublicKey(
 		}
 
 		logger.Debugf("submitted SubmitPublicKey transaction with hash: [%x]", transaction.Hash())
+		ec.describeTransaction(transaction, "SubmitPublicKey", keepAddress.Hex())
+		transactionHash = transaction.Hash()
 		return nil
 	}
 
@@ -245,32 +452,33 @@ func (ec *EthereumChain) SubmitKeepPublicKey(
 	// a new cloned contract has not been registered by the ethereum node. Common
 	// case is when Ethereum nodes are behind a load balancer and not fully synced
 	// with each other. To mitigate this issue, a client will retry submitting
-	// a public key up to 10 times with a 250ms interval.
+	// a public key with delays and up to the number of attempts configured
+	// for chain calls.
 	if err := ec.withRetry(submitPubKey); err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
-	return nil
+	return transactionHash, nil
 }
 
 func (ec *EthereumChain) withRetry(fn func() error) error {
-	const numberOfRetries = 10
-	const delay = 12 * time.Second
-
 	for i := 1; ; i++ {
 		err := fn()
 		if err != nil {
 			logger.Errorf("Error occurred [%v]; on [%v] retry", err, i)
-			if i == numberOfRetries {
+			if i == ec.chainCallsMaxAttempts {
 				return err
 			}
-			time.Sleep(delay)
+			time.Sleep(ec.chainCallsPolicy.Delay(i))
 		} else {
 			return nil
 		}
 	}
 }
 
+// getKeepContract returns a handle of the keep contract. Bonded and
+// fully-backed keeps share the API used by the client, so the bonded keep
+// binding is used for keeps of both types.
 func (ec *EthereumChain) getKeepContract(address common.Address) (*contract.BondedECDSAKeep, error) {
 	bondedECDSAKeepContract, err := contract.NewBondedECDSAKeep(
 		address,
@@ -288,24 +496,24 @@ func (ec *EthereumChain) getKeepContract(address common.Address) (*contract.Bond
 }
 
 // SubmitSignature submits a signature to a keep contract deployed under a
-// given address.
+// given address. It returns the hash of the submission transaction.
 func (ec *EthereumChain) SubmitSignature(
 	keepAddress common.Address,
 	signature *ecdsa.Signature,
-) error {
+) (common.Hash, error) {
 	keepContract, err := ec.getKeepContract(keepAddress)
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
 	signatureR, err := byteutils.BytesTo32Byte(signature.R.Bytes())
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
 	signatureS, err := byteutils.BytesTo32Byte(signature.S.Bytes())
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
 	transaction, err := keepContract.SubmitSignature(
@@ -314,14 +522,124 @@ func (ec *EthereumChain) SubmitSignature(
 		uint8(signature.RecoveryID),
 	)
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
 	logger.Debugf("submitted SubmitSignature transaction with hash: [%x]", transaction.Hash())
+	ec.describeTransaction(transaction, "SubmitSignature", keepAddress.Hex())
+
+	return transaction.Hash(), nil
+}
+
+// OnSignatureSubmitted installs a callback that is invoked upon notification
+// of a signature submitted to the given keep.
+func (ec *EthereumChain) OnSignatureSubmitted(
+	keepAddress common.Address,
+	handler func(event *eth.SignatureSubmittedEvent),
+) (subscription.EventSubscription, error) {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contract abi: [%v]", err)
+	}
+
+	return keepContract.WatchSignatureSubmitted(
+		func(
+			Digest [32]uint8,
+			R [32]uint8,
+			S [32]uint8,
+			RecoveryID uint8,
+			blockNumber uint64,
+		) {
+			handler(&eth.SignatureSubmittedEvent{
+				Digest:      Digest,
+				R:           R,
+				S:           S,
+				RecoveryID:  RecoveryID,
+				BlockNumber: blockNumber,
+			})
+		},
+		func(err error) error {
+			return fmt.Errorf("keep signature submitted callback failed: [%v]", err)
+		},
+		nil,
+	)
+}
+
+// CheckSignatureFraud checks if the given signature calculated with the keep's
+// key over the given digest is a fraud.
+func (ec *EthereumChain) CheckSignatureFraud(
+	keepAddress common.Address,
+	signature *ecdsa.Signature,
+	signedDigest [32]byte,
+	preimage []byte,
+) (bool, error) {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return false, err
+	}
+
+	v, r, s, err := fraudProofSignature(signature)
+	if err != nil {
+		return false, err
+	}
+
+	return keepContract.CheckSignatureFraud(v, r, s, signedDigest, preimage)
+}
+
+// SubmitSignatureFraud submits a proof that the keep's key has been used to
+// sign a digest which the keep has never been requested to sign.
+func (ec *EthereumChain) SubmitSignatureFraud(
+	keepAddress common.Address,
+	signature *ecdsa.Signature,
+	signedDigest [32]byte,
+	preimage []byte,
+) error {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return err
+	}
+
+	v, r, s, err := fraudProofSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := keepContract.SubmitSignatureFraud(
+		v,
+		r,
+		s,
+		signedDigest,
+		preimage,
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Debugf("submitted SubmitSignatureFraud transaction with hash: [%x]", transaction.Hash())
+	ec.describeTransaction(transaction, "SubmitSignatureFraud", keepAddress.Hex())
 
 	return nil
 }
 
+// fraudProofSignature converts the signature to the form expected by the keep
+// contract fraud proof functions. The contract recovers the signer address with
+// `ecrecover` which expects `v` to be 27 or 28.
+func fraudProofSignature(
+	signature *ecdsa.Signature,
+) (uint8, [32]byte, [32]byte, error) {
+	r, err := byteutils.BytesTo32Byte(signature.R.Bytes())
+	if err != nil {
+		return 0, [32]byte{}, [32]byte{}, err
+	}
+
+	s, err := byteutils.BytesTo32Byte(signature.S.Bytes())
+	if err != nil {
+		return 0, [32]byte{}, [32]byte{}, err
+	}
+
+	return uint8(27 + signature.RecoveryID), r, s, nil
+}
+
 // IsAwaitingSignature checks if the keep is waiting for a signature to be
 // calculated for the given digest.
 func (ec *EthereumChain) IsAwaitingSignature(keepAddress common.Address, digest [32]byte) (bool, error) {
@@ -345,9 +663,16 @@ func (ec *EthereumChain) IsActive(keepAddress common.Address) (bool, error) {
 
 // HasMinimumStake returns true if the specified address is staked.  False will
 // be returned if not staked.  If err != nil then it was not possible to determine
-// if the address is staked or not.
+// if the address is staked or not. If the fully-backed keep factory is
+// configured, operators with a delegation in the FullyBackedBonding contract
+// are considered staked as well.
 func (ec *EthereumChain) HasMinimumStake(address common.Address) (bool, error) {
-	return ec.bondedECDSAKeepFactoryContract.HasMinimumStake(address)
+	hasMinimumStake, err := ec.bondedECDSAKeepFactoryContract.HasMinimumStake(address)
+	if err != nil || hasMinimumStake || ec.fullyBackedECDSAKeepFactoryContract == nil {
+		return hasMinimumStake, err
+	}
+
+	return ec.hasFullyBackedDelegation(address)
 }
 
 // BalanceOf returns the stake balance of the specified address.
@@ -363,7 +688,7 @@ func (ec *EthereumChain) BlockCounter() chain.BlockCounter {
 // IsRegisteredForApplication checks if the operator is registered
 // as a signer candidate in the factory for the given application.
 func (ec *EthereumChain) IsRegisteredForApplication(application common.Address) (bool, error) {
-	return ec.bondedECDSAKeepFactoryContract.IsOperatorRegistered(
+	return ec.keepFactoryFor(application).IsOperatorRegistered(
 		ec.Address(),
 		application,
 	)
@@ -372,7 +697,7 @@ func (ec *EthereumChain) IsRegisteredForApplication(application common.Address)
 // IsEligibleForApplication checks if the operator is eligible to register
 // as a signer candidate for the given application.
 func (ec *EthereumChain) IsEligibleForApplication(application common.Address) (bool, error) {
-	return ec.bondedECDSAKeepFactoryContract.IsOperatorEligible(
+	return ec.keepFactoryFor(application).IsOperatorEligible(
 		ec.Address(),
 		application,
 	)
@@ -381,7 +706,7 @@ func (ec *EthereumChain) IsEligibleForApplication(application common.Address) (b
 // IsStatusUpToDateForApplication checks if the operator's status
 // is up to date in the signers' pool of the given application.
 func (ec *EthereumChain) IsStatusUpToDateForApplication(application common.Address) (bool, error) {
-	return ec.bondedECDSAKeepFactoryContract.IsOperatorUpToDate(
+	return ec.keepFactoryFor(application).IsOperatorUpToDate(
 		ec.Address(),
 		application,
 	)
@@ -390,7 +715,7 @@ func (ec *EthereumChain) IsStatusUpToDateForApplication(application common.Addre
 // UpdateStatusForApplication updates the operator's status in the signers'
 // pool for the given application.
 func (ec *EthereumChain) UpdateStatusForApplication(application common.Address) error {
-	transaction, err := ec.bondedECDSAKeepFactoryContract.UpdateOperatorStatus(
+	transaction, err := ec.keepFactoryFor(application).UpdateOperatorStatus(
 		ec.Address(),
 		application,
 	)
@@ -402,26 +727,21 @@ func (ec *EthereumChain) UpdateStatusForApplication(application common.Address)
 		"submitted UpdateOperatorStatus transaction with hash: [%x]",
 		transaction.Hash(),
 	)
+	ec.describeTransaction(transaction, "UpdateOperatorStatus", application.Hex())
 
 	return nil
 }
 
 // IsOperatorAuthorized checks if the factory has the authorization to
-// operate on stake represented by the provided operator.
+// operate on stake represented by the provided operator. If the fully-backed
+// keep factory is configured, authorization of any of the factories is enough.
 func (ec *EthereumChain) IsOperatorAuthorized(operator common.Address) (bool, error) {
-	return ec.bondedECDSAKeepFactoryContract.IsOperatorAuthorized(operator)
-}
-
-// GetKeepCount returns number of keeps.
-func (ec *EthereumChain) GetKeepCount() (*big.Int, error) {
-	return ec.bondedECDSAKeepFactoryContract.GetKeepCount()
-}
+	isAuthorized, err := ec.bondedECDSAKeepFactoryContract.IsOperatorAuthorized(operator)
+	if err != nil || isAuthorized || ec.fullyBackedECDSAKeepFactoryContract == nil {
+		return isAuthorized, err
+	}
 
-// GetKeepAtIndex returns the address of the keep at the given index.
-func (ec *EthereumChain) GetKeepAtIndex(
-	keepIndex *big.Int,
-) (common.Address, error) {
-	return ec.bondedECDSAKeepFactoryContract.GetKeepAtIndex(keepIndex)
+	return ec.fullyBackedECDSAKeepFactoryContract.IsOperatorAuthorized(operator)
 }
 
 // LatestDigest returns the latest digest requested to be signed.
@@ -494,6 +814,54 @@ func (ec *EthereumChain) GetHonestThreshold(
 	return threshold.Uint64(), nil
 }
 
+// GetOwner returns the address of the keep's owner.
+func (ec *EthereumChain) GetOwner(
+	keepAddress common.Address,
+) (common.Address, error) {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return keepContract.GetOwner()
+}
+
+// GetMemberETHBalance returns the ETH balance in wei of the keep member
+// which can be withdrawn from the keep.
+func (ec *EthereumChain) GetMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) (*big.Int, error) {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return keepContract.GetMemberETHBalance(member)
+}
+
+// WithdrawMemberETHBalance withdraws the ETH balance of the keep member to
+// the member's beneficiary. It returns the hash of the withdrawal transaction.
+func (ec *EthereumChain) WithdrawMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) (common.Hash, error) {
+	keepContract, err := ec.getKeepContract(keepAddress)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	transaction, err := keepContract.Withdraw(member)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	logger.Debugf("submitted Withdraw transaction with hash: [%x]", transaction.Hash())
+	ec.describeTransaction(transaction, "Withdraw", keepAddress.Hex())
+
+	return transaction.Hash(), nil
+}
+
 // GetOpenedTimestamp returns timestamp when the keep was created.
 func (ec *EthereumChain) GetOpenedTimestamp(keepAddress common.Address) (time.Time, error) {
 	keepContract, err := ec.getKeepContract(keepAddress)
@@ -555,6 +923,173 @@ func (ec *EthereumChain) PastSignatureSubmittedEvents(
 	return result, nil
 }
 
+// PastSignatureRequestedEvents returns all signature requested events
+// for the given keep which occurred after the provided start block.
+// Returned events are sorted by the block number in the ascending order.
+func (ec *EthereumChain) PastSignatureRequestedEvents(
+	keepAddress string,
+	startBlock uint64,
+) ([]*eth.SignatureRequestedEvent, error) {
+	if !common.IsHexAddress(keepAddress) {
+		return nil, fmt.Errorf("invalid keep address: [%v]", keepAddress)
+	}
+	keepContract, err := ec.getKeepContract(common.HexToAddress(keepAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := keepContract.PastSignatureRequestedEvents(
+		startBlock,
+		nil, // latest block
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*eth.SignatureRequestedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &eth.SignatureRequestedEvent{
+			Digest:      event.Digest,
+			BlockNumber: event.Raw.BlockNumber,
+		})
+	}
+
+	// Make sure events are sorted by block number in ascending order.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
+// PastKeepClosedEvents returns all keep closed events for the given keep
+// which occurred after the provided start block. Returned events are sorted
+// by the block number in the ascending order.
+func (ec *EthereumChain) PastKeepClosedEvents(
+	keepAddress string,
+	startBlock uint64,
+) ([]*eth.KeepClosedEvent, error) {
+	if !common.IsHexAddress(keepAddress) {
+		return nil, fmt.Errorf("invalid keep address: [%v]", keepAddress)
+	}
+	keepContract, err := ec.getKeepContract(common.HexToAddress(keepAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := keepContract.PastKeepClosedEvents(
+		startBlock,
+		nil, // latest block
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*eth.KeepClosedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &eth.KeepClosedEvent{
+			BlockNumber: event.Raw.BlockNumber,
+		})
+	}
+
+	// Make sure events are sorted by block number in ascending order.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
+// PastKeepTerminatedEvents returns all keep terminated events for the given
+// keep which occurred after the provided start block. Returned events are
+// sorted by the block number in the ascending order.
+func (ec *EthereumChain) PastKeepTerminatedEvents(
+	keepAddress string,
+	startBlock uint64,
+) ([]*eth.KeepTerminatedEvent, error) {
+	if !common.IsHexAddress(keepAddress) {
+		return nil, fmt.Errorf("invalid keep address: [%v]", keepAddress)
+	}
+	keepContract, err := ec.getKeepContract(common.HexToAddress(keepAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := keepContract.PastKeepTerminatedEvents(
+		startBlock,
+		nil, // latest block
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*eth.KeepTerminatedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &eth.KeepTerminatedEvent{
+			BlockNumber: event.Raw.BlockNumber,
+		})
+	}
+
+	// Make sure events are sorted by block number in ascending order.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
+// PastBondedECDSAKeepCreatedEvents returns all keep created events which
+// occurred after the provided start block, including fully-backed keeps if
+// the fully-backed keep factory is configured. Returned events are sorted by
+// the block number in the ascending order.
+func (ec *EthereumChain) PastBondedECDSAKeepCreatedEvents(
+	startBlock uint64,
+) ([]*eth.BondedECDSAKeepCreatedEvent, error) {
+	events, err := ec.bondedECDSAKeepFactoryContract.PastBondedECDSAKeepCreatedEvents(
+		startBlock,
+		nil, // latest block
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*eth.BondedECDSAKeepCreatedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &eth.BondedECDSAKeepCreatedEvent{
+			KeepAddress:     event.KeepAddress,
+			Members:         event.Members,
+			HonestThreshold: event.HonestThreshold.Uint64(),
+			BlockNumber:     event.Raw.BlockNumber,
+		})
+	}
+
+	if ec.fullyBackedECDSAKeepFactoryContract != nil {
+		fullyBackedEvents, err := ec.pastFullyBackedECDSAKeepCreatedEvents(
+			startBlock,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, fullyBackedEvents...)
+	}
+
+	// Make sure events are sorted by block number in ascending order.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
 // BlockTimestamp returns given block's timestamp.
 func (ec *EthereumChain) BlockTimestamp(blockNumber *big.Int) (uint64, error) {
 	ctx, cancelCtx := context.WithTimeout(context.Background(), 1*time.Minute)
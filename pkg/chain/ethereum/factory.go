@@ -0,0 +1,479 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+)
+
+// KeepFactoryType determines the factory which creates keeps for
+// an application.
+type KeepFactoryType string
+
+const (
+	// BondedKeepFactory creates keeps backed by KEEP stake and ETH bonds
+	// of the members. It is used for applications with no factory type
+	// configured.
+	BondedKeepFactory KeepFactoryType = "bonded"
+	// FullyBackedKeepFactory creates keeps backed by ETH bonds of the
+	// members only.
+	FullyBackedKeepFactory KeepFactoryType = "fully-backed"
+)
+
+// ParseKeepFactoryType parses the name of a keep factory type.
+func ParseKeepFactoryType(name string) (KeepFactoryType, error) {
+	switch factoryType := KeepFactoryType(name); factoryType {
+	case BondedKeepFactory, FullyBackedKeepFactory:
+		return factoryType, nil
+	default:
+		return "", fmt.Errorf(
+			"unknown keep factory type [%s]; expected [%s] or [%s]",
+			name,
+			BondedKeepFactory,
+			FullyBackedKeepFactory,
+		)
+	}
+}
+
+// keepFactory is a part of the keep factory contract API which is the same
+// for bonded and fully-backed keep factories.
+type keepFactory interface {
+	RegisterMemberCandidateGasEstimate(application common.Address) (uint64, error)
+	RegisterMemberCandidate(
+		application common.Address,
+		transactionOptions ...ethutil.TransactionOptions,
+	) (*types.Transaction, error)
+	IsOperatorRegistered(operator, application common.Address) (bool, error)
+	IsOperatorEligible(operator, application common.Address) (bool, error)
+	IsOperatorUpToDate(operator, application common.Address) (bool, error)
+	UpdateOperatorStatus(
+		operator, application common.Address,
+		transactionOptions ...ethutil.TransactionOptions,
+	) (*types.Transaction, error)
+	IsOperatorAuthorized(operator common.Address) (bool, error)
+	GetKeepCount() (*big.Int, error)
+	GetKeepAtIndex(index *big.Int) (common.Address, error)
+	GetKeepOpenedTimestamp(keep common.Address) (*big.Int, error)
+}
+
+// keepFactoryTypes holds factory types of applications. It is shared by
+// all accounts operating on the same connection.
+type keepFactoryTypes struct {
+	mutex sync.RWMutex
+	types map[common.Address]KeepFactoryType
+}
+
+func newKeepFactoryTypes() *keepFactoryTypes {
+	return &keepFactoryTypes{
+		types: make(map[common.Address]KeepFactoryType),
+	}
+}
+
+func (kft *keepFactoryTypes) set(
+	application common.Address,
+	factoryType KeepFactoryType,
+) {
+	kft.mutex.Lock()
+	defer kft.mutex.Unlock()
+
+	kft.types[application] = factoryType
+}
+
+func (kft *keepFactoryTypes) get(application common.Address) KeepFactoryType {
+	kft.mutex.RLock()
+	defer kft.mutex.RUnlock()
+
+	if factoryType, ok := kft.types[application]; ok {
+		return factoryType
+	}
+
+	return BondedKeepFactory
+}
+
+// keepOpenedTimestamps caches opening timestamps of keeps at the given index
+// of the given factory. Keeps are never removed from factories, so the keep
+// at an index and its opening timestamp never change.
+type keepOpenedTimestamps struct {
+	mutex      sync.Mutex
+	timestamps map[keepOpenedTimestampKey]*big.Int
+}
+
+type keepOpenedTimestampKey struct {
+	factoryType KeepFactoryType
+	index       uint64
+}
+
+func newKeepOpenedTimestamps() *keepOpenedTimestamps {
+	return &keepOpenedTimestamps{
+		timestamps: make(map[keepOpenedTimestampKey]*big.Int),
+	}
+}
+
+// SetKeepFactoryType sets the type of the factory which creates keeps for
+// the given application. Registration and status updates for the application
+// are submitted to that factory. Fully-backed factory type requires the
+// FullyBackedECDSAKeepFactory contract address to be configured.
+func (ec *EthereumChain) SetKeepFactoryType(
+	application common.Address,
+	factoryType KeepFactoryType,
+) error {
+	if factoryType == FullyBackedKeepFactory &&
+		ec.fullyBackedECDSAKeepFactoryContract == nil {
+		return fmt.Errorf(
+			"could not use fully-backed keep factory for application [%s]: "+
+				"[%s] contract address is not configured",
+			application.Hex(),
+			FullyBackedECDSAKeepFactoryContractName,
+		)
+	}
+
+	ec.keepFactoryTypes.set(application, factoryType)
+
+	return nil
+}
+
+func (ec *EthereumChain) keepFactoryFor(application common.Address) keepFactory {
+	if ec.keepFactoryTypes.get(application) == FullyBackedKeepFactory {
+		return ec.fullyBackedECDSAKeepFactoryContract
+	}
+
+	return ec.bondedECDSAKeepFactoryContract
+}
+
+// newFullyBackedECDSAKeepFactory creates a handle of the fully-backed keep
+// factory contract for the given account. It returns nil if the contract
+// address is not configured.
+func newFullyBackedECDSAKeepFactory(
+	config *ethereum.Config,
+	accountKey *keystore.Key,
+	client ethutil.EthereumClient,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	transactionMutex *sync.Mutex,
+) (*contract.FullyBackedECDSAKeepFactory, error) {
+	address, err := config.ContractAddress(FullyBackedECDSAKeepFactoryContractName)
+	if err != nil {
+		return nil, nil
+	}
+
+	return contract.NewFullyBackedECDSAKeepFactory(
+		*address,
+		accountKey,
+		client,
+		nonceManager,
+		miningWaiter,
+		transactionMutex,
+	)
+}
+
+// hasFullyBackedDelegation checks if the operator has been delegated in the
+// FullyBackedBonding contract. It returns false if the contract address is
+// not configured.
+func (ec *EthereumChain) hasFullyBackedDelegation(
+	operator common.Address,
+) (bool, error) {
+	if _, err := ec.config.ContractAddress(
+		FullyBackedBondingContractName,
+	); err != nil {
+		return false, nil
+	}
+
+	fullyBackedBondingContract, err := ec.getFullyBackedBondingContract()
+	if err != nil {
+		return false, err
+	}
+
+	owner, err := fullyBackedBondingContract.OwnerOf(operator)
+	if err != nil {
+		return false, err
+	}
+
+	return owner != (common.Address{}), nil
+}
+
+// GetKeepCount returns number of keeps created by all configured factories.
+func (ec *EthereumChain) GetKeepCount() (*big.Int, error) {
+	bondedKeepCount, err := ec.bondedECDSAKeepFactoryContract.GetKeepCount()
+	if err != nil {
+		return nil, err
+	}
+
+	if ec.fullyBackedECDSAKeepFactoryContract == nil {
+		return bondedKeepCount, nil
+	}
+
+	fullyBackedKeepCount, err := ec.fullyBackedECDSAKeepFactoryContract.GetKeepCount()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).Add(bondedKeepCount, fullyBackedKeepCount), nil
+}
+
+// GetKeepAtIndex returns the address of the keep at the given index. Keeps of
+// all configured factories are ordered by their opening time, so the look-back
+// over the most recent keeps sees keeps of all factories. Keeps opened at the
+// same time are ordered with bonded keeps first.
+func (ec *EthereumChain) GetKeepAtIndex(
+	keepIndex *big.Int,
+) (common.Address, error) {
+	if ec.fullyBackedECDSAKeepFactoryContract == nil {
+		return ec.bondedECDSAKeepFactoryContract.GetKeepAtIndex(keepIndex)
+	}
+
+	bondedKeepCount, err := ec.bondedECDSAKeepFactoryContract.GetKeepCount()
+	if err != nil {
+		return common.Address{}, err
+	}
+	fullyBackedKeepCount, err := ec.fullyBackedECDSAKeepFactoryContract.GetKeepCount()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	bonded := bondedKeepCount.Uint64()
+	fullyBacked := fullyBackedKeepCount.Uint64()
+
+	if !keepIndex.IsUint64() || keepIndex.Uint64() >= bonded+fullyBacked {
+		return common.Address{}, fmt.Errorf(
+			"keep index [%v] out of range; there are [%v] keeps",
+			keepIndex,
+			bonded+fullyBacked,
+		)
+	}
+	index := keepIndex.Uint64()
+
+	bondedTimestamp := func(i uint64) (*big.Int, error) {
+		return ec.keepOpenedTimestampAt(BondedKeepFactory, i)
+	}
+	fullyBackedTimestamp := func(i uint64) (*big.Int, error) {
+		return ec.keepOpenedTimestampAt(FullyBackedKeepFactory, i)
+	}
+
+	// Find the number of bonded keeps among keeps preceding the one at the
+	// given index. Both factories hold keeps in the order they were opened,
+	// so the number can be found with a binary search.
+	low := uint64(0)
+	if index > fullyBacked {
+		low = index - fullyBacked
+	}
+	high := index
+	if high > bonded {
+		high = bonded
+	}
+	for low < high {
+		middle := low + (high-low)/2
+
+		bondedOpened, err := bondedTimestamp(middle)
+		if err != nil {
+			return common.Address{}, err
+		}
+		fullyBackedOpened, err := fullyBackedTimestamp(index - middle - 1)
+		if err != nil {
+			return common.Address{}, err
+		}
+
+		if bondedOpened.Cmp(fullyBackedOpened) <= 0 {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+
+	precedingBonded := low
+	precedingFullyBacked := index - low
+
+	// The keep is the next bonded or the next fully-backed keep, whichever
+	// was opened first.
+	isBonded := precedingFullyBacked == fullyBacked
+	if precedingBonded < bonded && precedingFullyBacked < fullyBacked {
+		bondedOpened, err := bondedTimestamp(precedingBonded)
+		if err != nil {
+			return common.Address{}, err
+		}
+		fullyBackedOpened, err := fullyBackedTimestamp(precedingFullyBacked)
+		if err != nil {
+			return common.Address{}, err
+		}
+
+		isBonded = bondedOpened.Cmp(fullyBackedOpened) <= 0
+	}
+
+	if isBonded {
+		return ec.bondedECDSAKeepFactoryContract.GetKeepAtIndex(
+			new(big.Int).SetUint64(precedingBonded),
+		)
+	}
+
+	return ec.fullyBackedECDSAKeepFactoryContract.GetKeepAtIndex(
+		new(big.Int).SetUint64(precedingFullyBacked),
+	)
+}
+
+func (ec *EthereumChain) keepOpenedTimestampAt(
+	factoryType KeepFactoryType,
+	index uint64,
+) (*big.Int, error) {
+	key := keepOpenedTimestampKey{factoryType, index}
+
+	ec.keepOpenedTimestamps.mutex.Lock()
+	timestamp, ok := ec.keepOpenedTimestamps.timestamps[key]
+	ec.keepOpenedTimestamps.mutex.Unlock()
+
+	if ok {
+		return timestamp, nil
+	}
+
+	var factory keepFactory = ec.bondedECDSAKeepFactoryContract
+	if factoryType == FullyBackedKeepFactory {
+		factory = ec.fullyBackedECDSAKeepFactoryContract
+	}
+
+	keepAddress, err := factory.GetKeepAtIndex(new(big.Int).SetUint64(index))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get %s keep at index [%v]: [%v]",
+			factoryType,
+			index,
+			err,
+		)
+	}
+
+	timestamp, err = factory.GetKeepOpenedTimestamp(keepAddress)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get opening timestamp of keep [%s]: [%v]",
+			keepAddress.Hex(),
+			err,
+		)
+	}
+
+	ec.keepOpenedTimestamps.mutex.Lock()
+	ec.keepOpenedTimestamps.timestamps[key] = timestamp
+	ec.keepOpenedTimestamps.mutex.Unlock()
+
+	return timestamp, nil
+}
+
+// onFullyBackedECDSAKeepCreated installs a callback that is invoked when an
+// on-chain notification of a new fully-backed keep creation is seen.
+func (ec *EthereumChain) onFullyBackedECDSAKeepCreated(
+	handler func(event *eth.BondedECDSAKeepCreatedEvent),
+) subscription.EventSubscription {
+	factoryAddress, err := ec.config.ContractAddress(
+		FullyBackedECDSAKeepFactoryContractName,
+	)
+	if err != nil {
+		logger.Errorf("could not watch FullyBackedECDSAKeepCreated event: [%v]", err)
+		return subscription.NewEventSubscription(func() {})
+	}
+
+	factoryContract, err := abi.NewFullyBackedECDSAKeepFactory(
+		*factoryAddress,
+		ec.client,
+	)
+	if err != nil {
+		logger.Errorf("could not watch FullyBackedECDSAKeepCreated event: [%v]", err)
+		return subscription.NewEventSubscription(func() {})
+	}
+
+	handle := func(event *abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated) {
+		handler(&eth.BondedECDSAKeepCreatedEvent{
+			KeepAddress:     event.KeepAddress,
+			Members:         event.Members,
+			HonestThreshold: event.HonestThreshold.Uint64(),
+			BlockNumber:     event.Raw.BlockNumber,
+		})
+	}
+
+	return ec.watchEvents(&eventSource{
+		name: "FullyBackedECDSAKeepCreated",
+		subscribe: func(deliver eventDelivery) (event.Subscription, error) {
+			sink := make(chan *abi.FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated)
+			eventSubscription, err := factoryContract.WatchFullyBackedECDSAKeepCreated(
+				nil,
+				sink,
+				nil,
+				nil,
+				nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return event.NewSubscription(func(quit <-chan struct{}) error {
+				defer eventSubscription.Unsubscribe()
+				for {
+					select {
+					case event := <-sink:
+						deliver(event.Raw, func() { handle(event) })
+					case err := <-eventSubscription.Err():
+						return err
+					case <-quit:
+						return nil
+					}
+				}
+			}), nil
+		},
+		poll: func(startBlock uint64, deliver eventDelivery) error {
+			events, err := ec.fullyBackedECDSAKeepFactoryContract.PastFullyBackedECDSAKeepCreatedEvents(
+				startBlock,
+				nil, // latest block
+				nil,
+				nil,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				event := event
+				deliver(event.Raw, func() { handle(event) })
+			}
+
+			return nil
+		},
+	})
+}
+
+// pastFullyBackedECDSAKeepCreatedEvents returns all fully-backed keep created
+// events which occurred after the provided start block.
+func (ec *EthereumChain) pastFullyBackedECDSAKeepCreatedEvents(
+	startBlock uint64,
+) ([]*eth.BondedECDSAKeepCreatedEvent, error) {
+	events, err := ec.fullyBackedECDSAKeepFactoryContract.PastFullyBackedECDSAKeepCreatedEvents(
+		startBlock,
+		nil, // latest block
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*eth.BondedECDSAKeepCreatedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &eth.BondedECDSAKeepCreatedEvent{
+			KeepAddress:     event.KeepAddress,
+			Members:         event.Members,
+			HonestThreshold: event.HonestThreshold.Uint64(),
+			BlockNumber:     event.Raw.BlockNumber,
+		})
+	}
+
+	return result, nil
+}
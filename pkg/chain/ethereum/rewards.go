@@ -0,0 +1,128 @@
+package ethereum
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+)
+
+// CurrentRewardsInterval returns the rewards interval the current time falls
+// within.
+func (ec *EthereumChain) CurrentRewardsInterval() (uint64, error) {
+	ecdsaRewardsContract, err := ec.getECDSARewardsContract()
+	if err != nil {
+		return 0, err
+	}
+
+	interval, err := ecdsaRewardsContract.IntervalOf(
+		big.NewInt(time.Now().Unix()),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return interval.Uint64(), nil
+}
+
+// AllocatedRewards returns rewards allocated to the beneficiary of the
+// operator in the given interval.
+func (ec *EthereumChain) AllocatedRewards(
+	interval uint64,
+	operator common.Address,
+) (*big.Int, error) {
+	ecdsaRewardsContract, err := ec.getECDSARewardsContract()
+	if err != nil {
+		return nil, err
+	}
+
+	return ecdsaRewardsContract.GetAllocatedRewards(
+		new(big.Int).SetUint64(interval),
+		operator,
+	)
+}
+
+// WithdrawnRewards returns rewards already withdrawn to the beneficiary of the
+// operator in the given interval.
+func (ec *EthereumChain) WithdrawnRewards(
+	interval uint64,
+	operator common.Address,
+) (*big.Int, error) {
+	ecdsaRewardsContract, err := ec.getECDSARewardsContract()
+	if err != nil {
+		return nil, err
+	}
+
+	return ecdsaRewardsContract.GetWithdrawnRewards(
+		new(big.Int).SetUint64(interval),
+		operator,
+	)
+}
+
+// WithdrawableRewards returns rewards allocated to the beneficiary of the
+// operator in the given interval which have not been withdrawn yet.
+func (ec *EthereumChain) WithdrawableRewards(
+	interval uint64,
+	operator common.Address,
+) (*big.Int, error) {
+	ecdsaRewardsContract, err := ec.getECDSARewardsContract()
+	if err != nil {
+		return nil, err
+	}
+
+	return ecdsaRewardsContract.GetWithdrawableRewards(
+		new(big.Int).SetUint64(interval),
+		operator,
+	)
+}
+
+// WithdrawRewards withdraws all rewards available for the operator in the
+// given interval to the beneficiary of the operator. It returns the hash of
+// the withdrawal transaction.
+func (ec *EthereumChain) WithdrawRewards(
+	interval uint64,
+	operator common.Address,
+) (common.Hash, error) {
+	ecdsaRewardsContract, err := ec.getECDSARewardsContract()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	transaction, err := ecdsaRewardsContract.WithdrawRewards(
+		new(big.Int).SetUint64(interval),
+		operator,
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	logger.Debugf(
+		"submitted WithdrawRewards transaction with hash: [%x]",
+		transaction.Hash(),
+	)
+	ec.describeTransaction(transaction, "WithdrawRewards", operator.Hex())
+
+	return transaction.Hash(), nil
+}
+
+// getECDSARewardsContract returns a handle of the ECDSARewards contract. The
+// contract address is not required by the client so it is read from the
+// config only when rewards are used.
+func (ec *EthereumChain) getECDSARewardsContract() (*contract.ECDSARewards, error) {
+	ecdsaRewardsContractAddress, err := ec.config.ContractAddress(
+		ECDSARewardsContractName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.NewECDSARewards(
+		*ecdsaRewardsContractAddress,
+		ec.accountKey,
+		ec.client,
+		ec.nonceManager,
+		ec.miningWaiter,
+		ec.transactionMutex,
+	)
+}
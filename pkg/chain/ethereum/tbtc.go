@@ -177,6 +177,40 @@ func (tec *TBTCEthereumChain) OnDepositRedeemed(
 	)
 }
 
+// PastDepositCreatedEvents returns all deposit created events which occurred
+// after the provided start block. Returned events are sorted by the block
+// number in the ascending order.
+func (tec *TBTCEthereumChain) PastDepositCreatedEvents(
+	startBlock uint64,
+) ([]*chain.DepositCreatedEvent, error) {
+	events, err := tec.tbtcSystemContract.PastCreatedEvents(
+		startBlock,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*chain.DepositCreatedEvent, 0)
+
+	for _, event := range events {
+		result = append(result, &chain.DepositCreatedEvent{
+			DepositAddress: event.DepositContractAddress.Hex(),
+			KeepAddress:    event.KeepAddress.Hex(),
+			BlockNumber:    event.Raw.BlockNumber,
+		})
+	}
+
+	// Make sure events are sorted by block number in ascending order.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
 // PastDepositRedemptionRequestedEvents returns all redemption requested
 // events for the given deposit which occurred after the provided start block.
 // Returned events are sorted by the block number in the ascending order.
@@ -187,12 +221,32 @@ func (tec *TBTCEthereumChain) PastDepositRedemptionRequestedEvents(
 	if !common.IsHexAddress(depositAddress) {
 		return nil, fmt.Errorf("incorrect deposit contract address")
 	}
-	events, err := tec.tbtcSystemContract.PastRedemptionRequestedEvents(
+
+	return tec.pastRedemptionRequestedEvents(
 		startBlock,
-		nil,
 		[]common.Address{
 			common.HexToAddress(depositAddress),
 		},
+	)
+}
+
+// PastAllDepositRedemptionRequestedEvents returns redemption requested events
+// for all deposits which occurred after the provided start block.
+// Returned events are sorted by the block number in the ascending order.
+func (tec *TBTCEthereumChain) PastAllDepositRedemptionRequestedEvents(
+	startBlock uint64,
+) ([]*chain.DepositRedemptionRequestedEvent, error) {
+	return tec.pastRedemptionRequestedEvents(startBlock, nil)
+}
+
+func (tec *TBTCEthereumChain) pastRedemptionRequestedEvents(
+	startBlock uint64,
+	depositAddressFilter []common.Address,
+) ([]*chain.DepositRedemptionRequestedEvent, error) {
+	events, err := tec.tbtcSystemContract.PastRedemptionRequestedEvents(
+		startBlock,
+		nil,
+		depositAddressFilter,
 		nil,
 		nil,
 	)
@@ -260,6 +314,7 @@ func (tec *TBTCEthereumChain) RetrieveSignerPubkey(
 		"submitted RetrieveSignerPubkey transaction with hash: [%x]",
 		transaction.Hash(),
 	)
+	tec.describeTransaction(transaction, "RetrieveSignerPubkey", depositAddress)
 
 	return nil
 }
@@ -286,6 +341,7 @@ func (tec *TBTCEthereumChain) ProvideRedemptionSignature(
 		"submitted ProvideRedemptionSignature transaction with hash: [%x]",
 		transaction.Hash(),
 	)
+	tec.describeTransaction(transaction, "ProvideRedemptionSignature", depositAddress)
 
 	return nil
 }
@@ -313,6 +369,7 @@ func (tec *TBTCEthereumChain) IncreaseRedemptionFee(
 		"submitted IncreaseRedemptionFee transaction with hash: [%x]",
 		transaction.Hash(),
 	)
+	tec.describeTransaction(transaction, "IncreaseRedemptionFee", depositAddress)
 
 	return nil
 }
@@ -350,6 +407,7 @@ func (tec *TBTCEthereumChain) ProvideRedemptionProof(
 		"submitted ProvideRedemptionProof transaction with hash: [%x]",
 		transaction.Hash(),
 	)
+	tec.describeTransaction(transaction, "ProvideRedemptionProof", depositAddress)
 
 	return nil
 }
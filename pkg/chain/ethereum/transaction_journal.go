@@ -0,0 +1,556 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+const transactionFileName = "/transaction"
+
+const (
+	// Determines how often receipts of pending transactions are checked.
+	transactionsCheckInterval = 1 * time.Minute
+
+	// Pending transactions first submitted longer than this time ago are
+	// considered stuck.
+	stuckTransactionAge = 30 * time.Minute
+
+	// Timeout of a single receipt or transaction lookup.
+	transactionLookupTimeout = 30 * time.Second
+)
+
+// TransactionStatus represents a stage of the transaction lifecycle.
+type TransactionStatus int
+
+const (
+	// TransactionPending means the transaction has been submitted but it has
+	// not been mined yet.
+	TransactionPending TransactionStatus = iota
+	// TransactionMined means the transaction has been mined successfully.
+	TransactionMined
+	// TransactionReverted means the transaction has been mined but its
+	// execution failed.
+	TransactionReverted
+	// TransactionDropped means the nonce of the transaction has been used
+	// by another transaction and none of the transaction submissions will
+	// be mined.
+	TransactionDropped
+)
+
+func (ts TransactionStatus) String() string {
+	switch ts {
+	case TransactionPending:
+		return "pending"
+	case TransactionMined:
+		return "mined"
+	case TransactionReverted:
+		return "reverted"
+	case TransactionDropped:
+		return "dropped"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(ts))
+	}
+}
+
+// TransactionSubmission is a single submission of the transaction. The
+// transaction is resubmitted with a higher gas price when it is not mined
+// in time.
+type TransactionSubmission struct {
+	Hash        common.Hash
+	GasPrice    *big.Int
+	SubmittedAt time.Time
+}
+
+// Transaction is a transaction noted in the transaction journal.
+type Transaction struct {
+	From  common.Address
+	Nonce uint64
+	// Action performed by the transaction, e.g. signature submission.
+	Purpose string
+	// Keep or deposit the transaction has been submitted for, if any.
+	Subject     string
+	Submissions []*TransactionSubmission
+	Status      TransactionStatus
+	// Hash of the submission which has been mined.
+	MinedHash   common.Hash
+	BlockNumber uint64
+	UpdatedAt   time.Time
+}
+
+// IsStuck returns true if the transaction is still pending long after its
+// first submission.
+func (t *Transaction) IsStuck(now time.Time) bool {
+	return t.Status == TransactionPending &&
+		len(t.Submissions) > 0 &&
+		now.Sub(t.Submissions[0].SubmittedAt) > stuckTransactionAge
+}
+
+func (t *Transaction) hasSubmission(hash common.Hash) bool {
+	for _, submission := range t.Submissions {
+		if submission.Hash == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TransactionJournal is a disk-backed record of transactions submitted by the
+// client, their resubmissions and their final status. Each transaction is
+// stored in a separate directory of the persistence layer.
+type TransactionJournal struct {
+	handle persistence.Handle
+
+	mutex        sync.Mutex
+	transactions map[string]*Transaction
+}
+
+// NewTransactionJournal returns an empty journal backed by the given
+// persistence handle. Transactions persisted before have to be loaded with
+// Load.
+func NewTransactionJournal(handle persistence.Handle) *TransactionJournal {
+	return &TransactionJournal{
+		handle:       handle,
+		transactions: make(map[string]*Transaction),
+	}
+}
+
+// Load reads all transactions from the persistence layer into memory.
+// Transactions which could not be read are logged and skipped.
+func (tj *TransactionJournal) Load() {
+	tj.mutex.Lock()
+	defer tj.mutex.Unlock()
+
+	dataChannel, errorsChannel := tj.handle.ReadAll()
+
+	// Data and errors channels are not buffered and we don't know in what
+	// order they are written, so both are read at the same time.
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for descriptor := range dataChannel {
+			content, err := descriptor.Content()
+			if err != nil {
+				logger.Errorf(
+					"could not read transaction from directory [%v]: [%v]",
+					descriptor.Directory(),
+					err,
+				)
+				continue
+			}
+
+			transaction := &Transaction{}
+			if err := json.Unmarshal(content, transaction); err != nil {
+				logger.Errorf(
+					"could not unmarshal transaction from directory [%v]: [%v]",
+					descriptor.Directory(),
+					err,
+				)
+				continue
+			}
+
+			tj.transactions[descriptor.Directory()] = transaction
+		}
+
+		wg.Done()
+	}()
+
+	go func() {
+		for err := range errorsChannel {
+			logger.Errorf("could not load transaction: [%v]", err)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+}
+
+// Transactions returns all transactions noted in the journal sorted by the
+// sender and nonce.
+func (tj *TransactionJournal) Transactions() []*Transaction {
+	tj.mutex.Lock()
+	defer tj.mutex.Unlock()
+
+	transactions := make([]*Transaction, 0, len(tj.transactions))
+	for _, transaction := range tj.transactions {
+		transactions = append(transactions, transaction)
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		if transactions[i].From != transactions[j].From {
+			return transactions[i].From.Hex() < transactions[j].From.Hex()
+		}
+		return transactions[i].Nonce < transactions[j].Nonce
+	})
+
+	return transactions
+}
+
+// StuckTransactions returns pending transactions first submitted long ago.
+func (tj *TransactionJournal) StuckTransactions() []*Transaction {
+	now := time.Now()
+
+	stuck := make([]*Transaction, 0)
+	for _, transaction := range tj.Transactions() {
+		if transaction.IsStuck(now) {
+			stuck = append(stuck, transaction)
+		}
+	}
+
+	return stuck
+}
+
+func (tj *TransactionJournal) pending() []*Transaction {
+	pending := make([]*Transaction, 0)
+	for _, transaction := range tj.Transactions() {
+		if transaction.Status == TransactionPending {
+			pending = append(pending, transaction)
+		}
+	}
+
+	return pending
+}
+
+// recordSubmission notes the submitted transaction. A transaction with the
+// nonce of a pending transaction of the same sender is a resubmission of
+// that transaction.
+func (tj *TransactionJournal) recordSubmission(
+	from common.Address,
+	transaction *types.Transaction,
+) error {
+	tj.mutex.Lock()
+	defer tj.mutex.Unlock()
+
+	submission := &TransactionSubmission{
+		Hash:        transaction.Hash(),
+		GasPrice:    transaction.GasPrice(),
+		SubmittedAt: time.Now(),
+	}
+
+	for key, noted := range tj.transactions {
+		if noted.From == from &&
+			noted.Nonce == transaction.Nonce() &&
+			noted.Status == TransactionPending {
+			noted.Submissions = append(noted.Submissions, submission)
+			return tj.save(key, noted)
+		}
+	}
+
+	noted := &Transaction{
+		From:        from,
+		Nonce:       transaction.Nonce(),
+		Submissions: []*TransactionSubmission{submission},
+		Status:      TransactionPending,
+	}
+
+	return tj.save(
+		fmt.Sprintf("%s_%d_%s", from.Hex(), transaction.Nonce(), transaction.Hash().Hex()),
+		noted,
+	)
+}
+
+// describe notes the purpose and the subject of the transaction with the
+// given submission hash.
+func (tj *TransactionJournal) describe(
+	hash common.Hash,
+	purpose string,
+	subject string,
+) error {
+	tj.mutex.Lock()
+	defer tj.mutex.Unlock()
+
+	for key, noted := range tj.transactions {
+		if noted.hasSubmission(hash) {
+			noted.Purpose = purpose
+			noted.Subject = subject
+			return tj.save(key, noted)
+		}
+	}
+
+	return fmt.Errorf("transaction [%s] not found in the journal", hash.Hex())
+}
+
+// updateStatus notes the final status of the transaction with the given
+// sender and nonce.
+func (tj *TransactionJournal) updateStatus(
+	transaction *Transaction,
+	status TransactionStatus,
+	minedHash common.Hash,
+	blockNumber uint64,
+) error {
+	tj.mutex.Lock()
+	defer tj.mutex.Unlock()
+
+	for key, noted := range tj.transactions {
+		if noted == transaction {
+			noted.Status = status
+			noted.MinedHash = minedHash
+			noted.BlockNumber = blockNumber
+			return tj.save(key, noted)
+		}
+	}
+
+	return fmt.Errorf(
+		"transaction with nonce [%d] of [%s] not found in the journal",
+		transaction.Nonce,
+		transaction.From.Hex(),
+	)
+}
+
+func (tj *TransactionJournal) save(key string, transaction *Transaction) error {
+	transaction.UpdatedAt = time.Now()
+
+	content, err := json.Marshal(transaction)
+	if err != nil {
+		return fmt.Errorf("failed to marshal transaction: [%v]", err)
+	}
+
+	if err := tj.handle.Save(content, key, transactionFileName); err != nil {
+		return fmt.Errorf("failed to save transaction: [%v]", err)
+	}
+
+	tj.transactions[key] = transaction
+
+	return nil
+}
+
+// transactionJournals holds journals of all accounts operating on the same
+// Ethereum connection.
+type transactionJournals struct {
+	mutex    sync.RWMutex
+	journals map[common.Address]*TransactionJournal
+}
+
+func newTransactionJournals() *transactionJournals {
+	return &transactionJournals{
+		journals: make(map[common.Address]*TransactionJournal),
+	}
+}
+
+func (tjs *transactionJournals) set(
+	account common.Address,
+	journal *TransactionJournal,
+) {
+	tjs.mutex.Lock()
+	defer tjs.mutex.Unlock()
+
+	tjs.journals[account] = journal
+}
+
+func (tjs *transactionJournals) get(account common.Address) (*TransactionJournal, bool) {
+	tjs.mutex.RLock()
+	defer tjs.mutex.RUnlock()
+
+	journal, ok := tjs.journals[account]
+	return journal, ok
+}
+
+// journalingClient notes every transaction sent through the client, including
+// resubmissions performed by the mining waiter, in the journal of the sender.
+type journalingClient struct {
+	ethutil.EthereumClient
+
+	journals *transactionJournals
+}
+
+func (jc *journalingClient) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	if err := jc.EthereumClient.SendTransaction(ctx, transaction); err != nil {
+		return err
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if transaction.Protected() {
+		signer = types.NewEIP155Signer(transaction.ChainId())
+	}
+
+	from, err := types.Sender(signer, transaction)
+	if err != nil {
+		logger.Warningf(
+			"could not determine sender of transaction [%s]: [%v]",
+			transaction.Hash().Hex(),
+			err,
+		)
+		return nil
+	}
+
+	if journal, ok := jc.journals.get(from); ok {
+		if err := journal.recordSubmission(from, transaction); err != nil {
+			logger.Errorf(
+				"could not record transaction [%s] in the journal: [%v]",
+				transaction.Hash().Hex(),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// EnableTransactionJournal notes transactions submitted on behalf of the
+// account of this handle in the given journal and tracks their receipts until
+// they are mined, reverted or dropped. Tracking stops when the context is done.
+func (ec *EthereumChain) EnableTransactionJournal(
+	ctx context.Context,
+	journal *TransactionJournal,
+) {
+	ec.transactionJournals.set(ec.Address(), journal)
+
+	go func() {
+		ticker := time.NewTicker(transactionsCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			ec.checkPendingTransactions(journal)
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// describeTransaction notes the purpose and the subject of the submitted
+// transaction in the journal, if the journal is enabled.
+func (ec *EthereumChain) describeTransaction(
+	transaction *types.Transaction,
+	purpose string,
+	subject string,
+) {
+	journal, ok := ec.transactionJournals.get(ec.Address())
+	if !ok {
+		return
+	}
+
+	if err := journal.describe(transaction.Hash(), purpose, subject); err != nil {
+		logger.Errorf(
+			"could not describe transaction [%s] in the journal: [%v]",
+			transaction.Hash().Hex(),
+			err,
+		)
+	}
+}
+
+func (ec *EthereumChain) checkPendingTransactions(journal *TransactionJournal) {
+	for _, transaction := range journal.pending() {
+		status, minedHash, blockNumber, err := ec.transactionStatus(transaction)
+		if err != nil {
+			logger.Warningf(
+				"could not check status of transaction with nonce [%d]: [%v]",
+				transaction.Nonce,
+				err,
+			)
+			continue
+		}
+
+		if status == TransactionPending {
+			if transaction.IsStuck(time.Now()) {
+				logger.Warningf(
+					"transaction [%s] with nonce [%d] submitted at [%v] "+
+						"is still pending",
+					transaction.Purpose,
+					transaction.Nonce,
+					transaction.Submissions[0].SubmittedAt,
+				)
+			}
+			continue
+		}
+
+		logger.Infof(
+			"transaction [%s] with nonce [%d] is [%v]",
+			transaction.Purpose,
+			transaction.Nonce,
+			status,
+		)
+
+		if err := journal.updateStatus(
+			transaction,
+			status,
+			minedHash,
+			blockNumber,
+		); err != nil {
+			logger.Errorf("could not update transaction status: [%v]", err)
+		}
+	}
+}
+
+// transactionStatus determines the status of the transaction based on the
+// receipts of its submissions. The transaction is considered dropped if none
+// of its submissions is known to the node while the nonce of the transaction
+// has already been used.
+func (ec *EthereumChain) transactionStatus(
+	transaction *Transaction,
+) (TransactionStatus, common.Hash, uint64, error) {
+	isKnown := false
+
+	for _, submission := range transaction.Submissions {
+		ctx, cancel := context.WithTimeout(
+			context.Background(),
+			transactionLookupTimeout,
+		)
+		receipt, err := ec.client.TransactionReceipt(ctx, submission.Hash)
+		cancel()
+
+		if err == nil && receipt != nil {
+			status := TransactionMined
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				status = TransactionReverted
+			}
+			return status, submission.Hash, receipt.BlockNumber.Uint64(), nil
+		}
+		if err != nil && err != ethereum.NotFound {
+			return TransactionPending, common.Hash{}, 0, err
+		}
+
+		ctx, cancel = context.WithTimeout(
+			context.Background(),
+			transactionLookupTimeout,
+		)
+		_, _, err = ec.client.TransactionByHash(ctx, submission.Hash)
+		cancel()
+
+		if err == nil {
+			isKnown = true
+		} else if err != ethereum.NotFound {
+			return TransactionPending, common.Hash{}, 0, err
+		}
+	}
+
+	if isKnown {
+		return TransactionPending, common.Hash{}, 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		transactionLookupTimeout,
+	)
+	defer cancel()
+
+	nonce, err := ec.client.PendingNonceAt(ctx, transaction.From)
+	if err != nil {
+		return TransactionPending, common.Hash{}, 0, err
+	}
+
+	if nonce > transaction.Nonce {
+		return TransactionDropped, common.Hash{}, 0, nil
+	}
+
+	return TransactionPending, common.Hash{}, 0, nil
+}
@@ -0,0 +1,318 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/keep-network/keep-common/pkg/subscription"
+)
+
+// Determines how often past events are polled while an event subscription
+// is not established.
+const disconnectedPollInterval = 15 * time.Second
+
+// SubscriptionsHealth is a snapshot of the state of event subscriptions.
+type SubscriptionsHealth struct {
+	// Number of subscriptions which are currently established.
+	Connected int
+	// Number of subscriptions which are waiting for resubscription. Events
+	// of these subscriptions are polled from the chain history.
+	Disconnected int
+	// Number of times subscriptions have been re-established since the
+	// client start.
+	Resubscriptions uint64
+	// Number of events delivered by polling instead of subscriptions since
+	// the client start.
+	PolledEvents uint64
+}
+
+// subscriptionsTrack keeps the state of all event subscriptions of the chain
+// handle.
+type subscriptionsTrack struct {
+	mutex           sync.Mutex
+	connected       map[*eventWatcher]bool
+	resubscriptions uint64
+	polledEvents    uint64
+}
+
+func newSubscriptionsTrack() *subscriptionsTrack {
+	return &subscriptionsTrack{
+		connected: make(map[*eventWatcher]bool),
+	}
+}
+
+func (st *subscriptionsTrack) setConnected(watcher *eventWatcher, connected bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	st.connected[watcher] = connected
+}
+
+func (st *subscriptionsTrack) remove(watcher *eventWatcher) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	delete(st.connected, watcher)
+}
+
+func (st *subscriptionsTrack) noteResubscription() {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	st.resubscriptions++
+}
+
+func (st *subscriptionsTrack) notePolledEvent() {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	st.polledEvents++
+}
+
+func (st *subscriptionsTrack) health() SubscriptionsHealth {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	health := SubscriptionsHealth{
+		Resubscriptions: st.resubscriptions,
+		PolledEvents:    st.polledEvents,
+	}
+
+	for _, connected := range st.connected {
+		if connected {
+			health.Connected++
+		} else {
+			health.Disconnected++
+		}
+	}
+
+	return health
+}
+
+// SubscriptionsHealth returns a snapshot of the state of event subscriptions.
+// Handles created with WithAccount share the state with the original handle.
+func (ec *EthereumChain) SubscriptionsHealth() SubscriptionsHealth {
+	return ec.subscriptions.health()
+}
+
+// eventDelivery passes the event with the given log to the handle function
+// unless the event has already been delivered.
+type eventDelivery func(log types.Log, handle func())
+
+// eventSource describes how events of a single kind are watched and polled.
+type eventSource struct {
+	name string
+	// subscribe establishes a subscription delivering events as they are
+	// emitted. Failures of the subscription are reported by its error channel.
+	subscribe func(deliver eventDelivery) (event.Subscription, error)
+	// poll delivers events emitted since the given block.
+	poll func(startBlock uint64, deliver eventDelivery) error
+}
+
+// eventWatcher keeps the subscription of the event source established. When
+// the subscription fails, it is re-established with delays determined by the
+// subscriptions backoff policy. Until then, events are polled from the chain
+// history, so no event emitted in the meantime is missed. Events seen by both
+// the subscription and polling are delivered once.
+type eventWatcher struct {
+	chain  *EthereumChain
+	source *eventSource
+
+	mutex sync.Mutex
+	// Block from which events are polled. All events emitted before it have
+	// already been delivered.
+	startBlock uint64
+	// Blocks of delivered events by their identifiers.
+	deliveredEvents map[string]uint64
+}
+
+// watchEvents starts watching events of the given source. Watching stops when
+// the returned subscription is unsubscribed.
+func (ec *EthereumChain) watchEvents(source *eventSource) subscription.EventSubscription {
+	startBlock, err := ec.blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Warningf(
+			"could not get current block for [%s] events watch: [%v]",
+			source.name,
+			err,
+		)
+	}
+
+	watcher := &eventWatcher{
+		chain:           ec,
+		source:          source,
+		startBlock:      startBlock,
+		deliveredEvents: make(map[string]uint64),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go watcher.run(ctx)
+
+	return subscription.NewEventSubscription(cancel)
+}
+
+func (ew *eventWatcher) run(ctx context.Context) {
+	subscriptions := ew.chain.subscriptions
+	defer subscriptions.remove(ew)
+
+	for attempt := 0; ; attempt++ {
+		eventSubscription, err := ew.source.subscribe(ew.deliver)
+		if err == nil {
+			if attempt > 0 {
+				logger.Infof(
+					"re-established subscription for [%s] events",
+					ew.source.name,
+				)
+				subscriptions.noteResubscription()
+
+				// Events emitted between the last poll and the new
+				// subscription are not delivered by the subscription.
+				ew.pollPastEvents()
+			}
+
+			attempt = 0
+			subscriptions.setConnected(ew, true)
+
+			select {
+			case err = <-eventSubscription.Err():
+				eventSubscription.Unsubscribe()
+			case <-ctx.Done():
+				eventSubscription.Unsubscribe()
+				return
+			}
+		}
+
+		subscriptions.setConnected(ew, false)
+
+		logger.Warningf(
+			"subscription for [%s] events failed: [%v]; "+
+				"polling events until the subscription is re-established",
+			ew.source.name,
+			err,
+		)
+
+		ew.pollPastEvents()
+
+		if err := ew.waitPolling(
+			ctx,
+			ew.chain.subscriptionsPolicy.Delay(attempt+1),
+		); err != nil {
+			return
+		}
+	}
+}
+
+// waitPolling waits for the given delay and polls past events in the
+// meantime. It returns an error if the context is done before the delay
+// elapses.
+func (ew *eventWatcher) waitPolling(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(disconnectedPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return nil
+		case <-ticker.C:
+			ew.pollPastEvents()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// pollPastEvents delivers events emitted since the start block and advances
+// the start block to the current block.
+func (ew *eventWatcher) pollPastEvents() {
+	currentBlock, err := ew.chain.blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Errorf(
+			"could not get current block to poll [%s] events: [%v]",
+			ew.source.name,
+			err,
+		)
+		return
+	}
+
+	ew.mutex.Lock()
+	startBlock := ew.startBlock
+	ew.mutex.Unlock()
+
+	err = ew.source.poll(startBlock, func(log types.Log, handle func()) {
+		if ew.deliverOnce(log, handle) {
+			logger.Infof(
+				"delivered polled [%s] event emitted at block [%d]",
+				ew.source.name,
+				log.BlockNumber,
+			)
+			ew.chain.subscriptions.notePolledEvent()
+		}
+	})
+	if err != nil {
+		logger.Errorf(
+			"could not poll [%s] events since block [%d]: [%v]",
+			ew.source.name,
+			startBlock,
+			err,
+		)
+		return
+	}
+
+	ew.advanceStartBlock(currentBlock)
+}
+
+func (ew *eventWatcher) deliver(log types.Log, handle func()) {
+	ew.deliverOnce(log, handle)
+}
+
+// deliverOnce calls the handle function if the event with the given log has
+// not been delivered yet. Logs removed by a chain reorganization are not
+// delivered. It returns true if the event has been delivered.
+func (ew *eventWatcher) deliverOnce(log types.Log, handle func()) bool {
+	if log.Removed {
+		return false
+	}
+
+	eventID := fmt.Sprintf("%s-%d", log.TxHash.Hex(), log.Index)
+
+	ew.mutex.Lock()
+	if _, ok := ew.deliveredEvents[eventID]; ok {
+		ew.mutex.Unlock()
+		return false
+	}
+	ew.deliveredEvents[eventID] = log.BlockNumber
+	ew.mutex.Unlock()
+
+	ew.advanceStartBlock(log.BlockNumber)
+
+	handle()
+
+	return true
+}
+
+// advanceStartBlock moves the start block of polling to the given block if
+// it is higher than the current one. Delivered events emitted before the start
+// block are forgotten as they are not polled anymore.
+func (ew *eventWatcher) advanceStartBlock(block uint64) {
+	ew.mutex.Lock()
+	defer ew.mutex.Unlock()
+
+	if block <= ew.startBlock {
+		return
+	}
+
+	ew.startBlock = block
+
+	for eventID, eventBlock := range ew.deliveredEvents {
+		if eventBlock < block {
+			delete(ew.deliveredEvents, eventID)
+		}
+	}
+}
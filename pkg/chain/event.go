@@ -9,6 +9,7 @@ type BondedECDSAKeepCreatedEvent struct {
 	KeepAddress     common.Address   // keep contract address
 	Members         []common.Address // keep members addresses
 	HonestThreshold uint64
+	BlockNumber     uint64
 }
 
 // ConflictingPublicKeySubmittedEvent is an event emitted each time when one of
@@ -0,0 +1,1726 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ECDSARewardsABI is the input ABI used to generate the binding from.
+const ECDSARewardsABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_factoryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_tokenStakingAddress\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"keep\",\"type\":\"bytes32\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"RewardReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amountTransferred\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"UpgradeFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newRewardsContract\",\"type\":\"address\",\"indexed\":false}],\"name\":\"UpgradeInitiated\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"allocateRewards\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"beneficiaryRewardCap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"dispensedRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_keep\",\"type\":\"bytes32\"}],\"name\":\"eligibleButTerminated\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_keep\",\"type\":\"bytes32\"}],\"name\":\"eligibleForReward\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"endOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"finalizeRewardsUpgrade\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"firstIntervalStart\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"funded\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"getAllocatedRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"getAllocatedRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getIntervalCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"getIntervalWeight\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"getWithdrawableRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"getWithdrawnRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newRewardsContract\",\"type\":\"address\"}],\"name\":\"initiateRewardsUpgrade\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"intervalKeepsProcessed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"intervalOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"isAllocated\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"isFinished\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"keepsInInterval\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"markAsFunded\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"minimumKeepsPerInterval\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"newRewardsContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"name\":\"receiveApproval\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"keepIdentifier\",\"type\":\"bytes32\"}],\"name\":\"receiveReward\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"keepIdentifiers\",\"type\":\"bytes32[]\"}],\"name\":\"receiveRewards\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"keepIdentifier\",\"type\":\"bytes32\"}],\"name\":\"reportTermination\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"keepIdentifiers\",\"type\":\"bytes32[]\"}],\"name\":\"reportTerminations\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_keep\",\"type\":\"bytes32\"}],\"name\":\"rewardClaimed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"}],\"name\":\"startOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"token\",\"outputs\":[{\"internalType\":\"contractKeepToken\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"totalRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"unallocatedRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"upgradeFinalizedTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"upgradeInitiatedTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"withdrawRewards\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// ECDSARewards is an auto generated Go binding around an Ethereum contract.
+type ECDSARewards struct {
+	ECDSARewardsCaller     // Read-only binding to the contract
+	ECDSARewardsTransactor // Write-only binding to the contract
+	ECDSARewardsFilterer   // Log filterer for contract events
+}
+
+// ECDSARewardsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ECDSARewardsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ECDSARewardsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ECDSARewardsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ECDSARewardsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ECDSARewardsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ECDSARewardsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ECDSARewardsSession struct {
+	Contract     *ECDSARewards     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ECDSARewardsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ECDSARewardsCallerSession struct {
+	Contract *ECDSARewardsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// ECDSARewardsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ECDSARewardsTransactorSession struct {
+	Contract     *ECDSARewardsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ECDSARewardsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ECDSARewardsRaw struct {
+	Contract *ECDSARewards // Generic contract binding to access the raw methods on
+}
+
+// ECDSARewardsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ECDSARewardsCallerRaw struct {
+	Contract *ECDSARewardsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ECDSARewardsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ECDSARewardsTransactorRaw struct {
+	Contract *ECDSARewardsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewECDSARewards creates a new instance of ECDSARewards, bound to a specific deployed contract.
+func NewECDSARewards(address common.Address, backend bind.ContractBackend) (*ECDSARewards, error) {
+	contract, err := bindECDSARewards(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewards{ECDSARewardsCaller: ECDSARewardsCaller{contract: contract}, ECDSARewardsTransactor: ECDSARewardsTransactor{contract: contract}, ECDSARewardsFilterer: ECDSARewardsFilterer{contract: contract}}, nil
+}
+
+// NewECDSARewardsCaller creates a new read-only instance of ECDSARewards, bound to a specific deployed contract.
+func NewECDSARewardsCaller(address common.Address, caller bind.ContractCaller) (*ECDSARewardsCaller, error) {
+	contract, err := bindECDSARewards(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsCaller{contract: contract}, nil
+}
+
+// NewECDSARewardsTransactor creates a new write-only instance of ECDSARewards, bound to a specific deployed contract.
+func NewECDSARewardsTransactor(address common.Address, transactor bind.ContractTransactor) (*ECDSARewardsTransactor, error) {
+	contract, err := bindECDSARewards(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsTransactor{contract: contract}, nil
+}
+
+// NewECDSARewardsFilterer creates a new log filterer instance of ECDSARewards, bound to a specific deployed contract.
+func NewECDSARewardsFilterer(address common.Address, filterer bind.ContractFilterer) (*ECDSARewardsFilterer, error) {
+	contract, err := bindECDSARewards(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsFilterer{contract: contract}, nil
+}
+
+// bindECDSARewards binds a generic wrapper to an already deployed contract.
+func bindECDSARewards(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ECDSARewardsABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ECDSARewards *ECDSARewardsRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _ECDSARewards.Contract.ECDSARewardsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ECDSARewards *ECDSARewardsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ECDSARewardsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ECDSARewards *ECDSARewardsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ECDSARewardsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ECDSARewards *ECDSARewardsCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _ECDSARewards.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ECDSARewards *ECDSARewardsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ECDSARewards *ECDSARewardsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.contract.Transact(opts, method, params...)
+}
+
+// BeneficiaryRewardCap is a free data retrieval call binding the contract method 0x725cf04e.
+//
+// Solidity: function beneficiaryRewardCap() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) BeneficiaryRewardCap(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "beneficiaryRewardCap")
+	return *ret0, err
+}
+
+// BeneficiaryRewardCap is a free data retrieval call binding the contract method 0x725cf04e.
+//
+// Solidity: function beneficiaryRewardCap() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) BeneficiaryRewardCap() (*big.Int, error) {
+	return _ECDSARewards.Contract.BeneficiaryRewardCap(&_ECDSARewards.CallOpts)
+}
+
+// BeneficiaryRewardCap is a free data retrieval call binding the contract method 0x725cf04e.
+//
+// Solidity: function beneficiaryRewardCap() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) BeneficiaryRewardCap() (*big.Int, error) {
+	return _ECDSARewards.Contract.BeneficiaryRewardCap(&_ECDSARewards.CallOpts)
+}
+
+// DispensedRewards is a free data retrieval call binding the contract method 0xe1fcd5e8.
+//
+// Solidity: function dispensedRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) DispensedRewards(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "dispensedRewards")
+	return *ret0, err
+}
+
+// DispensedRewards is a free data retrieval call binding the contract method 0xe1fcd5e8.
+//
+// Solidity: function dispensedRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) DispensedRewards() (*big.Int, error) {
+	return _ECDSARewards.Contract.DispensedRewards(&_ECDSARewards.CallOpts)
+}
+
+// DispensedRewards is a free data retrieval call binding the contract method 0xe1fcd5e8.
+//
+// Solidity: function dispensedRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) DispensedRewards() (*big.Int, error) {
+	return _ECDSARewards.Contract.DispensedRewards(&_ECDSARewards.CallOpts)
+}
+
+// EligibleButTerminated is a free data retrieval call binding the contract method 0x97a1e434.
+//
+// Solidity: function eligibleButTerminated(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) EligibleButTerminated(opts *bind.CallOpts, _keep [32]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "eligibleButTerminated", _keep)
+	return *ret0, err
+}
+
+// EligibleButTerminated is a free data retrieval call binding the contract method 0x97a1e434.
+//
+// Solidity: function eligibleButTerminated(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) EligibleButTerminated(_keep [32]byte) (bool, error) {
+	return _ECDSARewards.Contract.EligibleButTerminated(&_ECDSARewards.CallOpts, _keep)
+}
+
+// EligibleButTerminated is a free data retrieval call binding the contract method 0x97a1e434.
+//
+// Solidity: function eligibleButTerminated(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) EligibleButTerminated(_keep [32]byte) (bool, error) {
+	return _ECDSARewards.Contract.EligibleButTerminated(&_ECDSARewards.CallOpts, _keep)
+}
+
+// EligibleForReward is a free data retrieval call binding the contract method 0x12f57063.
+//
+// Solidity: function eligibleForReward(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) EligibleForReward(opts *bind.CallOpts, _keep [32]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "eligibleForReward", _keep)
+	return *ret0, err
+}
+
+// EligibleForReward is a free data retrieval call binding the contract method 0x12f57063.
+//
+// Solidity: function eligibleForReward(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) EligibleForReward(_keep [32]byte) (bool, error) {
+	return _ECDSARewards.Contract.EligibleForReward(&_ECDSARewards.CallOpts, _keep)
+}
+
+// EligibleForReward is a free data retrieval call binding the contract method 0x12f57063.
+//
+// Solidity: function eligibleForReward(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) EligibleForReward(_keep [32]byte) (bool, error) {
+	return _ECDSARewards.Contract.EligibleForReward(&_ECDSARewards.CallOpts, _keep)
+}
+
+// EndOf is a free data retrieval call binding the contract method 0xb965933e.
+//
+// Solidity: function endOf(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) EndOf(opts *bind.CallOpts, interval *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "endOf", interval)
+	return *ret0, err
+}
+
+// EndOf is a free data retrieval call binding the contract method 0xb965933e.
+//
+// Solidity: function endOf(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) EndOf(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.EndOf(&_ECDSARewards.CallOpts, interval)
+}
+
+// EndOf is a free data retrieval call binding the contract method 0xb965933e.
+//
+// Solidity: function endOf(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) EndOf(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.EndOf(&_ECDSARewards.CallOpts, interval)
+}
+
+// FirstIntervalStart is a free data retrieval call binding the contract method 0x95aeca48.
+//
+// Solidity: function firstIntervalStart() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) FirstIntervalStart(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "firstIntervalStart")
+	return *ret0, err
+}
+
+// FirstIntervalStart is a free data retrieval call binding the contract method 0x95aeca48.
+//
+// Solidity: function firstIntervalStart() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) FirstIntervalStart() (*big.Int, error) {
+	return _ECDSARewards.Contract.FirstIntervalStart(&_ECDSARewards.CallOpts)
+}
+
+// FirstIntervalStart is a free data retrieval call binding the contract method 0x95aeca48.
+//
+// Solidity: function firstIntervalStart() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) FirstIntervalStart() (*big.Int, error) {
+	return _ECDSARewards.Contract.FirstIntervalStart(&_ECDSARewards.CallOpts)
+}
+
+// Funded is a free data retrieval call binding the contract method 0xf3a504f2.
+//
+// Solidity: function funded() constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) Funded(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "funded")
+	return *ret0, err
+}
+
+// Funded is a free data retrieval call binding the contract method 0xf3a504f2.
+//
+// Solidity: function funded() constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) Funded() (bool, error) {
+	return _ECDSARewards.Contract.Funded(&_ECDSARewards.CallOpts)
+}
+
+// Funded is a free data retrieval call binding the contract method 0xf3a504f2.
+//
+// Solidity: function funded() constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) Funded() (bool, error) {
+	return _ECDSARewards.Contract.Funded(&_ECDSARewards.CallOpts)
+}
+
+// GetAllocatedRewards is a free data retrieval call binding the contract method 0x0e658abc.
+//
+// Solidity: function getAllocatedRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) GetAllocatedRewards(opts *bind.CallOpts, interval *big.Int, operator common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "getAllocatedRewards", interval, operator)
+	return *ret0, err
+}
+
+// GetAllocatedRewards is a free data retrieval call binding the contract method 0x0e658abc.
+//
+// Solidity: function getAllocatedRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) GetAllocatedRewards(interval *big.Int, operator common.Address) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetAllocatedRewards(&_ECDSARewards.CallOpts, interval, operator)
+}
+
+// GetAllocatedRewards is a free data retrieval call binding the contract method 0x0e658abc.
+//
+// Solidity: function getAllocatedRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) GetAllocatedRewards(interval *big.Int, operator common.Address) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetAllocatedRewards(&_ECDSARewards.CallOpts, interval, operator)
+}
+
+// GetAllocatedRewards0 is a free data retrieval call binding the contract method 0x35c9ef71.
+//
+// Solidity: function getAllocatedRewards(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) GetAllocatedRewards0(opts *bind.CallOpts, interval *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "getAllocatedRewards0", interval)
+	return *ret0, err
+}
+
+// GetAllocatedRewards0 is a free data retrieval call binding the contract method 0x35c9ef71.
+//
+// Solidity: function getAllocatedRewards(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) GetAllocatedRewards0(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetAllocatedRewards0(&_ECDSARewards.CallOpts, interval)
+}
+
+// GetAllocatedRewards0 is a free data retrieval call binding the contract method 0x35c9ef71.
+//
+// Solidity: function getAllocatedRewards(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) GetAllocatedRewards0(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetAllocatedRewards0(&_ECDSARewards.CallOpts, interval)
+}
+
+// GetIntervalCount is a free data retrieval call binding the contract method 0x183affa1.
+//
+// Solidity: function getIntervalCount() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) GetIntervalCount(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "getIntervalCount")
+	return *ret0, err
+}
+
+// GetIntervalCount is a free data retrieval call binding the contract method 0x183affa1.
+//
+// Solidity: function getIntervalCount() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) GetIntervalCount() (*big.Int, error) {
+	return _ECDSARewards.Contract.GetIntervalCount(&_ECDSARewards.CallOpts)
+}
+
+// GetIntervalCount is a free data retrieval call binding the contract method 0x183affa1.
+//
+// Solidity: function getIntervalCount() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) GetIntervalCount() (*big.Int, error) {
+	return _ECDSARewards.Contract.GetIntervalCount(&_ECDSARewards.CallOpts)
+}
+
+// GetIntervalWeight is a free data retrieval call binding the contract method 0x36544951.
+//
+// Solidity: function getIntervalWeight(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) GetIntervalWeight(opts *bind.CallOpts, interval *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "getIntervalWeight", interval)
+	return *ret0, err
+}
+
+// GetIntervalWeight is a free data retrieval call binding the contract method 0x36544951.
+//
+// Solidity: function getIntervalWeight(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) GetIntervalWeight(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetIntervalWeight(&_ECDSARewards.CallOpts, interval)
+}
+
+// GetIntervalWeight is a free data retrieval call binding the contract method 0x36544951.
+//
+// Solidity: function getIntervalWeight(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) GetIntervalWeight(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetIntervalWeight(&_ECDSARewards.CallOpts, interval)
+}
+
+// GetWithdrawableRewards is a free data retrieval call binding the contract method 0xfbf7d0d5.
+//
+// Solidity: function getWithdrawableRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) GetWithdrawableRewards(opts *bind.CallOpts, interval *big.Int, operator common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "getWithdrawableRewards", interval, operator)
+	return *ret0, err
+}
+
+// GetWithdrawableRewards is a free data retrieval call binding the contract method 0xfbf7d0d5.
+//
+// Solidity: function getWithdrawableRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) GetWithdrawableRewards(interval *big.Int, operator common.Address) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetWithdrawableRewards(&_ECDSARewards.CallOpts, interval, operator)
+}
+
+// GetWithdrawableRewards is a free data retrieval call binding the contract method 0xfbf7d0d5.
+//
+// Solidity: function getWithdrawableRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) GetWithdrawableRewards(interval *big.Int, operator common.Address) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetWithdrawableRewards(&_ECDSARewards.CallOpts, interval, operator)
+}
+
+// GetWithdrawnRewards is a free data retrieval call binding the contract method 0x614851d8.
+//
+// Solidity: function getWithdrawnRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) GetWithdrawnRewards(opts *bind.CallOpts, interval *big.Int, operator common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "getWithdrawnRewards", interval, operator)
+	return *ret0, err
+}
+
+// GetWithdrawnRewards is a free data retrieval call binding the contract method 0x614851d8.
+//
+// Solidity: function getWithdrawnRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) GetWithdrawnRewards(interval *big.Int, operator common.Address) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetWithdrawnRewards(&_ECDSARewards.CallOpts, interval, operator)
+}
+
+// GetWithdrawnRewards is a free data retrieval call binding the contract method 0x614851d8.
+//
+// Solidity: function getWithdrawnRewards(uint256 interval, address operator) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) GetWithdrawnRewards(interval *big.Int, operator common.Address) (*big.Int, error) {
+	return _ECDSARewards.Contract.GetWithdrawnRewards(&_ECDSARewards.CallOpts, interval, operator)
+}
+
+// IntervalKeepsProcessed is a free data retrieval call binding the contract method 0x6a823878.
+//
+// Solidity: function intervalKeepsProcessed(uint256 ) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) IntervalKeepsProcessed(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "intervalKeepsProcessed", arg0)
+	return *ret0, err
+}
+
+// IntervalKeepsProcessed is a free data retrieval call binding the contract method 0x6a823878.
+//
+// Solidity: function intervalKeepsProcessed(uint256 ) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) IntervalKeepsProcessed(arg0 *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.IntervalKeepsProcessed(&_ECDSARewards.CallOpts, arg0)
+}
+
+// IntervalKeepsProcessed is a free data retrieval call binding the contract method 0x6a823878.
+//
+// Solidity: function intervalKeepsProcessed(uint256 ) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) IntervalKeepsProcessed(arg0 *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.IntervalKeepsProcessed(&_ECDSARewards.CallOpts, arg0)
+}
+
+// IntervalOf is a free data retrieval call binding the contract method 0xb369af71.
+//
+// Solidity: function intervalOf(uint256 timestamp) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) IntervalOf(opts *bind.CallOpts, timestamp *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "intervalOf", timestamp)
+	return *ret0, err
+}
+
+// IntervalOf is a free data retrieval call binding the contract method 0xb369af71.
+//
+// Solidity: function intervalOf(uint256 timestamp) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) IntervalOf(timestamp *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.IntervalOf(&_ECDSARewards.CallOpts, timestamp)
+}
+
+// IntervalOf is a free data retrieval call binding the contract method 0xb369af71.
+//
+// Solidity: function intervalOf(uint256 timestamp) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) IntervalOf(timestamp *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.IntervalOf(&_ECDSARewards.CallOpts, timestamp)
+}
+
+// IsAllocated is a free data retrieval call binding the contract method 0x0a1bb1b1.
+//
+// Solidity: function isAllocated(uint256 interval) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) IsAllocated(opts *bind.CallOpts, interval *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "isAllocated", interval)
+	return *ret0, err
+}
+
+// IsAllocated is a free data retrieval call binding the contract method 0x0a1bb1b1.
+//
+// Solidity: function isAllocated(uint256 interval) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) IsAllocated(interval *big.Int) (bool, error) {
+	return _ECDSARewards.Contract.IsAllocated(&_ECDSARewards.CallOpts, interval)
+}
+
+// IsAllocated is a free data retrieval call binding the contract method 0x0a1bb1b1.
+//
+// Solidity: function isAllocated(uint256 interval) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) IsAllocated(interval *big.Int) (bool, error) {
+	return _ECDSARewards.Contract.IsAllocated(&_ECDSARewards.CallOpts, interval)
+}
+
+// IsFinished is a free data retrieval call binding the contract method 0xe8ba6509.
+//
+// Solidity: function isFinished(uint256 interval) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) IsFinished(opts *bind.CallOpts, interval *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "isFinished", interval)
+	return *ret0, err
+}
+
+// IsFinished is a free data retrieval call binding the contract method 0xe8ba6509.
+//
+// Solidity: function isFinished(uint256 interval) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) IsFinished(interval *big.Int) (bool, error) {
+	return _ECDSARewards.Contract.IsFinished(&_ECDSARewards.CallOpts, interval)
+}
+
+// IsFinished is a free data retrieval call binding the contract method 0xe8ba6509.
+//
+// Solidity: function isFinished(uint256 interval) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) IsFinished(interval *big.Int) (bool, error) {
+	return _ECDSARewards.Contract.IsFinished(&_ECDSARewards.CallOpts, interval)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "isOwner")
+	return *ret0, err
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) IsOwner() (bool, error) {
+	return _ECDSARewards.Contract.IsOwner(&_ECDSARewards.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) IsOwner() (bool, error) {
+	return _ECDSARewards.Contract.IsOwner(&_ECDSARewards.CallOpts)
+}
+
+// MinimumKeepsPerInterval is a free data retrieval call binding the contract method 0xc70cee6a.
+//
+// Solidity: function minimumKeepsPerInterval() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) MinimumKeepsPerInterval(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "minimumKeepsPerInterval")
+	return *ret0, err
+}
+
+// MinimumKeepsPerInterval is a free data retrieval call binding the contract method 0xc70cee6a.
+//
+// Solidity: function minimumKeepsPerInterval() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) MinimumKeepsPerInterval() (*big.Int, error) {
+	return _ECDSARewards.Contract.MinimumKeepsPerInterval(&_ECDSARewards.CallOpts)
+}
+
+// MinimumKeepsPerInterval is a free data retrieval call binding the contract method 0xc70cee6a.
+//
+// Solidity: function minimumKeepsPerInterval() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) MinimumKeepsPerInterval() (*big.Int, error) {
+	return _ECDSARewards.Contract.MinimumKeepsPerInterval(&_ECDSARewards.CallOpts)
+}
+
+// NewRewardsContract is a free data retrieval call binding the contract method 0x81b83c33.
+//
+// Solidity: function newRewardsContract() constant returns(address)
+func (_ECDSARewards *ECDSARewardsCaller) NewRewardsContract(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "newRewardsContract")
+	return *ret0, err
+}
+
+// NewRewardsContract is a free data retrieval call binding the contract method 0x81b83c33.
+//
+// Solidity: function newRewardsContract() constant returns(address)
+func (_ECDSARewards *ECDSARewardsSession) NewRewardsContract() (common.Address, error) {
+	return _ECDSARewards.Contract.NewRewardsContract(&_ECDSARewards.CallOpts)
+}
+
+// NewRewardsContract is a free data retrieval call binding the contract method 0x81b83c33.
+//
+// Solidity: function newRewardsContract() constant returns(address)
+func (_ECDSARewards *ECDSARewardsCallerSession) NewRewardsContract() (common.Address, error) {
+	return _ECDSARewards.Contract.NewRewardsContract(&_ECDSARewards.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() constant returns(address)
+func (_ECDSARewards *ECDSARewardsCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "owner")
+	return *ret0, err
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() constant returns(address)
+func (_ECDSARewards *ECDSARewardsSession) Owner() (common.Address, error) {
+	return _ECDSARewards.Contract.Owner(&_ECDSARewards.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() constant returns(address)
+func (_ECDSARewards *ECDSARewardsCallerSession) Owner() (common.Address, error) {
+	return _ECDSARewards.Contract.Owner(&_ECDSARewards.CallOpts)
+}
+
+// RewardClaimed is a free data retrieval call binding the contract method 0xce2830ad.
+//
+// Solidity: function rewardClaimed(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCaller) RewardClaimed(opts *bind.CallOpts, _keep [32]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "rewardClaimed", _keep)
+	return *ret0, err
+}
+
+// RewardClaimed is a free data retrieval call binding the contract method 0xce2830ad.
+//
+// Solidity: function rewardClaimed(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsSession) RewardClaimed(_keep [32]byte) (bool, error) {
+	return _ECDSARewards.Contract.RewardClaimed(&_ECDSARewards.CallOpts, _keep)
+}
+
+// RewardClaimed is a free data retrieval call binding the contract method 0xce2830ad.
+//
+// Solidity: function rewardClaimed(bytes32 _keep) constant returns(bool)
+func (_ECDSARewards *ECDSARewardsCallerSession) RewardClaimed(_keep [32]byte) (bool, error) {
+	return _ECDSARewards.Contract.RewardClaimed(&_ECDSARewards.CallOpts, _keep)
+}
+
+// StartOf is a free data retrieval call binding the contract method 0xb41e7f47.
+//
+// Solidity: function startOf(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) StartOf(opts *bind.CallOpts, interval *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "startOf", interval)
+	return *ret0, err
+}
+
+// StartOf is a free data retrieval call binding the contract method 0xb41e7f47.
+//
+// Solidity: function startOf(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) StartOf(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.StartOf(&_ECDSARewards.CallOpts, interval)
+}
+
+// StartOf is a free data retrieval call binding the contract method 0xb41e7f47.
+//
+// Solidity: function startOf(uint256 interval) constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) StartOf(interval *big.Int) (*big.Int, error) {
+	return _ECDSARewards.Contract.StartOf(&_ECDSARewards.CallOpts, interval)
+}
+
+// Token is a free data retrieval call binding the contract method 0xfc0c546a.
+//
+// Solidity: function token() constant returns(address)
+func (_ECDSARewards *ECDSARewardsCaller) Token(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "token")
+	return *ret0, err
+}
+
+// Token is a free data retrieval call binding the contract method 0xfc0c546a.
+//
+// Solidity: function token() constant returns(address)
+func (_ECDSARewards *ECDSARewardsSession) Token() (common.Address, error) {
+	return _ECDSARewards.Contract.Token(&_ECDSARewards.CallOpts)
+}
+
+// Token is a free data retrieval call binding the contract method 0xfc0c546a.
+//
+// Solidity: function token() constant returns(address)
+func (_ECDSARewards *ECDSARewardsCallerSession) Token() (common.Address, error) {
+	return _ECDSARewards.Contract.Token(&_ECDSARewards.CallOpts)
+}
+
+// TotalRewards is a free data retrieval call binding the contract method 0x0e15561a.
+//
+// Solidity: function totalRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) TotalRewards(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "totalRewards")
+	return *ret0, err
+}
+
+// TotalRewards is a free data retrieval call binding the contract method 0x0e15561a.
+//
+// Solidity: function totalRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) TotalRewards() (*big.Int, error) {
+	return _ECDSARewards.Contract.TotalRewards(&_ECDSARewards.CallOpts)
+}
+
+// TotalRewards is a free data retrieval call binding the contract method 0x0e15561a.
+//
+// Solidity: function totalRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) TotalRewards() (*big.Int, error) {
+	return _ECDSARewards.Contract.TotalRewards(&_ECDSARewards.CallOpts)
+}
+
+// UnallocatedRewards is a free data retrieval call binding the contract method 0x2b6fdc7e.
+//
+// Solidity: function unallocatedRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) UnallocatedRewards(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "unallocatedRewards")
+	return *ret0, err
+}
+
+// UnallocatedRewards is a free data retrieval call binding the contract method 0x2b6fdc7e.
+//
+// Solidity: function unallocatedRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) UnallocatedRewards() (*big.Int, error) {
+	return _ECDSARewards.Contract.UnallocatedRewards(&_ECDSARewards.CallOpts)
+}
+
+// UnallocatedRewards is a free data retrieval call binding the contract method 0x2b6fdc7e.
+//
+// Solidity: function unallocatedRewards() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) UnallocatedRewards() (*big.Int, error) {
+	return _ECDSARewards.Contract.UnallocatedRewards(&_ECDSARewards.CallOpts)
+}
+
+// UpgradeFinalizedTimestamp is a free data retrieval call binding the contract method 0xce2b3e3c.
+//
+// Solidity: function upgradeFinalizedTimestamp() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) UpgradeFinalizedTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "upgradeFinalizedTimestamp")
+	return *ret0, err
+}
+
+// UpgradeFinalizedTimestamp is a free data retrieval call binding the contract method 0xce2b3e3c.
+//
+// Solidity: function upgradeFinalizedTimestamp() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) UpgradeFinalizedTimestamp() (*big.Int, error) {
+	return _ECDSARewards.Contract.UpgradeFinalizedTimestamp(&_ECDSARewards.CallOpts)
+}
+
+// UpgradeFinalizedTimestamp is a free data retrieval call binding the contract method 0xce2b3e3c.
+//
+// Solidity: function upgradeFinalizedTimestamp() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) UpgradeFinalizedTimestamp() (*big.Int, error) {
+	return _ECDSARewards.Contract.UpgradeFinalizedTimestamp(&_ECDSARewards.CallOpts)
+}
+
+// UpgradeInitiatedTimestamp is a free data retrieval call binding the contract method 0x95131526.
+//
+// Solidity: function upgradeInitiatedTimestamp() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCaller) UpgradeInitiatedTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ECDSARewards.contract.Call(opts, out, "upgradeInitiatedTimestamp")
+	return *ret0, err
+}
+
+// UpgradeInitiatedTimestamp is a free data retrieval call binding the contract method 0x95131526.
+//
+// Solidity: function upgradeInitiatedTimestamp() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) UpgradeInitiatedTimestamp() (*big.Int, error) {
+	return _ECDSARewards.Contract.UpgradeInitiatedTimestamp(&_ECDSARewards.CallOpts)
+}
+
+// UpgradeInitiatedTimestamp is a free data retrieval call binding the contract method 0x95131526.
+//
+// Solidity: function upgradeInitiatedTimestamp() constant returns(uint256)
+func (_ECDSARewards *ECDSARewardsCallerSession) UpgradeInitiatedTimestamp() (*big.Int, error) {
+	return _ECDSARewards.Contract.UpgradeInitiatedTimestamp(&_ECDSARewards.CallOpts)
+}
+
+// AllocateRewards is a paid mutator transaction binding the contract method 0x28fc33c7.
+//
+// Solidity: function allocateRewards(uint256 interval) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) AllocateRewards(opts *bind.TransactOpts, interval *big.Int) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "allocateRewards", interval)
+}
+
+// AllocateRewards is a paid mutator transaction binding the contract method 0x28fc33c7.
+//
+// Solidity: function allocateRewards(uint256 interval) returns()
+func (_ECDSARewards *ECDSARewardsSession) AllocateRewards(interval *big.Int) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.AllocateRewards(&_ECDSARewards.TransactOpts, interval)
+}
+
+// AllocateRewards is a paid mutator transaction binding the contract method 0x28fc33c7.
+//
+// Solidity: function allocateRewards(uint256 interval) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) AllocateRewards(interval *big.Int) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.AllocateRewards(&_ECDSARewards.TransactOpts, interval)
+}
+
+// FinalizeRewardsUpgrade is a paid mutator transaction binding the contract method 0xe27000e7.
+//
+// Solidity: function finalizeRewardsUpgrade() returns()
+func (_ECDSARewards *ECDSARewardsTransactor) FinalizeRewardsUpgrade(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "finalizeRewardsUpgrade")
+}
+
+// FinalizeRewardsUpgrade is a paid mutator transaction binding the contract method 0xe27000e7.
+//
+// Solidity: function finalizeRewardsUpgrade() returns()
+func (_ECDSARewards *ECDSARewardsSession) FinalizeRewardsUpgrade() (*types.Transaction, error) {
+	return _ECDSARewards.Contract.FinalizeRewardsUpgrade(&_ECDSARewards.TransactOpts)
+}
+
+// FinalizeRewardsUpgrade is a paid mutator transaction binding the contract method 0xe27000e7.
+//
+// Solidity: function finalizeRewardsUpgrade() returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) FinalizeRewardsUpgrade() (*types.Transaction, error) {
+	return _ECDSARewards.Contract.FinalizeRewardsUpgrade(&_ECDSARewards.TransactOpts)
+}
+
+// InitiateRewardsUpgrade is a paid mutator transaction binding the contract method 0xce21d8cf.
+//
+// Solidity: function initiateRewardsUpgrade(address _newRewardsContract) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) InitiateRewardsUpgrade(opts *bind.TransactOpts, _newRewardsContract common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "initiateRewardsUpgrade", _newRewardsContract)
+}
+
+// InitiateRewardsUpgrade is a paid mutator transaction binding the contract method 0xce21d8cf.
+//
+// Solidity: function initiateRewardsUpgrade(address _newRewardsContract) returns()
+func (_ECDSARewards *ECDSARewardsSession) InitiateRewardsUpgrade(_newRewardsContract common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.InitiateRewardsUpgrade(&_ECDSARewards.TransactOpts, _newRewardsContract)
+}
+
+// InitiateRewardsUpgrade is a paid mutator transaction binding the contract method 0xce21d8cf.
+//
+// Solidity: function initiateRewardsUpgrade(address _newRewardsContract) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) InitiateRewardsUpgrade(_newRewardsContract common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.InitiateRewardsUpgrade(&_ECDSARewards.TransactOpts, _newRewardsContract)
+}
+
+// KeepsInInterval is a paid mutator transaction binding the contract method 0xaeae364d.
+//
+// Solidity: function keepsInInterval(uint256 interval) returns(uint256)
+func (_ECDSARewards *ECDSARewardsTransactor) KeepsInInterval(opts *bind.TransactOpts, interval *big.Int) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "keepsInInterval", interval)
+}
+
+// KeepsInInterval is a paid mutator transaction binding the contract method 0xaeae364d.
+//
+// Solidity: function keepsInInterval(uint256 interval) returns(uint256)
+func (_ECDSARewards *ECDSARewardsSession) KeepsInInterval(interval *big.Int) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.KeepsInInterval(&_ECDSARewards.TransactOpts, interval)
+}
+
+// KeepsInInterval is a paid mutator transaction binding the contract method 0xaeae364d.
+//
+// Solidity: function keepsInInterval(uint256 interval) returns(uint256)
+func (_ECDSARewards *ECDSARewardsTransactorSession) KeepsInInterval(interval *big.Int) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.KeepsInInterval(&_ECDSARewards.TransactOpts, interval)
+}
+
+// MarkAsFunded is a paid mutator transaction binding the contract method 0x0da8882f.
+//
+// Solidity: function markAsFunded() returns()
+func (_ECDSARewards *ECDSARewardsTransactor) MarkAsFunded(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "markAsFunded")
+}
+
+// MarkAsFunded is a paid mutator transaction binding the contract method 0x0da8882f.
+//
+// Solidity: function markAsFunded() returns()
+func (_ECDSARewards *ECDSARewardsSession) MarkAsFunded() (*types.Transaction, error) {
+	return _ECDSARewards.Contract.MarkAsFunded(&_ECDSARewards.TransactOpts)
+}
+
+// MarkAsFunded is a paid mutator transaction binding the contract method 0x0da8882f.
+//
+// Solidity: function markAsFunded() returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) MarkAsFunded() (*types.Transaction, error) {
+	return _ECDSARewards.Contract.MarkAsFunded(&_ECDSARewards.TransactOpts)
+}
+
+// ReceiveApproval is a paid mutator transaction binding the contract method 0x8f4ffcb1.
+//
+// Solidity: function receiveApproval(address _from, uint256 _value, address _token, bytes ) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) ReceiveApproval(opts *bind.TransactOpts, _from common.Address, _value *big.Int, _token common.Address, arg3 []byte) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "receiveApproval", _from, _value, _token, arg3)
+}
+
+// ReceiveApproval is a paid mutator transaction binding the contract method 0x8f4ffcb1.
+//
+// Solidity: function receiveApproval(address _from, uint256 _value, address _token, bytes ) returns()
+func (_ECDSARewards *ECDSARewardsSession) ReceiveApproval(_from common.Address, _value *big.Int, _token common.Address, arg3 []byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReceiveApproval(&_ECDSARewards.TransactOpts, _from, _value, _token, arg3)
+}
+
+// ReceiveApproval is a paid mutator transaction binding the contract method 0x8f4ffcb1.
+//
+// Solidity: function receiveApproval(address _from, uint256 _value, address _token, bytes ) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) ReceiveApproval(_from common.Address, _value *big.Int, _token common.Address, arg3 []byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReceiveApproval(&_ECDSARewards.TransactOpts, _from, _value, _token, arg3)
+}
+
+// ReceiveReward is a paid mutator transaction binding the contract method 0x68ec9c42.
+//
+// Solidity: function receiveReward(bytes32 keepIdentifier) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) ReceiveReward(opts *bind.TransactOpts, keepIdentifier [32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "receiveReward", keepIdentifier)
+}
+
+// ReceiveReward is a paid mutator transaction binding the contract method 0x68ec9c42.
+//
+// Solidity: function receiveReward(bytes32 keepIdentifier) returns()
+func (_ECDSARewards *ECDSARewardsSession) ReceiveReward(keepIdentifier [32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReceiveReward(&_ECDSARewards.TransactOpts, keepIdentifier)
+}
+
+// ReceiveReward is a paid mutator transaction binding the contract method 0x68ec9c42.
+//
+// Solidity: function receiveReward(bytes32 keepIdentifier) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) ReceiveReward(keepIdentifier [32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReceiveReward(&_ECDSARewards.TransactOpts, keepIdentifier)
+}
+
+// ReceiveRewards is a paid mutator transaction binding the contract method 0x45016312.
+//
+// Solidity: function receiveRewards(bytes32[] keepIdentifiers) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) ReceiveRewards(opts *bind.TransactOpts, keepIdentifiers [][32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "receiveRewards", keepIdentifiers)
+}
+
+// ReceiveRewards is a paid mutator transaction binding the contract method 0x45016312.
+//
+// Solidity: function receiveRewards(bytes32[] keepIdentifiers) returns()
+func (_ECDSARewards *ECDSARewardsSession) ReceiveRewards(keepIdentifiers [][32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReceiveRewards(&_ECDSARewards.TransactOpts, keepIdentifiers)
+}
+
+// ReceiveRewards is a paid mutator transaction binding the contract method 0x45016312.
+//
+// Solidity: function receiveRewards(bytes32[] keepIdentifiers) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) ReceiveRewards(keepIdentifiers [][32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReceiveRewards(&_ECDSARewards.TransactOpts, keepIdentifiers)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ECDSARewards *ECDSARewardsTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ECDSARewards *ECDSARewardsSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ECDSARewards.Contract.RenounceOwnership(&_ECDSARewards.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ECDSARewards.Contract.RenounceOwnership(&_ECDSARewards.TransactOpts)
+}
+
+// ReportTermination is a paid mutator transaction binding the contract method 0x86d954c0.
+//
+// Solidity: function reportTermination(bytes32 keepIdentifier) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) ReportTermination(opts *bind.TransactOpts, keepIdentifier [32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "reportTermination", keepIdentifier)
+}
+
+// ReportTermination is a paid mutator transaction binding the contract method 0x86d954c0.
+//
+// Solidity: function reportTermination(bytes32 keepIdentifier) returns()
+func (_ECDSARewards *ECDSARewardsSession) ReportTermination(keepIdentifier [32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReportTermination(&_ECDSARewards.TransactOpts, keepIdentifier)
+}
+
+// ReportTermination is a paid mutator transaction binding the contract method 0x86d954c0.
+//
+// Solidity: function reportTermination(bytes32 keepIdentifier) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) ReportTermination(keepIdentifier [32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReportTermination(&_ECDSARewards.TransactOpts, keepIdentifier)
+}
+
+// ReportTerminations is a paid mutator transaction binding the contract method 0xab7239c0.
+//
+// Solidity: function reportTerminations(bytes32[] keepIdentifiers) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) ReportTerminations(opts *bind.TransactOpts, keepIdentifiers [][32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "reportTerminations", keepIdentifiers)
+}
+
+// ReportTerminations is a paid mutator transaction binding the contract method 0xab7239c0.
+//
+// Solidity: function reportTerminations(bytes32[] keepIdentifiers) returns()
+func (_ECDSARewards *ECDSARewardsSession) ReportTerminations(keepIdentifiers [][32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReportTerminations(&_ECDSARewards.TransactOpts, keepIdentifiers)
+}
+
+// ReportTerminations is a paid mutator transaction binding the contract method 0xab7239c0.
+//
+// Solidity: function reportTerminations(bytes32[] keepIdentifiers) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) ReportTerminations(keepIdentifiers [][32]byte) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.ReportTerminations(&_ECDSARewards.TransactOpts, keepIdentifiers)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ECDSARewards *ECDSARewardsSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.TransferOwnership(&_ECDSARewards.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.TransferOwnership(&_ECDSARewards.TransactOpts, newOwner)
+}
+
+// WithdrawRewards is a paid mutator transaction binding the contract method 0xf3301f6b.
+//
+// Solidity: function withdrawRewards(uint256 interval, address operator) returns()
+func (_ECDSARewards *ECDSARewardsTransactor) WithdrawRewards(opts *bind.TransactOpts, interval *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.contract.Transact(opts, "withdrawRewards", interval, operator)
+}
+
+// WithdrawRewards is a paid mutator transaction binding the contract method 0xf3301f6b.
+//
+// Solidity: function withdrawRewards(uint256 interval, address operator) returns()
+func (_ECDSARewards *ECDSARewardsSession) WithdrawRewards(interval *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.WithdrawRewards(&_ECDSARewards.TransactOpts, interval, operator)
+}
+
+// WithdrawRewards is a paid mutator transaction binding the contract method 0xf3301f6b.
+//
+// Solidity: function withdrawRewards(uint256 interval, address operator) returns()
+func (_ECDSARewards *ECDSARewardsTransactorSession) WithdrawRewards(interval *big.Int, operator common.Address) (*types.Transaction, error) {
+	return _ECDSARewards.Contract.WithdrawRewards(&_ECDSARewards.TransactOpts, interval, operator)
+}
+
+// ECDSARewardsOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ECDSARewards contract.
+type ECDSARewardsOwnershipTransferredIterator struct {
+	Event *ECDSARewardsOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ECDSARewardsOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ECDSARewardsOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ECDSARewardsOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ECDSARewardsOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ECDSARewardsOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ECDSARewardsOwnershipTransferred represents a OwnershipTransferred event raised by the ECDSARewards contract.
+type ECDSARewardsOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ECDSARewards *ECDSARewardsFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ECDSARewardsOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ECDSARewards.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsOwnershipTransferredIterator{contract: _ECDSARewards.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ECDSARewards *ECDSARewardsFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ECDSARewardsOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ECDSARewards.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ECDSARewardsOwnershipTransferred)
+				if err := _ECDSARewards.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ECDSARewards *ECDSARewardsFilterer) ParseOwnershipTransferred(log types.Log) (*ECDSARewardsOwnershipTransferred, error) {
+	event := new(ECDSARewardsOwnershipTransferred)
+	if err := _ECDSARewards.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ECDSARewardsRewardReceivedIterator is returned from FilterRewardReceived and is used to iterate over the raw logs and unpacked data for RewardReceived events raised by the ECDSARewards contract.
+type ECDSARewardsRewardReceivedIterator struct {
+	Event *ECDSARewardsRewardReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ECDSARewardsRewardReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ECDSARewardsRewardReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ECDSARewardsRewardReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ECDSARewardsRewardReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ECDSARewardsRewardReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ECDSARewardsRewardReceived represents a RewardReceived event raised by the ECDSARewards contract.
+type ECDSARewardsRewardReceived struct {
+	Keep   [32]byte
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardReceived is a free log retrieval operation binding the contract event 0xf8fcbb083cc485f0dc726d4235dbec7c9c5c03af58254f353627503577216170.
+//
+// Solidity: event RewardReceived(bytes32 keep, uint256 amount)
+func (_ECDSARewards *ECDSARewardsFilterer) FilterRewardReceived(opts *bind.FilterOpts) (*ECDSARewardsRewardReceivedIterator, error) {
+
+	logs, sub, err := _ECDSARewards.contract.FilterLogs(opts, "RewardReceived")
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsRewardReceivedIterator{contract: _ECDSARewards.contract, event: "RewardReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardReceived is a free log subscription operation binding the contract event 0xf8fcbb083cc485f0dc726d4235dbec7c9c5c03af58254f353627503577216170.
+//
+// Solidity: event RewardReceived(bytes32 keep, uint256 amount)
+func (_ECDSARewards *ECDSARewardsFilterer) WatchRewardReceived(opts *bind.WatchOpts, sink chan<- *ECDSARewardsRewardReceived) (event.Subscription, error) {
+
+	logs, sub, err := _ECDSARewards.contract.WatchLogs(opts, "RewardReceived")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ECDSARewardsRewardReceived)
+				if err := _ECDSARewards.contract.UnpackLog(event, "RewardReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardReceived is a log parse operation binding the contract event 0xf8fcbb083cc485f0dc726d4235dbec7c9c5c03af58254f353627503577216170.
+//
+// Solidity: event RewardReceived(bytes32 keep, uint256 amount)
+func (_ECDSARewards *ECDSARewardsFilterer) ParseRewardReceived(log types.Log) (*ECDSARewardsRewardReceived, error) {
+	event := new(ECDSARewardsRewardReceived)
+	if err := _ECDSARewards.contract.UnpackLog(event, "RewardReceived", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ECDSARewardsUpgradeFinalizedIterator is returned from FilterUpgradeFinalized and is used to iterate over the raw logs and unpacked data for UpgradeFinalized events raised by the ECDSARewards contract.
+type ECDSARewardsUpgradeFinalizedIterator struct {
+	Event *ECDSARewardsUpgradeFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ECDSARewardsUpgradeFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ECDSARewardsUpgradeFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ECDSARewardsUpgradeFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ECDSARewardsUpgradeFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ECDSARewardsUpgradeFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ECDSARewardsUpgradeFinalized represents a UpgradeFinalized event raised by the ECDSARewards contract.
+type ECDSARewardsUpgradeFinalized struct {
+	AmountTransferred *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgradeFinalized is a free log retrieval operation binding the contract event 0x89f5b857dbf5a32a4fa55162349dc1146850c9b76ff05bedf3a47b3f14095c0a.
+//
+// Solidity: event UpgradeFinalized(uint256 amountTransferred)
+func (_ECDSARewards *ECDSARewardsFilterer) FilterUpgradeFinalized(opts *bind.FilterOpts) (*ECDSARewardsUpgradeFinalizedIterator, error) {
+
+	logs, sub, err := _ECDSARewards.contract.FilterLogs(opts, "UpgradeFinalized")
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsUpgradeFinalizedIterator{contract: _ECDSARewards.contract, event: "UpgradeFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgradeFinalized is a free log subscription operation binding the contract event 0x89f5b857dbf5a32a4fa55162349dc1146850c9b76ff05bedf3a47b3f14095c0a.
+//
+// Solidity: event UpgradeFinalized(uint256 amountTransferred)
+func (_ECDSARewards *ECDSARewardsFilterer) WatchUpgradeFinalized(opts *bind.WatchOpts, sink chan<- *ECDSARewardsUpgradeFinalized) (event.Subscription, error) {
+
+	logs, sub, err := _ECDSARewards.contract.WatchLogs(opts, "UpgradeFinalized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ECDSARewardsUpgradeFinalized)
+				if err := _ECDSARewards.contract.UnpackLog(event, "UpgradeFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgradeFinalized is a log parse operation binding the contract event 0x89f5b857dbf5a32a4fa55162349dc1146850c9b76ff05bedf3a47b3f14095c0a.
+//
+// Solidity: event UpgradeFinalized(uint256 amountTransferred)
+func (_ECDSARewards *ECDSARewardsFilterer) ParseUpgradeFinalized(log types.Log) (*ECDSARewardsUpgradeFinalized, error) {
+	event := new(ECDSARewardsUpgradeFinalized)
+	if err := _ECDSARewards.contract.UnpackLog(event, "UpgradeFinalized", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ECDSARewardsUpgradeInitiatedIterator is returned from FilterUpgradeInitiated and is used to iterate over the raw logs and unpacked data for UpgradeInitiated events raised by the ECDSARewards contract.
+type ECDSARewardsUpgradeInitiatedIterator struct {
+	Event *ECDSARewardsUpgradeInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ECDSARewardsUpgradeInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ECDSARewardsUpgradeInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ECDSARewardsUpgradeInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ECDSARewardsUpgradeInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ECDSARewardsUpgradeInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ECDSARewardsUpgradeInitiated represents a UpgradeInitiated event raised by the ECDSARewards contract.
+type ECDSARewardsUpgradeInitiated struct {
+	NewRewardsContract common.Address
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgradeInitiated is a free log retrieval operation binding the contract event 0x4508cb0974b8dda93dec7c130aa186db2705fcee58bc00d1334726761835aca1.
+//
+// Solidity: event UpgradeInitiated(address newRewardsContract)
+func (_ECDSARewards *ECDSARewardsFilterer) FilterUpgradeInitiated(opts *bind.FilterOpts) (*ECDSARewardsUpgradeInitiatedIterator, error) {
+
+	logs, sub, err := _ECDSARewards.contract.FilterLogs(opts, "UpgradeInitiated")
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSARewardsUpgradeInitiatedIterator{contract: _ECDSARewards.contract, event: "UpgradeInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgradeInitiated is a free log subscription operation binding the contract event 0x4508cb0974b8dda93dec7c130aa186db2705fcee58bc00d1334726761835aca1.
+//
+// Solidity: event UpgradeInitiated(address newRewardsContract)
+func (_ECDSARewards *ECDSARewardsFilterer) WatchUpgradeInitiated(opts *bind.WatchOpts, sink chan<- *ECDSARewardsUpgradeInitiated) (event.Subscription, error) {
+
+	logs, sub, err := _ECDSARewards.contract.WatchLogs(opts, "UpgradeInitiated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ECDSARewardsUpgradeInitiated)
+				if err := _ECDSARewards.contract.UnpackLog(event, "UpgradeInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgradeInitiated is a log parse operation binding the contract event 0x4508cb0974b8dda93dec7c130aa186db2705fcee58bc00d1334726761835aca1.
+//
+// Solidity: event UpgradeInitiated(address newRewardsContract)
+func (_ECDSARewards *ECDSARewardsFilterer) ParseUpgradeInitiated(log types.Log) (*ECDSARewardsUpgradeInitiated, error) {
+	event := new(ECDSARewardsUpgradeInitiated)
+	if err := _ECDSARewards.contract.UnpackLog(event, "UpgradeInitiated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
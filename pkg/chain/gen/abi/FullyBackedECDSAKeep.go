@@ -0,0 +1,1953 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// FullyBackedECDSAKeepABI is the input ABI used to generate the binding from.
+const FullyBackedECDSAKeepABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"submittingMember\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"conflictingPublicKey\",\"type\":\"bytes\"}],\"name\":\"ConflictingPublicKeySubmitted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ERC20RewardDistributed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ETHRewardDistributed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"KeepClosed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"KeepTerminated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"publicKey\",\"type\":\"bytes\"}],\"name\":\"PublicKeyPublished\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"digest\",\"type\":\"bytes32\"}],\"name\":\"SignatureRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"digest\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"recoveryID\",\"type\":\"uint8\"}],\"name\":\"SignatureSubmitted\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[],\"name\":\"checkBondAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"_v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"_r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_s\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_signedDigest\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"_preimage\",\"type\":\"bytes\"}],\"name\":\"checkSignatureFraud\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"_isFraud\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"closeKeep\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"digest\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"digests\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"distributeERC20Reward\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"distributeETHReward\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_member\",\"type\":\"address\"}],\"name\":\"getMemberETHBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getMembers\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getOpenedTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getPublicKey\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"honestThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"_members\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"_honestThreshold\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_bonding\",\"type\":\"address\"},{\"internalType\":\"addresspayable\",\"name\":\"_keepFactory\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_digest\",\"type\":\"bytes32\"}],\"name\":\"isAwaitingSignature\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isClosed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isTerminated\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"members\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"publicKey\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"returnPartialSignerBonds\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"seizeSignerBonds\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_digest\",\"type\":\"bytes32\"}],\"name\":\"sign\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"}],\"name\":\"submitPublicKey\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_s\",\"type\":\"bytes32\"},{\"internalType\":\"uint8\",\"name\":\"_recoveryID\",\"type\":\"uint8\"}],\"name\":\"submitSignature\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"_v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"_r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_s\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_signedDigest\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"_preimage\",\"type\":\"bytes\"}],\"name\":\"submitSignatureFraud\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"_isFraud\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_member\",\"type\":\"address\"}],\"name\":\"withdraw\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// FullyBackedECDSAKeep is an auto generated Go binding around an Ethereum contract.
+type FullyBackedECDSAKeep struct {
+	FullyBackedECDSAKeepCaller     // Read-only binding to the contract
+	FullyBackedECDSAKeepTransactor // Write-only binding to the contract
+	FullyBackedECDSAKeepFilterer   // Log filterer for contract events
+}
+
+// FullyBackedECDSAKeepCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedECDSAKeepTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedECDSAKeepFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FullyBackedECDSAKeepFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedECDSAKeepSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FullyBackedECDSAKeepSession struct {
+	Contract     *FullyBackedECDSAKeep // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// FullyBackedECDSAKeepCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FullyBackedECDSAKeepCallerSession struct {
+	Contract *FullyBackedECDSAKeepCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// FullyBackedECDSAKeepTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FullyBackedECDSAKeepTransactorSession struct {
+	Contract     *FullyBackedECDSAKeepTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// FullyBackedECDSAKeepRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepRaw struct {
+	Contract *FullyBackedECDSAKeep // Generic contract binding to access the raw methods on
+}
+
+// FullyBackedECDSAKeepCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepCallerRaw struct {
+	Contract *FullyBackedECDSAKeepCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FullyBackedECDSAKeepTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepTransactorRaw struct {
+	Contract *FullyBackedECDSAKeepTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFullyBackedECDSAKeep creates a new instance of FullyBackedECDSAKeep, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeep(address common.Address, backend bind.ContractBackend) (*FullyBackedECDSAKeep, error) {
+	contract, err := bindFullyBackedECDSAKeep(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeep{FullyBackedECDSAKeepCaller: FullyBackedECDSAKeepCaller{contract: contract}, FullyBackedECDSAKeepTransactor: FullyBackedECDSAKeepTransactor{contract: contract}, FullyBackedECDSAKeepFilterer: FullyBackedECDSAKeepFilterer{contract: contract}}, nil
+}
+
+// NewFullyBackedECDSAKeepCaller creates a new read-only instance of FullyBackedECDSAKeep, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepCaller(address common.Address, caller bind.ContractCaller) (*FullyBackedECDSAKeepCaller, error) {
+	contract, err := bindFullyBackedECDSAKeep(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepCaller{contract: contract}, nil
+}
+
+// NewFullyBackedECDSAKeepTransactor creates a new write-only instance of FullyBackedECDSAKeep, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepTransactor(address common.Address, transactor bind.ContractTransactor) (*FullyBackedECDSAKeepTransactor, error) {
+	contract, err := bindFullyBackedECDSAKeep(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepTransactor{contract: contract}, nil
+}
+
+// NewFullyBackedECDSAKeepFilterer creates a new log filterer instance of FullyBackedECDSAKeep, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepFilterer(address common.Address, filterer bind.ContractFilterer) (*FullyBackedECDSAKeepFilterer, error) {
+	contract, err := bindFullyBackedECDSAKeep(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFilterer{contract: contract}, nil
+}
+
+// bindFullyBackedECDSAKeep binds a generic wrapper to an already deployed contract.
+func bindFullyBackedECDSAKeep(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(FullyBackedECDSAKeepABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _FullyBackedECDSAKeep.Contract.FullyBackedECDSAKeepCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.FullyBackedECDSAKeepTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.FullyBackedECDSAKeepTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _FullyBackedECDSAKeep.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.contract.Transact(opts, method, params...)
+}
+
+// CheckBondAmount is a free data retrieval call binding the contract method 0xdc3d6da8.
+//
+// Solidity: function checkBondAmount() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) CheckBondAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "checkBondAmount")
+	return *ret0, err
+}
+
+// CheckBondAmount is a free data retrieval call binding the contract method 0xdc3d6da8.
+//
+// Solidity: function checkBondAmount() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) CheckBondAmount() (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.CheckBondAmount(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// CheckBondAmount is a free data retrieval call binding the contract method 0xdc3d6da8.
+//
+// Solidity: function checkBondAmount() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) CheckBondAmount() (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.CheckBondAmount(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// CheckSignatureFraud is a free data retrieval call binding the contract method 0xbf9c8301.
+//
+// Solidity: function checkSignatureFraud(uint8 _v, bytes32 _r, bytes32 _s, bytes32 _signedDigest, bytes _preimage) constant returns(bool _isFraud)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) CheckSignatureFraud(opts *bind.CallOpts, _v uint8, _r [32]byte, _s [32]byte, _signedDigest [32]byte, _preimage []byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "checkSignatureFraud", _v, _r, _s, _signedDigest, _preimage)
+	return *ret0, err
+}
+
+// CheckSignatureFraud is a free data retrieval call binding the contract method 0xbf9c8301.
+//
+// Solidity: function checkSignatureFraud(uint8 _v, bytes32 _r, bytes32 _s, bytes32 _signedDigest, bytes _preimage) constant returns(bool _isFraud)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) CheckSignatureFraud(_v uint8, _r [32]byte, _s [32]byte, _signedDigest [32]byte, _preimage []byte) (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.CheckSignatureFraud(&_FullyBackedECDSAKeep.CallOpts, _v, _r, _s, _signedDigest, _preimage)
+}
+
+// CheckSignatureFraud is a free data retrieval call binding the contract method 0xbf9c8301.
+//
+// Solidity: function checkSignatureFraud(uint8 _v, bytes32 _r, bytes32 _s, bytes32 _signedDigest, bytes _preimage) constant returns(bool _isFraud)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) CheckSignatureFraud(_v uint8, _r [32]byte, _s [32]byte, _signedDigest [32]byte, _preimage []byte) (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.CheckSignatureFraud(&_FullyBackedECDSAKeep.CallOpts, _v, _r, _s, _signedDigest, _preimage)
+}
+
+// Digest is a free data retrieval call binding the contract method 0x52a82b65.
+//
+// Solidity: function digest() constant returns(bytes32)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) Digest(opts *bind.CallOpts) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "digest")
+	return *ret0, err
+}
+
+// Digest is a free data retrieval call binding the contract method 0x52a82b65.
+//
+// Solidity: function digest() constant returns(bytes32)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Digest() ([32]byte, error) {
+	return _FullyBackedECDSAKeep.Contract.Digest(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// Digest is a free data retrieval call binding the contract method 0x52a82b65.
+//
+// Solidity: function digest() constant returns(bytes32)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) Digest() ([32]byte, error) {
+	return _FullyBackedECDSAKeep.Contract.Digest(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// Digests is a free data retrieval call binding the contract method 0x01ac4293.
+//
+// Solidity: function digests(bytes32 ) constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) Digests(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "digests", arg0)
+	return *ret0, err
+}
+
+// Digests is a free data retrieval call binding the contract method 0x01ac4293.
+//
+// Solidity: function digests(bytes32 ) constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Digests(arg0 [32]byte) (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.Digests(&_FullyBackedECDSAKeep.CallOpts, arg0)
+}
+
+// Digests is a free data retrieval call binding the contract method 0x01ac4293.
+//
+// Solidity: function digests(bytes32 ) constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) Digests(arg0 [32]byte) (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.Digests(&_FullyBackedECDSAKeep.CallOpts, arg0)
+}
+
+// GetMemberETHBalance is a free data retrieval call binding the contract method 0xd5cc8b0f.
+//
+// Solidity: function getMemberETHBalance(address _member) constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) GetMemberETHBalance(opts *bind.CallOpts, _member common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "getMemberETHBalance", _member)
+	return *ret0, err
+}
+
+// GetMemberETHBalance is a free data retrieval call binding the contract method 0xd5cc8b0f.
+//
+// Solidity: function getMemberETHBalance(address _member) constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) GetMemberETHBalance(_member common.Address) (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.GetMemberETHBalance(&_FullyBackedECDSAKeep.CallOpts, _member)
+}
+
+// GetMemberETHBalance is a free data retrieval call binding the contract method 0xd5cc8b0f.
+//
+// Solidity: function getMemberETHBalance(address _member) constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) GetMemberETHBalance(_member common.Address) (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.GetMemberETHBalance(&_FullyBackedECDSAKeep.CallOpts, _member)
+}
+
+// GetMembers is a free data retrieval call binding the contract method 0x9eab5253.
+//
+// Solidity: function getMembers() constant returns(address[])
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) GetMembers(opts *bind.CallOpts) ([]common.Address, error) {
+	var (
+		ret0 = new([]common.Address)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "getMembers")
+	return *ret0, err
+}
+
+// GetMembers is a free data retrieval call binding the contract method 0x9eab5253.
+//
+// Solidity: function getMembers() constant returns(address[])
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) GetMembers() ([]common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.GetMembers(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetMembers is a free data retrieval call binding the contract method 0x9eab5253.
+//
+// Solidity: function getMembers() constant returns(address[])
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) GetMembers() ([]common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.GetMembers(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetOpenedTimestamp is a free data retrieval call binding the contract method 0xf4c2b4c1.
+//
+// Solidity: function getOpenedTimestamp() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) GetOpenedTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "getOpenedTimestamp")
+	return *ret0, err
+}
+
+// GetOpenedTimestamp is a free data retrieval call binding the contract method 0xf4c2b4c1.
+//
+// Solidity: function getOpenedTimestamp() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) GetOpenedTimestamp() (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.GetOpenedTimestamp(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetOpenedTimestamp is a free data retrieval call binding the contract method 0xf4c2b4c1.
+//
+// Solidity: function getOpenedTimestamp() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) GetOpenedTimestamp() (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.GetOpenedTimestamp(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
+//
+// Solidity: function getOwner() constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) GetOwner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "getOwner")
+	return *ret0, err
+}
+
+// GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
+//
+// Solidity: function getOwner() constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) GetOwner() (common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.GetOwner(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
+//
+// Solidity: function getOwner() constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) GetOwner() (common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.GetOwner(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetPublicKey is a free data retrieval call binding the contract method 0x2e334452.
+//
+// Solidity: function getPublicKey() constant returns(bytes)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) GetPublicKey(opts *bind.CallOpts) ([]byte, error) {
+	var (
+		ret0 = new([]byte)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "getPublicKey")
+	return *ret0, err
+}
+
+// GetPublicKey is a free data retrieval call binding the contract method 0x2e334452.
+//
+// Solidity: function getPublicKey() constant returns(bytes)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) GetPublicKey() ([]byte, error) {
+	return _FullyBackedECDSAKeep.Contract.GetPublicKey(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// GetPublicKey is a free data retrieval call binding the contract method 0x2e334452.
+//
+// Solidity: function getPublicKey() constant returns(bytes)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) GetPublicKey() ([]byte, error) {
+	return _FullyBackedECDSAKeep.Contract.GetPublicKey(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// HonestThreshold is a free data retrieval call binding the contract method 0x6806db1f.
+//
+// Solidity: function honestThreshold() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) HonestThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "honestThreshold")
+	return *ret0, err
+}
+
+// HonestThreshold is a free data retrieval call binding the contract method 0x6806db1f.
+//
+// Solidity: function honestThreshold() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) HonestThreshold() (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.HonestThreshold(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// HonestThreshold is a free data retrieval call binding the contract method 0x6806db1f.
+//
+// Solidity: function honestThreshold() constant returns(uint256)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) HonestThreshold() (*big.Int, error) {
+	return _FullyBackedECDSAKeep.Contract.HonestThreshold(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x22f3e2d4.
+//
+// Solidity: function isActive() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) IsActive(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "isActive")
+	return *ret0, err
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x22f3e2d4.
+//
+// Solidity: function isActive() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) IsActive() (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsActive(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x22f3e2d4.
+//
+// Solidity: function isActive() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) IsActive() (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsActive(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// IsAwaitingSignature is a free data retrieval call binding the contract method 0xcb7cf187.
+//
+// Solidity: function isAwaitingSignature(bytes32 _digest) constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) IsAwaitingSignature(opts *bind.CallOpts, _digest [32]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "isAwaitingSignature", _digest)
+	return *ret0, err
+}
+
+// IsAwaitingSignature is a free data retrieval call binding the contract method 0xcb7cf187.
+//
+// Solidity: function isAwaitingSignature(bytes32 _digest) constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) IsAwaitingSignature(_digest [32]byte) (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsAwaitingSignature(&_FullyBackedECDSAKeep.CallOpts, _digest)
+}
+
+// IsAwaitingSignature is a free data retrieval call binding the contract method 0xcb7cf187.
+//
+// Solidity: function isAwaitingSignature(bytes32 _digest) constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) IsAwaitingSignature(_digest [32]byte) (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsAwaitingSignature(&_FullyBackedECDSAKeep.CallOpts, _digest)
+}
+
+// IsClosed is a free data retrieval call binding the contract method 0xc2b6b58c.
+//
+// Solidity: function isClosed() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) IsClosed(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "isClosed")
+	return *ret0, err
+}
+
+// IsClosed is a free data retrieval call binding the contract method 0xc2b6b58c.
+//
+// Solidity: function isClosed() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) IsClosed() (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsClosed(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// IsClosed is a free data retrieval call binding the contract method 0xc2b6b58c.
+//
+// Solidity: function isClosed() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) IsClosed() (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsClosed(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// IsTerminated is a free data retrieval call binding the contract method 0xd1cc9976.
+//
+// Solidity: function isTerminated() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) IsTerminated(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "isTerminated")
+	return *ret0, err
+}
+
+// IsTerminated is a free data retrieval call binding the contract method 0xd1cc9976.
+//
+// Solidity: function isTerminated() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) IsTerminated() (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsTerminated(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// IsTerminated is a free data retrieval call binding the contract method 0xd1cc9976.
+//
+// Solidity: function isTerminated() constant returns(bool)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) IsTerminated() (bool, error) {
+	return _FullyBackedECDSAKeep.Contract.IsTerminated(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// Members is a free data retrieval call binding the contract method 0x5daf08ca.
+//
+// Solidity: function members(uint256 ) constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) Members(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "members", arg0)
+	return *ret0, err
+}
+
+// Members is a free data retrieval call binding the contract method 0x5daf08ca.
+//
+// Solidity: function members(uint256 ) constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Members(arg0 *big.Int) (common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.Members(&_FullyBackedECDSAKeep.CallOpts, arg0)
+}
+
+// Members is a free data retrieval call binding the contract method 0x5daf08ca.
+//
+// Solidity: function members(uint256 ) constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) Members(arg0 *big.Int) (common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.Members(&_FullyBackedECDSAKeep.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "owner")
+	return *ret0, err
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Owner() (common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.Owner(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() constant returns(address)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) Owner() (common.Address, error) {
+	return _FullyBackedECDSAKeep.Contract.Owner(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// PublicKey is a free data retrieval call binding the contract method 0x63ffab31.
+//
+// Solidity: function publicKey() constant returns(bytes)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCaller) PublicKey(opts *bind.CallOpts) ([]byte, error) {
+	var (
+		ret0 = new([]byte)
+	)
+	out := ret0
+	err := _FullyBackedECDSAKeep.contract.Call(opts, out, "publicKey")
+	return *ret0, err
+}
+
+// PublicKey is a free data retrieval call binding the contract method 0x63ffab31.
+//
+// Solidity: function publicKey() constant returns(bytes)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) PublicKey() ([]byte, error) {
+	return _FullyBackedECDSAKeep.Contract.PublicKey(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// PublicKey is a free data retrieval call binding the contract method 0x63ffab31.
+//
+// Solidity: function publicKey() constant returns(bytes)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepCallerSession) PublicKey() ([]byte, error) {
+	return _FullyBackedECDSAKeep.Contract.PublicKey(&_FullyBackedECDSAKeep.CallOpts)
+}
+
+// CloseKeep is a paid mutator transaction binding the contract method 0xa15c3bbb.
+//
+// Solidity: function closeKeep() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) CloseKeep(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "closeKeep")
+}
+
+// CloseKeep is a paid mutator transaction binding the contract method 0xa15c3bbb.
+//
+// Solidity: function closeKeep() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) CloseKeep() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.CloseKeep(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// CloseKeep is a paid mutator transaction binding the contract method 0xa15c3bbb.
+//
+// Solidity: function closeKeep() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) CloseKeep() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.CloseKeep(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// DistributeERC20Reward is a paid mutator transaction binding the contract method 0x5a89f810.
+//
+// Solidity: function distributeERC20Reward(address _tokenAddress, uint256 _value) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) DistributeERC20Reward(opts *bind.TransactOpts, _tokenAddress common.Address, _value *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "distributeERC20Reward", _tokenAddress, _value)
+}
+
+// DistributeERC20Reward is a paid mutator transaction binding the contract method 0x5a89f810.
+//
+// Solidity: function distributeERC20Reward(address _tokenAddress, uint256 _value) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) DistributeERC20Reward(_tokenAddress common.Address, _value *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.DistributeERC20Reward(&_FullyBackedECDSAKeep.TransactOpts, _tokenAddress, _value)
+}
+
+// DistributeERC20Reward is a paid mutator transaction binding the contract method 0x5a89f810.
+//
+// Solidity: function distributeERC20Reward(address _tokenAddress, uint256 _value) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) DistributeERC20Reward(_tokenAddress common.Address, _value *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.DistributeERC20Reward(&_FullyBackedECDSAKeep.TransactOpts, _tokenAddress, _value)
+}
+
+// DistributeETHReward is a paid mutator transaction binding the contract method 0x2930e170.
+//
+// Solidity: function distributeETHReward() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) DistributeETHReward(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "distributeETHReward")
+}
+
+// DistributeETHReward is a paid mutator transaction binding the contract method 0x2930e170.
+//
+// Solidity: function distributeETHReward() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) DistributeETHReward() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.DistributeETHReward(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// DistributeETHReward is a paid mutator transaction binding the contract method 0x2930e170.
+//
+// Solidity: function distributeETHReward() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) DistributeETHReward() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.DistributeETHReward(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x3f69031b.
+//
+// Solidity: function initialize(address _owner, address[] _members, uint256 _honestThreshold, address _bonding, address _keepFactory) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) Initialize(opts *bind.TransactOpts, _owner common.Address, _members []common.Address, _honestThreshold *big.Int, _bonding common.Address, _keepFactory common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "initialize", _owner, _members, _honestThreshold, _bonding, _keepFactory)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x3f69031b.
+//
+// Solidity: function initialize(address _owner, address[] _members, uint256 _honestThreshold, address _bonding, address _keepFactory) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Initialize(_owner common.Address, _members []common.Address, _honestThreshold *big.Int, _bonding common.Address, _keepFactory common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.Initialize(&_FullyBackedECDSAKeep.TransactOpts, _owner, _members, _honestThreshold, _bonding, _keepFactory)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x3f69031b.
+//
+// Solidity: function initialize(address _owner, address[] _members, uint256 _honestThreshold, address _bonding, address _keepFactory) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) Initialize(_owner common.Address, _members []common.Address, _honestThreshold *big.Int, _bonding common.Address, _keepFactory common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.Initialize(&_FullyBackedECDSAKeep.TransactOpts, _owner, _members, _honestThreshold, _bonding, _keepFactory)
+}
+
+// ReturnPartialSignerBonds is a paid mutator transaction binding the contract method 0x6ed15f94.
+//
+// Solidity: function returnPartialSignerBonds() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) ReturnPartialSignerBonds(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "returnPartialSignerBonds")
+}
+
+// ReturnPartialSignerBonds is a paid mutator transaction binding the contract method 0x6ed15f94.
+//
+// Solidity: function returnPartialSignerBonds() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) ReturnPartialSignerBonds() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.ReturnPartialSignerBonds(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// ReturnPartialSignerBonds is a paid mutator transaction binding the contract method 0x6ed15f94.
+//
+// Solidity: function returnPartialSignerBonds() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) ReturnPartialSignerBonds() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.ReturnPartialSignerBonds(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// SeizeSignerBonds is a paid mutator transaction binding the contract method 0x07acd5cb.
+//
+// Solidity: function seizeSignerBonds() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) SeizeSignerBonds(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "seizeSignerBonds")
+}
+
+// SeizeSignerBonds is a paid mutator transaction binding the contract method 0x07acd5cb.
+//
+// Solidity: function seizeSignerBonds() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) SeizeSignerBonds() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SeizeSignerBonds(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// SeizeSignerBonds is a paid mutator transaction binding the contract method 0x07acd5cb.
+//
+// Solidity: function seizeSignerBonds() returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) SeizeSignerBonds() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SeizeSignerBonds(&_FullyBackedECDSAKeep.TransactOpts)
+}
+
+// Sign is a paid mutator transaction binding the contract method 0x799cd333.
+//
+// Solidity: function sign(bytes32 _digest) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) Sign(opts *bind.TransactOpts, _digest [32]byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "sign", _digest)
+}
+
+// Sign is a paid mutator transaction binding the contract method 0x799cd333.
+//
+// Solidity: function sign(bytes32 _digest) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Sign(_digest [32]byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.Sign(&_FullyBackedECDSAKeep.TransactOpts, _digest)
+}
+
+// Sign is a paid mutator transaction binding the contract method 0x799cd333.
+//
+// Solidity: function sign(bytes32 _digest) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) Sign(_digest [32]byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.Sign(&_FullyBackedECDSAKeep.TransactOpts, _digest)
+}
+
+// SubmitPublicKey is a paid mutator transaction binding the contract method 0xabd14f37.
+//
+// Solidity: function submitPublicKey(bytes _publicKey) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) SubmitPublicKey(opts *bind.TransactOpts, _publicKey []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "submitPublicKey", _publicKey)
+}
+
+// SubmitPublicKey is a paid mutator transaction binding the contract method 0xabd14f37.
+//
+// Solidity: function submitPublicKey(bytes _publicKey) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) SubmitPublicKey(_publicKey []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SubmitPublicKey(&_FullyBackedECDSAKeep.TransactOpts, _publicKey)
+}
+
+// SubmitPublicKey is a paid mutator transaction binding the contract method 0xabd14f37.
+//
+// Solidity: function submitPublicKey(bytes _publicKey) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) SubmitPublicKey(_publicKey []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SubmitPublicKey(&_FullyBackedECDSAKeep.TransactOpts, _publicKey)
+}
+
+// SubmitSignature is a paid mutator transaction binding the contract method 0x7df2b357.
+//
+// Solidity: function submitSignature(bytes32 _r, bytes32 _s, uint8 _recoveryID) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) SubmitSignature(opts *bind.TransactOpts, _r [32]byte, _s [32]byte, _recoveryID uint8) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "submitSignature", _r, _s, _recoveryID)
+}
+
+// SubmitSignature is a paid mutator transaction binding the contract method 0x7df2b357.
+//
+// Solidity: function submitSignature(bytes32 _r, bytes32 _s, uint8 _recoveryID) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) SubmitSignature(_r [32]byte, _s [32]byte, _recoveryID uint8) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SubmitSignature(&_FullyBackedECDSAKeep.TransactOpts, _r, _s, _recoveryID)
+}
+
+// SubmitSignature is a paid mutator transaction binding the contract method 0x7df2b357.
+//
+// Solidity: function submitSignature(bytes32 _r, bytes32 _s, uint8 _recoveryID) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) SubmitSignature(_r [32]byte, _s [32]byte, _recoveryID uint8) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SubmitSignature(&_FullyBackedECDSAKeep.TransactOpts, _r, _s, _recoveryID)
+}
+
+// SubmitSignatureFraud is a paid mutator transaction binding the contract method 0xf15d1a90.
+//
+// Solidity: function submitSignatureFraud(uint8 _v, bytes32 _r, bytes32 _s, bytes32 _signedDigest, bytes _preimage) returns(bool _isFraud)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) SubmitSignatureFraud(opts *bind.TransactOpts, _v uint8, _r [32]byte, _s [32]byte, _signedDigest [32]byte, _preimage []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "submitSignatureFraud", _v, _r, _s, _signedDigest, _preimage)
+}
+
+// SubmitSignatureFraud is a paid mutator transaction binding the contract method 0xf15d1a90.
+//
+// Solidity: function submitSignatureFraud(uint8 _v, bytes32 _r, bytes32 _s, bytes32 _signedDigest, bytes _preimage) returns(bool _isFraud)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) SubmitSignatureFraud(_v uint8, _r [32]byte, _s [32]byte, _signedDigest [32]byte, _preimage []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SubmitSignatureFraud(&_FullyBackedECDSAKeep.TransactOpts, _v, _r, _s, _signedDigest, _preimage)
+}
+
+// SubmitSignatureFraud is a paid mutator transaction binding the contract method 0xf15d1a90.
+//
+// Solidity: function submitSignatureFraud(uint8 _v, bytes32 _r, bytes32 _s, bytes32 _signedDigest, bytes _preimage) returns(bool _isFraud)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) SubmitSignatureFraud(_v uint8, _r [32]byte, _s [32]byte, _signedDigest [32]byte, _preimage []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.SubmitSignatureFraud(&_FullyBackedECDSAKeep.TransactOpts, _v, _r, _s, _signedDigest, _preimage)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x51cff8d9.
+//
+// Solidity: function withdraw(address _member) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactor) Withdraw(opts *bind.TransactOpts, _member common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.contract.Transact(opts, "withdraw", _member)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x51cff8d9.
+//
+// Solidity: function withdraw(address _member) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepSession) Withdraw(_member common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.Withdraw(&_FullyBackedECDSAKeep.TransactOpts, _member)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x51cff8d9.
+//
+// Solidity: function withdraw(address _member) returns()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepTransactorSession) Withdraw(_member common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeep.Contract.Withdraw(&_FullyBackedECDSAKeep.TransactOpts, _member)
+}
+
+// FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator is returned from FilterConflictingPublicKeySubmitted and is used to iterate over the raw logs and unpacked data for ConflictingPublicKeySubmitted events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator struct {
+	Event *FullyBackedECDSAKeepConflictingPublicKeySubmitted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepConflictingPublicKeySubmitted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepConflictingPublicKeySubmitted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepConflictingPublicKeySubmitted represents a ConflictingPublicKeySubmitted event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepConflictingPublicKeySubmitted struct {
+	SubmittingMember     common.Address
+	ConflictingPublicKey []byte
+	Raw                  types.Log // Blockchain specific contextual infos
+}
+
+// FilterConflictingPublicKeySubmitted is a free log retrieval operation binding the contract event 0x99d98e35ad6445ac964c46a75c7f748e8f390ebdca5a924cd8f92d674fa34ff7.
+//
+// Solidity: event ConflictingPublicKeySubmitted(address indexed submittingMember, bytes conflictingPublicKey)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterConflictingPublicKeySubmitted(opts *bind.FilterOpts, submittingMember []common.Address) (*FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator, error) {
+
+	var submittingMemberRule []interface{}
+	for _, submittingMemberItem := range submittingMember {
+		submittingMemberRule = append(submittingMemberRule, submittingMemberItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "ConflictingPublicKeySubmitted", submittingMemberRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepConflictingPublicKeySubmittedIterator{contract: _FullyBackedECDSAKeep.contract, event: "ConflictingPublicKeySubmitted", logs: logs, sub: sub}, nil
+}
+
+// WatchConflictingPublicKeySubmitted is a free log subscription operation binding the contract event 0x99d98e35ad6445ac964c46a75c7f748e8f390ebdca5a924cd8f92d674fa34ff7.
+//
+// Solidity: event ConflictingPublicKeySubmitted(address indexed submittingMember, bytes conflictingPublicKey)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchConflictingPublicKeySubmitted(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepConflictingPublicKeySubmitted, submittingMember []common.Address) (event.Subscription, error) {
+
+	var submittingMemberRule []interface{}
+	for _, submittingMemberItem := range submittingMember {
+		submittingMemberRule = append(submittingMemberRule, submittingMemberItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "ConflictingPublicKeySubmitted", submittingMemberRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepConflictingPublicKeySubmitted)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "ConflictingPublicKeySubmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConflictingPublicKeySubmitted is a log parse operation binding the contract event 0x99d98e35ad6445ac964c46a75c7f748e8f390ebdca5a924cd8f92d674fa34ff7.
+//
+// Solidity: event ConflictingPublicKeySubmitted(address indexed submittingMember, bytes conflictingPublicKey)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseConflictingPublicKeySubmitted(log types.Log) (*FullyBackedECDSAKeepConflictingPublicKeySubmitted, error) {
+	event := new(FullyBackedECDSAKeepConflictingPublicKeySubmitted)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "ConflictingPublicKeySubmitted", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepERC20RewardDistributedIterator is returned from FilterERC20RewardDistributed and is used to iterate over the raw logs and unpacked data for ERC20RewardDistributed events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepERC20RewardDistributedIterator struct {
+	Event *FullyBackedECDSAKeepERC20RewardDistributed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepERC20RewardDistributedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepERC20RewardDistributed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepERC20RewardDistributed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepERC20RewardDistributedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepERC20RewardDistributedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepERC20RewardDistributed represents a ERC20RewardDistributed event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepERC20RewardDistributed struct {
+	Token  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterERC20RewardDistributed is a free log retrieval operation binding the contract event 0xb69f5873bb2e9e1cc495d5c23d2995010c3b5cdd1756e3cada2bc3f2150902cc.
+//
+// Solidity: event ERC20RewardDistributed(address indexed token, uint256 amount)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterERC20RewardDistributed(opts *bind.FilterOpts, token []common.Address) (*FullyBackedECDSAKeepERC20RewardDistributedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "ERC20RewardDistributed", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepERC20RewardDistributedIterator{contract: _FullyBackedECDSAKeep.contract, event: "ERC20RewardDistributed", logs: logs, sub: sub}, nil
+}
+
+// WatchERC20RewardDistributed is a free log subscription operation binding the contract event 0xb69f5873bb2e9e1cc495d5c23d2995010c3b5cdd1756e3cada2bc3f2150902cc.
+//
+// Solidity: event ERC20RewardDistributed(address indexed token, uint256 amount)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchERC20RewardDistributed(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepERC20RewardDistributed, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "ERC20RewardDistributed", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepERC20RewardDistributed)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "ERC20RewardDistributed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseERC20RewardDistributed is a log parse operation binding the contract event 0xb69f5873bb2e9e1cc495d5c23d2995010c3b5cdd1756e3cada2bc3f2150902cc.
+//
+// Solidity: event ERC20RewardDistributed(address indexed token, uint256 amount)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseERC20RewardDistributed(log types.Log) (*FullyBackedECDSAKeepERC20RewardDistributed, error) {
+	event := new(FullyBackedECDSAKeepERC20RewardDistributed)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "ERC20RewardDistributed", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepETHRewardDistributedIterator is returned from FilterETHRewardDistributed and is used to iterate over the raw logs and unpacked data for ETHRewardDistributed events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepETHRewardDistributedIterator struct {
+	Event *FullyBackedECDSAKeepETHRewardDistributed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepETHRewardDistributedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepETHRewardDistributed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepETHRewardDistributed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepETHRewardDistributedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepETHRewardDistributedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepETHRewardDistributed represents a ETHRewardDistributed event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepETHRewardDistributed struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterETHRewardDistributed is a free log retrieval operation binding the contract event 0xa9e4160b29b5c7db7fa61c512c4b45e7c3451c3331537f065a3417778cea5096.
+//
+// Solidity: event ETHRewardDistributed(uint256 amount)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterETHRewardDistributed(opts *bind.FilterOpts) (*FullyBackedECDSAKeepETHRewardDistributedIterator, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "ETHRewardDistributed")
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepETHRewardDistributedIterator{contract: _FullyBackedECDSAKeep.contract, event: "ETHRewardDistributed", logs: logs, sub: sub}, nil
+}
+
+// WatchETHRewardDistributed is a free log subscription operation binding the contract event 0xa9e4160b29b5c7db7fa61c512c4b45e7c3451c3331537f065a3417778cea5096.
+//
+// Solidity: event ETHRewardDistributed(uint256 amount)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchETHRewardDistributed(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepETHRewardDistributed) (event.Subscription, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "ETHRewardDistributed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepETHRewardDistributed)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "ETHRewardDistributed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseETHRewardDistributed is a log parse operation binding the contract event 0xa9e4160b29b5c7db7fa61c512c4b45e7c3451c3331537f065a3417778cea5096.
+//
+// Solidity: event ETHRewardDistributed(uint256 amount)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseETHRewardDistributed(log types.Log) (*FullyBackedECDSAKeepETHRewardDistributed, error) {
+	event := new(FullyBackedECDSAKeepETHRewardDistributed)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "ETHRewardDistributed", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepKeepClosedIterator is returned from FilterKeepClosed and is used to iterate over the raw logs and unpacked data for KeepClosed events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepKeepClosedIterator struct {
+	Event *FullyBackedECDSAKeepKeepClosed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepKeepClosedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepKeepClosed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepKeepClosed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepKeepClosedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepKeepClosedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepKeepClosed represents a KeepClosed event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepKeepClosed struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterKeepClosed is a free log retrieval operation binding the contract event 0x400fd7ee62b209afddce9dfbca204b2124c135597dff0ac92e9844e2b08927f6.
+//
+// Solidity: event KeepClosed()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterKeepClosed(opts *bind.FilterOpts) (*FullyBackedECDSAKeepKeepClosedIterator, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "KeepClosed")
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepKeepClosedIterator{contract: _FullyBackedECDSAKeep.contract, event: "KeepClosed", logs: logs, sub: sub}, nil
+}
+
+// WatchKeepClosed is a free log subscription operation binding the contract event 0x400fd7ee62b209afddce9dfbca204b2124c135597dff0ac92e9844e2b08927f6.
+//
+// Solidity: event KeepClosed()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchKeepClosed(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepKeepClosed) (event.Subscription, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "KeepClosed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepKeepClosed)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "KeepClosed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseKeepClosed is a log parse operation binding the contract event 0x400fd7ee62b209afddce9dfbca204b2124c135597dff0ac92e9844e2b08927f6.
+//
+// Solidity: event KeepClosed()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseKeepClosed(log types.Log) (*FullyBackedECDSAKeepKeepClosed, error) {
+	event := new(FullyBackedECDSAKeepKeepClosed)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "KeepClosed", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepKeepTerminatedIterator is returned from FilterKeepTerminated and is used to iterate over the raw logs and unpacked data for KeepTerminated events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepKeepTerminatedIterator struct {
+	Event *FullyBackedECDSAKeepKeepTerminated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepKeepTerminatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepKeepTerminated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepKeepTerminated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepKeepTerminatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepKeepTerminatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepKeepTerminated represents a KeepTerminated event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepKeepTerminated struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterKeepTerminated is a free log retrieval operation binding the contract event 0x39f530c1293a870138e53618b826819a76f1fe86b5d500ba4622f9e8354a846a.
+//
+// Solidity: event KeepTerminated()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterKeepTerminated(opts *bind.FilterOpts) (*FullyBackedECDSAKeepKeepTerminatedIterator, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "KeepTerminated")
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepKeepTerminatedIterator{contract: _FullyBackedECDSAKeep.contract, event: "KeepTerminated", logs: logs, sub: sub}, nil
+}
+
+// WatchKeepTerminated is a free log subscription operation binding the contract event 0x39f530c1293a870138e53618b826819a76f1fe86b5d500ba4622f9e8354a846a.
+//
+// Solidity: event KeepTerminated()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchKeepTerminated(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepKeepTerminated) (event.Subscription, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "KeepTerminated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepKeepTerminated)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "KeepTerminated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseKeepTerminated is a log parse operation binding the contract event 0x39f530c1293a870138e53618b826819a76f1fe86b5d500ba4622f9e8354a846a.
+//
+// Solidity: event KeepTerminated()
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseKeepTerminated(log types.Log) (*FullyBackedECDSAKeepKeepTerminated, error) {
+	event := new(FullyBackedECDSAKeepKeepTerminated)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "KeepTerminated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepPublicKeyPublishedIterator is returned from FilterPublicKeyPublished and is used to iterate over the raw logs and unpacked data for PublicKeyPublished events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepPublicKeyPublishedIterator struct {
+	Event *FullyBackedECDSAKeepPublicKeyPublished // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepPublicKeyPublishedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepPublicKeyPublished)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepPublicKeyPublished)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepPublicKeyPublishedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepPublicKeyPublishedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepPublicKeyPublished represents a PublicKeyPublished event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepPublicKeyPublished struct {
+	PublicKey []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPublicKeyPublished is a free log retrieval operation binding the contract event 0xf62bba8b270bef3e8d0fcebc1f86567664da8ccbd03e8509d6231cc8d63f4b31.
+//
+// Solidity: event PublicKeyPublished(bytes publicKey)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterPublicKeyPublished(opts *bind.FilterOpts) (*FullyBackedECDSAKeepPublicKeyPublishedIterator, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "PublicKeyPublished")
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepPublicKeyPublishedIterator{contract: _FullyBackedECDSAKeep.contract, event: "PublicKeyPublished", logs: logs, sub: sub}, nil
+}
+
+// WatchPublicKeyPublished is a free log subscription operation binding the contract event 0xf62bba8b270bef3e8d0fcebc1f86567664da8ccbd03e8509d6231cc8d63f4b31.
+//
+// Solidity: event PublicKeyPublished(bytes publicKey)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchPublicKeyPublished(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepPublicKeyPublished) (event.Subscription, error) {
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "PublicKeyPublished")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepPublicKeyPublished)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "PublicKeyPublished", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePublicKeyPublished is a log parse operation binding the contract event 0xf62bba8b270bef3e8d0fcebc1f86567664da8ccbd03e8509d6231cc8d63f4b31.
+//
+// Solidity: event PublicKeyPublished(bytes publicKey)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParsePublicKeyPublished(log types.Log) (*FullyBackedECDSAKeepPublicKeyPublished, error) {
+	event := new(FullyBackedECDSAKeepPublicKeyPublished)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "PublicKeyPublished", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepSignatureRequestedIterator is returned from FilterSignatureRequested and is used to iterate over the raw logs and unpacked data for SignatureRequested events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepSignatureRequestedIterator struct {
+	Event *FullyBackedECDSAKeepSignatureRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepSignatureRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepSignatureRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepSignatureRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepSignatureRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepSignatureRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepSignatureRequested represents a SignatureRequested event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepSignatureRequested struct {
+	Digest [32]byte
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignatureRequested is a free log retrieval operation binding the contract event 0x34f611bedd4f8c135323bbfc4921e3f6e4feb7eef591036eed6af5462e6cfab0.
+//
+// Solidity: event SignatureRequested(bytes32 indexed digest)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterSignatureRequested(opts *bind.FilterOpts, digest [][32]byte) (*FullyBackedECDSAKeepSignatureRequestedIterator, error) {
+
+	var digestRule []interface{}
+	for _, digestItem := range digest {
+		digestRule = append(digestRule, digestItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "SignatureRequested", digestRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepSignatureRequestedIterator{contract: _FullyBackedECDSAKeep.contract, event: "SignatureRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchSignatureRequested is a free log subscription operation binding the contract event 0x34f611bedd4f8c135323bbfc4921e3f6e4feb7eef591036eed6af5462e6cfab0.
+//
+// Solidity: event SignatureRequested(bytes32 indexed digest)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchSignatureRequested(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepSignatureRequested, digest [][32]byte) (event.Subscription, error) {
+
+	var digestRule []interface{}
+	for _, digestItem := range digest {
+		digestRule = append(digestRule, digestItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "SignatureRequested", digestRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepSignatureRequested)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "SignatureRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignatureRequested is a log parse operation binding the contract event 0x34f611bedd4f8c135323bbfc4921e3f6e4feb7eef591036eed6af5462e6cfab0.
+//
+// Solidity: event SignatureRequested(bytes32 indexed digest)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseSignatureRequested(log types.Log) (*FullyBackedECDSAKeepSignatureRequested, error) {
+	event := new(FullyBackedECDSAKeepSignatureRequested)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "SignatureRequested", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FullyBackedECDSAKeepSignatureSubmittedIterator is returned from FilterSignatureSubmitted and is used to iterate over the raw logs and unpacked data for SignatureSubmitted events raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepSignatureSubmittedIterator struct {
+	Event *FullyBackedECDSAKeepSignatureSubmitted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepSignatureSubmittedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepSignatureSubmitted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepSignatureSubmitted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepSignatureSubmittedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepSignatureSubmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepSignatureSubmitted represents a SignatureSubmitted event raised by the FullyBackedECDSAKeep contract.
+type FullyBackedECDSAKeepSignatureSubmitted struct {
+	Digest     [32]byte
+	R          [32]byte
+	S          [32]byte
+	RecoveryID uint8
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignatureSubmitted is a free log retrieval operation binding the contract event 0xb19546e9e0b503d103dd4ae295f4d526e9115adf7c902ead329b1f2404efd35f.
+//
+// Solidity: event SignatureSubmitted(bytes32 indexed digest, bytes32 r, bytes32 s, uint8 recoveryID)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) FilterSignatureSubmitted(opts *bind.FilterOpts, digest [][32]byte) (*FullyBackedECDSAKeepSignatureSubmittedIterator, error) {
+
+	var digestRule []interface{}
+	for _, digestItem := range digest {
+		digestRule = append(digestRule, digestItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.FilterLogs(opts, "SignatureSubmitted", digestRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepSignatureSubmittedIterator{contract: _FullyBackedECDSAKeep.contract, event: "SignatureSubmitted", logs: logs, sub: sub}, nil
+}
+
+// WatchSignatureSubmitted is a free log subscription operation binding the contract event 0xb19546e9e0b503d103dd4ae295f4d526e9115adf7c902ead329b1f2404efd35f.
+//
+// Solidity: event SignatureSubmitted(bytes32 indexed digest, bytes32 r, bytes32 s, uint8 recoveryID)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) WatchSignatureSubmitted(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepSignatureSubmitted, digest [][32]byte) (event.Subscription, error) {
+
+	var digestRule []interface{}
+	for _, digestItem := range digest {
+		digestRule = append(digestRule, digestItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeep.contract.WatchLogs(opts, "SignatureSubmitted", digestRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepSignatureSubmitted)
+				if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "SignatureSubmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignatureSubmitted is a log parse operation binding the contract event 0xb19546e9e0b503d103dd4ae295f4d526e9115adf7c902ead329b1f2404efd35f.
+//
+// Solidity: event SignatureSubmitted(bytes32 indexed digest, bytes32 r, bytes32 s, uint8 recoveryID)
+func (_FullyBackedECDSAKeep *FullyBackedECDSAKeepFilterer) ParseSignatureSubmitted(log types.Log) (*FullyBackedECDSAKeepSignatureSubmitted, error) {
+	event := new(FullyBackedECDSAKeepSignatureSubmitted)
+	if err := _FullyBackedECDSAKeep.contract.UnpackLog(event, "SignatureSubmitted", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,1365 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated command and any manual changes will be lost.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/cmd"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+
+	"github.com/urfave/cli"
+)
+
+var ECDSARewardsCommand cli.Command
+
+var eCDSARewardsDescription = `The e-c-d-s-a-rewards command allows calling the ECDSARewards contract on an
+	Ethereum network. It has subcommands corresponding to each contract method,
+	which respectively each take parameters based on the contract method's
+	parameters.
+
+	Subcommands will submit a non-mutating call to the network and output the
+	result.
+
+	All subcommands can be called against a specific block by passing the
+	-b/--block flag.
+
+	All subcommands can be used to investigate the result of a previous
+	transaction that called that same method by passing the -t/--transaction
+	flag with the transaction hash.
+
+	Subcommands for mutating methods may be submitted as a mutating transaction
+	by passing the -s/--submit flag. In this mode, this command will terminate
+	successfully once the transaction has been submitted, but will not wait for
+	the transaction to be included in a block. They return the transaction hash.
+
+	Calls that require ether to be paid will get 0 ether by default, which can
+	be changed by passing the -v/--value flag.`
+
+func init() {
+	AvailableCommands = append(AvailableCommands, cli.Command{
+		Name:        "e-c-d-s-a-rewards",
+		Usage:       `Provides access to the ECDSARewards contract.`,
+		Description: eCDSARewardsDescription,
+		Subcommands: []cli.Command{{
+			Name:      "is-owner",
+			Usage:     "Calls the constant method isOwner on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarIsOwner,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "minimum-keeps-per-interval",
+			Usage:     "Calls the constant method minimumKeepsPerInterval on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarMinimumKeepsPerInterval,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "is-finished",
+			Usage:     "Calls the constant method isFinished on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarIsFinished,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "unallocated-rewards",
+			Usage:     "Calls the constant method unallocatedRewards on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarUnallocatedRewards,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "dispensed-rewards",
+			Usage:     "Calls the constant method dispensedRewards on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarDispensedRewards,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-allocated-rewards0",
+			Usage:     "Calls the constant method getAllocatedRewards0 on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarGetAllocatedRewards0,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "upgrade-finalized-timestamp",
+			Usage:     "Calls the constant method upgradeFinalizedTimestamp on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarUpgradeFinalizedTimestamp,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-interval-weight",
+			Usage:     "Calls the constant method getIntervalWeight on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarGetIntervalWeight,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-withdrawable-rewards",
+			Usage:     "Calls the constant method getWithdrawableRewards on the ECDSARewards contract.",
+			ArgsUsage: "[interval] [operator] ",
+			Action:    ecdsarGetWithdrawableRewards,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "interval-of",
+			Usage:     "Calls the constant method intervalOf on the ECDSARewards contract.",
+			ArgsUsage: "[timestamp] ",
+			Action:    ecdsarIntervalOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "new-rewards-contract",
+			Usage:     "Calls the constant method newRewardsContract on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarNewRewardsContract,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "upgrade-initiated-timestamp",
+			Usage:     "Calls the constant method upgradeInitiatedTimestamp on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarUpgradeInitiatedTimestamp,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "end-of",
+			Usage:     "Calls the constant method endOf on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarEndOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-interval-count",
+			Usage:     "Calls the constant method getIntervalCount on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarGetIntervalCount,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-withdrawn-rewards",
+			Usage:     "Calls the constant method getWithdrawnRewards on the ECDSARewards contract.",
+			ArgsUsage: "[interval] [operator] ",
+			Action:    ecdsarGetWithdrawnRewards,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "first-interval-start",
+			Usage:     "Calls the constant method firstIntervalStart on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarFirstIntervalStart,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "owner",
+			Usage:     "Calls the constant method owner on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarOwner,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "start-of",
+			Usage:     "Calls the constant method startOf on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarStartOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "total-rewards",
+			Usage:     "Calls the constant method totalRewards on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarTotalRewards,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "beneficiary-reward-cap",
+			Usage:     "Calls the constant method beneficiaryRewardCap on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarBeneficiaryRewardCap,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "token",
+			Usage:     "Calls the constant method token on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarToken,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-allocated-rewards",
+			Usage:     "Calls the constant method getAllocatedRewards on the ECDSARewards contract.",
+			ArgsUsage: "[interval] [operator] ",
+			Action:    ecdsarGetAllocatedRewards,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "interval-keeps-processed",
+			Usage:     "Calls the constant method intervalKeepsProcessed on the ECDSARewards contract.",
+			ArgsUsage: "[arg0] ",
+			Action:    ecdsarIntervalKeepsProcessed,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "is-allocated",
+			Usage:     "Calls the constant method isAllocated on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarIsAllocated,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "funded",
+			Usage:     "Calls the constant method funded on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarFunded,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "initiate-rewards-upgrade",
+			Usage:     "Calls the method initiateRewardsUpgrade on the ECDSARewards contract.",
+			ArgsUsage: "[_newRewardsContract] ",
+			Action:    ecdsarInitiateRewardsUpgrade,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "receive-approval",
+			Usage:     "Calls the method receiveApproval on the ECDSARewards contract.",
+			ArgsUsage: "[_from] [_value] [_token] [arg3] ",
+			Action:    ecdsarReceiveApproval,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(4))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "allocate-rewards",
+			Usage:     "Calls the method allocateRewards on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarAllocateRewards,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "finalize-rewards-upgrade",
+			Usage:     "Calls the method finalizeRewardsUpgrade on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarFinalizeRewardsUpgrade,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(0))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "keeps-in-interval",
+			Usage:     "Calls the method keepsInInterval on the ECDSARewards contract.",
+			ArgsUsage: "[interval] ",
+			Action:    ecdsarKeepsInInterval,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "mark-as-funded",
+			Usage:     "Calls the method markAsFunded on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarMarkAsFunded,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(0))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "renounce-ownership",
+			Usage:     "Calls the method renounceOwnership on the ECDSARewards contract.",
+			ArgsUsage: "",
+			Action:    ecdsarRenounceOwnership,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(0))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "withdraw-rewards",
+			Usage:     "Calls the method withdrawRewards on the ECDSARewards contract.",
+			ArgsUsage: "[interval] [operator] ",
+			Action:    ecdsarWithdrawRewards,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "transfer-ownership",
+			Usage:     "Calls the method transferOwnership on the ECDSARewards contract.",
+			ArgsUsage: "[newOwner] ",
+			Action:    ecdsarTransferOwnership,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.NonConstFlags,
+		}},
+	})
+}
+
+/// ------------------- Const methods -------------------
+
+func ecdsarIsOwner(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.IsOwnerAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarMinimumKeepsPerInterval(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.MinimumKeepsPerIntervalAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarIsFinished(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.IsFinishedAtBlock(
+		interval,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarUnallocatedRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.UnallocatedRewardsAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarDispensedRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.DispensedRewardsAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarGetAllocatedRewards0(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.GetAllocatedRewards0AtBlock(
+		interval,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarUpgradeFinalizedTimestamp(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.UpgradeFinalizedTimestampAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarGetIntervalWeight(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.GetIntervalWeightAtBlock(
+		interval,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarGetWithdrawableRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.GetWithdrawableRewardsAtBlock(
+		interval,
+		operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarIntervalOf(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	timestamp, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter timestamp, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.IntervalOfAtBlock(
+		timestamp,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarNewRewardsContract(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.NewRewardsContractAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarUpgradeInitiatedTimestamp(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.UpgradeInitiatedTimestampAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarEndOf(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.EndOfAtBlock(
+		interval,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarGetIntervalCount(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.GetIntervalCountAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarGetWithdrawnRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.GetWithdrawnRewardsAtBlock(
+		interval,
+		operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarFirstIntervalStart(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.FirstIntervalStartAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarOwner(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.OwnerAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarStartOf(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.StartOfAtBlock(
+		interval,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarTotalRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.TotalRewardsAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarBeneficiaryRewardCap(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.BeneficiaryRewardCapAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarToken(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.TokenAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarGetAllocatedRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.GetAllocatedRewardsAtBlock(
+		interval,
+		operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarIntervalKeepsProcessed(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	arg0, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter arg0, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.IntervalKeepsProcessedAtBlock(
+		arg0,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarIsAllocated(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.IsAllocatedAtBlock(
+		interval,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func ecdsarFunded(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.FundedAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+/// ------------------- Non-const methods -------------------
+
+func ecdsarInitiateRewardsUpgrade(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	_newRewardsContract, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _newRewardsContract, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.InitiateRewardsUpgrade(
+			_newRewardsContract,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallInitiateRewardsUpgrade(
+			_newRewardsContract,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarReceiveApproval(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	_from, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _from, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_value, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _value, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	_token, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _token, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	arg3, err := hexutil.Decode(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter arg3, a bytes, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.ReceiveApproval(
+			_from,
+			_value,
+			_token,
+			arg3,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallReceiveApproval(
+			_from,
+			_value,
+			_token,
+			arg3,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarAllocateRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.AllocateRewards(
+			interval,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallAllocateRewards(
+			interval,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarFinalizeRewardsUpgrade(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.FinalizeRewardsUpgrade()
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallFinalizeRewardsUpgrade(
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarKeepsInInterval(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+		result      *big.Int
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.KeepsInInterval(
+			interval,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		result, err = contract.CallKeepsInInterval(
+			interval,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(result)
+	}
+
+	return nil
+}
+
+func ecdsarMarkAsFunded(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.MarkAsFunded()
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallMarkAsFunded(
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarRenounceOwnership(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.RenounceOwnership()
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallRenounceOwnership(
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarWithdrawRewards(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	interval, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter interval, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.WithdrawRewards(
+			interval,
+			operator,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallWithdrawRewards(
+			interval,
+			operator,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func ecdsarTransferOwnership(c *cli.Context) error {
+	contract, err := initializeECDSARewards(c)
+	if err != nil {
+		return err
+	}
+
+	newOwner, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter newOwner, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.TransferOwnership(
+			newOwner,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallTransferOwnership(
+			newOwner,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+/// ------------------- Initialization -------------------
+
+func initializeECDSARewards(c *cli.Context) (*contract.ECDSARewards, error) {
+	config, err := config.ReadEthereumConfig(c.GlobalString("config"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading Ethereum config from file: [%v]", err)
+	}
+
+	client, _, _, err := ethutil.ConnectClients(config.URL, config.URLRPC)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	key, err := ethutil.DecryptKeyFile(
+		config.Account.KeyFile,
+		config.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read KeyFile: %s: [%v]",
+			config.Account.KeyFile,
+			err,
+		)
+	}
+
+	checkInterval := cmd.DefaultMiningCheckInterval
+	maxGasPrice := cmd.DefaultMaxGasPrice
+	if config.MiningCheckInterval != 0 {
+		checkInterval = time.Duration(config.MiningCheckInterval) * time.Second
+	}
+	if config.MaxGasPrice != 0 {
+		maxGasPrice = new(big.Int).SetUint64(config.MaxGasPrice)
+	}
+
+	miningWaiter := ethutil.NewMiningWaiter(client, checkInterval, maxGasPrice)
+
+	address := common.HexToAddress(config.ContractAddresses["ECDSARewards"])
+
+	return contract.NewECDSARewards(
+		address,
+		key,
+		client,
+		ethutil.NewNonceManager(key.Address, client),
+		miningWaiter,
+		&sync.Mutex{},
+	)
+}
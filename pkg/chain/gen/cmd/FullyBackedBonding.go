@@ -0,0 +1,1541 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated command and any manual changes will be lost.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/cmd"
+	"github.com/keep-network/keep-ecdsa/internal/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/contract"
+
+	"github.com/urfave/cli"
+)
+
+var FullyBackedBondingCommand cli.Command
+
+var fullyBackedBondingDescription = `The fully-backed-bonding command allows calling the FullyBackedBonding contract on an
+	Ethereum network. It has subcommands corresponding to each contract method,
+	which respectively each take parameters based on the contract method's
+	parameters.
+
+	Subcommands will submit a non-mutating call to the network and output the
+	result.
+
+	All subcommands can be called against a specific block by passing the
+	-b/--block flag.
+
+	All subcommands can be used to investigate the result of a previous
+	transaction that called that same method by passing the -t/--transaction
+	flag with the transaction hash.
+
+	Subcommands for mutating methods may be submitted as a mutating transaction
+	by passing the -s/--submit flag. In this mode, this command will terminate
+	successfully once the transaction has been submitted, but will not wait for
+	the transaction to be included in a block. They return the transaction hash.
+
+	Calls that require ether to be paid will get 0 ether by default, which can
+	be changed by passing the -v/--value flag.`
+
+func init() {
+	AvailableCommands = append(AvailableCommands, cli.Command{
+		Name:        "fully-backed-bonding",
+		Usage:       `Provides access to the FullyBackedBonding contract.`,
+		Description: fullyBackedBondingDescription,
+		Subcommands: []cli.Command{{
+			Name:      "bond-amount",
+			Usage:     "Calls the constant method bondAmount on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [holder] [referenceID] ",
+			Action:    fbbBondAmount,
+			Before:    cmd.ArgCountChecker(3),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "is-approved-operator-contract",
+			Usage:     "Calls the constant method isApprovedOperatorContract on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operatorContract] ",
+			Action:    fbbIsApprovedOperatorContract,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "available-unbonded-value",
+			Usage:     "Calls the constant method availableUnbondedValue on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [bondCreator] [authorizedSortitionPool] ",
+			Action:    fbbAvailableUnbondedValue,
+			Before:    cmd.ArgCountChecker(3),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "is-initialized",
+			Usage:     "Calls the constant method isInitialized on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [bondCreator] ",
+			Action:    fbbIsInitialized,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "m-i-n-i-m-u-m-d-e-l-e-g-a-t-i-o-n-d-e-p-o-s-i-t",
+			Usage:     "Calls the constant method mINIMUMDELEGATIONDEPOSIT on the FullyBackedBonding contract.",
+			ArgsUsage: "",
+			Action:    fbbMINIMUMDELEGATIONDEPOSIT,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "initialization-period",
+			Usage:     "Calls the constant method initializationPeriod on the FullyBackedBonding contract.",
+			ArgsUsage: "",
+			Action:    fbbInitializationPeriod,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "is-authorized-for-operator",
+			Usage:     "Calls the constant method isAuthorizedForOperator on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] [_operatorContract] ",
+			Action:    fbbIsAuthorizedForOperator,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "d-e-l-e-g-a-t-i-o-n-l-o-c-k-p-e-r-i-o-d",
+			Usage:     "Calls the constant method dELEGATIONLOCKPERIOD on the FullyBackedBonding contract.",
+			ArgsUsage: "",
+			Action:    fbbDELEGATIONLOCKPERIOD,
+			Before:    cmd.ArgCountChecker(0),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "balance-of",
+			Usage:     "Calls the constant method balanceOf on the FullyBackedBonding contract.",
+			ArgsUsage: "[_address] ",
+			Action:    fbbBalanceOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-authority-source",
+			Usage:     "Calls the constant method getAuthoritySource on the FullyBackedBonding contract.",
+			ArgsUsage: "[operatorContract] ",
+			Action:    fbbGetAuthoritySource,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "authorizer-of",
+			Usage:     "Calls the constant method authorizerOf on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] ",
+			Action:    fbbAuthorizerOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "has-secondary-authorization",
+			Usage:     "Calls the constant method hasSecondaryAuthorization on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] [_poolAddress] ",
+			Action:    fbbHasSecondaryAuthorization,
+			Before:    cmd.ArgCountChecker(2),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "beneficiary-of",
+			Usage:     "Calls the constant method beneficiaryOf on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] ",
+			Action:    fbbBeneficiaryOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "get-delegation-info",
+			Usage:     "Calls the constant method getDelegationInfo on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] ",
+			Action:    fbbGetDelegationInfo,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "owner-of",
+			Usage:     "Calls the constant method ownerOf on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] ",
+			Action:    fbbOwnerOf,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "unbonded-value",
+			Usage:     "Calls the constant method unbondedValue on the FullyBackedBonding contract.",
+			ArgsUsage: "[arg0] ",
+			Action:    fbbUnbondedValue,
+			Before:    cmd.ArgCountChecker(1),
+			Flags:     cmd.ConstFlags,
+		}, {
+			Name:      "withdraw",
+			Usage:     "Calls the method withdraw on the FullyBackedBonding contract.",
+			ArgsUsage: "[amount] [operator] ",
+			Action:    fbbWithdraw,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "seize-bond",
+			Usage:     "Calls the method seizeBond on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [referenceID] [amount] [destination] ",
+			Action:    fbbSeizeBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(4))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "deposit",
+			Usage:     "Calls the payable method deposit on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] ",
+			Action:    fbbDeposit,
+			Before:    cli.BeforeFunc(cmd.PayableArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.PayableFlags,
+		}, {
+			Name:      "authorize-operator-contract",
+			Usage:     "Calls the method authorizeOperatorContract on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] [_operatorContract] ",
+			Action:    fbbAuthorizeOperatorContract,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "deauthorize-sortition-pool-contract",
+			Usage:     "Calls the method deauthorizeSortitionPoolContract on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] [_poolAddress] ",
+			Action:    fbbDeauthorizeSortitionPoolContract,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "free-bond",
+			Usage:     "Calls the method freeBond on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [referenceID] ",
+			Action:    fbbFreeBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "delegate",
+			Usage:     "Calls the payable method delegate on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [beneficiary] [authorizer] ",
+			Action:    fbbDelegate,
+			Before:    cli.BeforeFunc(cmd.PayableArgsChecker.AndThen(cmd.ArgCountChecker(3))),
+			Flags:     cmd.PayableFlags,
+		}, {
+			Name:      "claim-delegated-authority",
+			Usage:     "Calls the method claimDelegatedAuthority on the FullyBackedBonding contract.",
+			ArgsUsage: "[delegatedAuthoritySource] ",
+			Action:    fbbClaimDelegatedAuthority,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "reassign-bond",
+			Usage:     "Calls the method reassignBond on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [referenceID] [newHolder] [newReferenceID] ",
+			Action:    fbbReassignBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(4))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "is-recognized",
+			Usage:     "Calls the method isRecognized on the FullyBackedBonding contract.",
+			ArgsUsage: "[delegatedAuthorityRecipient] ",
+			Action:    fbbIsRecognized,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "top-up",
+			Usage:     "Calls the payable method topUp on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] ",
+			Action:    fbbTopUp,
+			Before:    cli.BeforeFunc(cmd.PayableArgsChecker.AndThen(cmd.ArgCountChecker(1))),
+			Flags:     cmd.PayableFlags,
+		}, {
+			Name:      "create-bond",
+			Usage:     "Calls the method createBond on the FullyBackedBonding contract.",
+			ArgsUsage: "[operator] [holder] [referenceID] [amount] [authorizedSortitionPool] ",
+			Action:    fbbCreateBond,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(5))),
+			Flags:     cmd.NonConstFlags,
+		}, {
+			Name:      "authorize-sortition-pool-contract",
+			Usage:     "Calls the method authorizeSortitionPoolContract on the FullyBackedBonding contract.",
+			ArgsUsage: "[_operator] [_poolAddress] ",
+			Action:    fbbAuthorizeSortitionPoolContract,
+			Before:    cli.BeforeFunc(cmd.NonConstArgsChecker.AndThen(cmd.ArgCountChecker(2))),
+			Flags:     cmd.NonConstFlags,
+		}},
+	})
+}
+
+/// ------------------- Const methods -------------------
+
+func fbbBondAmount(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	holder, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter holder, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	result, err := contract.BondAmountAtBlock(
+		operator,
+		holder,
+		referenceID,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbIsApprovedOperatorContract(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_operatorContract, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operatorContract, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.IsApprovedOperatorContractAtBlock(
+		_operatorContract,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbAvailableUnbondedValue(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	bondCreator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter bondCreator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	authorizedSortitionPool, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter authorizedSortitionPool, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	result, err := contract.AvailableUnbondedValueAtBlock(
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbIsInitialized(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	bondCreator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter bondCreator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.IsInitializedAtBlock(
+		operator,
+		bondCreator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbMINIMUMDELEGATIONDEPOSIT(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.MINIMUMDELEGATIONDEPOSITAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbInitializationPeriod(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.InitializationPeriodAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbIsAuthorizedForOperator(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_operatorContract, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operatorContract, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.IsAuthorizedForOperatorAtBlock(
+		_operator,
+		_operatorContract,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbDELEGATIONLOCKPERIOD(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := contract.DELEGATIONLOCKPERIODAtBlock(
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbBalanceOf(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_address, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _address, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.BalanceOfAtBlock(
+		_address,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbGetAuthoritySource(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	operatorContract, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operatorContract, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.GetAuthoritySourceAtBlock(
+		operatorContract,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbAuthorizerOf(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.AuthorizerOfAtBlock(
+		_operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbHasSecondaryAuthorization(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_poolAddress, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _poolAddress, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	result, err := contract.HasSecondaryAuthorizationAtBlock(
+		_operator,
+		_poolAddress,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbBeneficiaryOf(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.BeneficiaryOfAtBlock(
+		_operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbGetDelegationInfo(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.GetDelegationInfoAtBlock(
+		operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbOwnerOf(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.OwnerOfAtBlock(
+		_operator,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+func fbbUnbondedValue(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+	arg0, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter arg0, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	result, err := contract.UnbondedValueAtBlock(
+		arg0,
+
+		cmd.BlockFlagValue.Uint,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintOutput(result)
+
+	return nil
+}
+
+/// ------------------- Non-const methods -------------------
+
+func fbbWithdraw(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.Withdraw(
+			amount,
+			operator,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallWithdraw(
+			amount,
+			operator,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbSeizeBond(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	destination, err := ethutil.AddressFromHex(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter destination, a address, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.SeizeBond(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallSeizeBond(
+			operator,
+			referenceID,
+			amount,
+			destination,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbDeposit(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.Deposit(
+			operator,
+			cmd.ValueFlagValue.Uint)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallDeposit(
+			operator,
+			cmd.ValueFlagValue.Uint, cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbAuthorizeOperatorContract(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_operatorContract, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operatorContract, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.AuthorizeOperatorContract(
+			_operator,
+			_operatorContract,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallAuthorizeOperatorContract(
+			_operator,
+			_operatorContract,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbDeauthorizeSortitionPoolContract(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_poolAddress, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _poolAddress, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.DeauthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallDeauthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbFreeBond(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.FreeBond(
+			operator,
+			referenceID,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallFreeBond(
+			operator,
+			referenceID,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbDelegate(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	beneficiary, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter beneficiary, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	authorizer, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter authorizer, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.Delegate(
+			operator,
+			beneficiary,
+			authorizer,
+			cmd.ValueFlagValue.Uint)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallDelegate(
+			operator,
+			beneficiary,
+			authorizer,
+			cmd.ValueFlagValue.Uint, cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbClaimDelegatedAuthority(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	delegatedAuthoritySource, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter delegatedAuthoritySource, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.ClaimDelegatedAuthority(
+			delegatedAuthoritySource,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallClaimDelegatedAuthority(
+			delegatedAuthoritySource,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbReassignBond(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	newHolder, err := ethutil.AddressFromHex(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter newHolder, a address, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	newReferenceID, err := hexutil.DecodeBig(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter newReferenceID, a uint256, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.ReassignBond(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallReassignBond(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbIsRecognized(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	delegatedAuthorityRecipient, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter delegatedAuthorityRecipient, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+		result      bool
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.IsRecognized(
+			delegatedAuthorityRecipient,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		result, err = contract.CallIsRecognized(
+			delegatedAuthorityRecipient,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(result)
+	}
+
+	return nil
+}
+
+func fbbTopUp(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.TopUp(
+			operator,
+			cmd.ValueFlagValue.Uint)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallTopUp(
+			operator,
+			cmd.ValueFlagValue.Uint, cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbCreateBond(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	holder, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter holder, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	referenceID, err := hexutil.DecodeBig(c.Args()[2])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter referenceID, a uint256, from passed value %v",
+			c.Args()[2],
+		)
+	}
+
+	amount, err := hexutil.DecodeBig(c.Args()[3])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter amount, a uint256, from passed value %v",
+			c.Args()[3],
+		)
+	}
+
+	authorizedSortitionPool, err := ethutil.AddressFromHex(c.Args()[4])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter authorizedSortitionPool, a address, from passed value %v",
+			c.Args()[4],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.CreateBond(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallCreateBond(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+func fbbAuthorizeSortitionPoolContract(c *cli.Context) error {
+	contract, err := initializeFullyBackedBonding(c)
+	if err != nil {
+		return err
+	}
+
+	_operator, err := ethutil.AddressFromHex(c.Args()[0])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _operator, a address, from passed value %v",
+			c.Args()[0],
+		)
+	}
+
+	_poolAddress, err := ethutil.AddressFromHex(c.Args()[1])
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't parse parameter _poolAddress, a address, from passed value %v",
+			c.Args()[1],
+		)
+	}
+
+	var (
+		transaction *types.Transaction
+	)
+
+	if c.Bool(cmd.SubmitFlag) {
+		// Do a regular submission. Take payable into account.
+		transaction, err = contract.AuthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(transaction.Hash)
+	} else {
+		// Do a call.
+		err = contract.CallAuthorizeSortitionPoolContract(
+			_operator,
+			_poolAddress,
+			cmd.BlockFlagValue.Uint,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmd.PrintOutput(nil)
+	}
+
+	return nil
+}
+
+/// ------------------- Initialization -------------------
+
+func initializeFullyBackedBonding(c *cli.Context) (*contract.FullyBackedBonding, error) {
+	config, err := config.ReadEthereumConfig(c.GlobalString("config"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading Ethereum config from file: [%v]", err)
+	}
+
+	client, _, _, err := ethutil.ConnectClients(config.URL, config.URLRPC)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	key, err := ethutil.DecryptKeyFile(
+		config.Account.KeyFile,
+		config.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read KeyFile: %s: [%v]",
+			config.Account.KeyFile,
+			err,
+		)
+	}
+
+	checkInterval := cmd.DefaultMiningCheckInterval
+	maxGasPrice := cmd.DefaultMaxGasPrice
+	if config.MiningCheckInterval != 0 {
+		checkInterval = time.Duration(config.MiningCheckInterval) * time.Second
+	}
+	if config.MaxGasPrice != 0 {
+		maxGasPrice = new(big.Int).SetUint64(config.MaxGasPrice)
+	}
+
+	miningWaiter := ethutil.NewMiningWaiter(client, checkInterval, maxGasPrice)
+
+	address := common.HexToAddress(config.ContractAddresses["FullyBackedBonding"])
+
+	return contract.NewFullyBackedBonding(
+		address,
+		key,
+		client,
+		ethutil.NewNonceManager(key.Address, client),
+		miningWaiter,
+		&sync.Mutex{},
+	)
+}
@@ -0,0 +1,3549 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereumabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var ecdsarLogger = log.Logger("keep-contract-ECDSARewards")
+
+type ECDSARewards struct {
+	contract          *abi.ECDSARewards
+	contractAddress   common.Address
+	contractABI       *ethereumabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *ethutil.ErrorResolver
+	nonceManager      *ethutil.NonceManager
+	miningWaiter      *ethutil.MiningWaiter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewECDSARewards(
+	contractAddress common.Address,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	transactionMutex *sync.Mutex,
+) (*ECDSARewards, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	transactorOptions := bind.NewKeyedTransactor(
+		accountKey.PrivateKey,
+	)
+
+	randomBeaconContract, err := abi.NewECDSARewards(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := ethereumabi.JSON(strings.NewReader(abi.ECDSARewardsABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &ECDSARewards{
+		contract:          randomBeaconContract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     ethutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) ReceiveReward(
+	keepIdentifier [32]uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction receiveReward",
+		"params: ",
+		fmt.Sprint(
+			keepIdentifier,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.ReceiveReward(
+		transactorOptions,
+		keepIdentifier,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"receiveReward",
+			keepIdentifier,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction receiveReward with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.ReceiveReward(
+				transactorOptions,
+				keepIdentifier,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"receiveReward",
+					keepIdentifier,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction receiveReward with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallReceiveReward(
+	keepIdentifier [32]uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"receiveReward",
+		&result,
+		keepIdentifier,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) ReceiveRewardGasEstimate(
+	keepIdentifier [32]uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"receiveReward",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		keepIdentifier,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) ReceiveRewards(
+	keepIdentifiers [][32]uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction receiveRewards",
+		"params: ",
+		fmt.Sprint(
+			keepIdentifiers,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.ReceiveRewards(
+		transactorOptions,
+		keepIdentifiers,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"receiveRewards",
+			keepIdentifiers,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction receiveRewards with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.ReceiveRewards(
+				transactorOptions,
+				keepIdentifiers,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"receiveRewards",
+					keepIdentifiers,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction receiveRewards with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallReceiveRewards(
+	keepIdentifiers [][32]uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"receiveRewards",
+		&result,
+		keepIdentifiers,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) ReceiveRewardsGasEstimate(
+	keepIdentifiers [][32]uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"receiveRewards",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		keepIdentifiers,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) ReportTerminations(
+	keepIdentifiers [][32]uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction reportTerminations",
+		"params: ",
+		fmt.Sprint(
+			keepIdentifiers,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.ReportTerminations(
+		transactorOptions,
+		keepIdentifiers,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"reportTerminations",
+			keepIdentifiers,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction reportTerminations with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.ReportTerminations(
+				transactorOptions,
+				keepIdentifiers,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"reportTerminations",
+					keepIdentifiers,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction reportTerminations with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallReportTerminations(
+	keepIdentifiers [][32]uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"reportTerminations",
+		&result,
+		keepIdentifiers,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) ReportTerminationsGasEstimate(
+	keepIdentifiers [][32]uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"reportTerminations",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		keepIdentifiers,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) InitiateRewardsUpgrade(
+	_newRewardsContract common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction initiateRewardsUpgrade",
+		"params: ",
+		fmt.Sprint(
+			_newRewardsContract,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.InitiateRewardsUpgrade(
+		transactorOptions,
+		_newRewardsContract,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"initiateRewardsUpgrade",
+			_newRewardsContract,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction initiateRewardsUpgrade with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.InitiateRewardsUpgrade(
+				transactorOptions,
+				_newRewardsContract,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"initiateRewardsUpgrade",
+					_newRewardsContract,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction initiateRewardsUpgrade with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallInitiateRewardsUpgrade(
+	_newRewardsContract common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"initiateRewardsUpgrade",
+		&result,
+		_newRewardsContract,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) InitiateRewardsUpgradeGasEstimate(
+	_newRewardsContract common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"initiateRewardsUpgrade",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		_newRewardsContract,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) ReceiveApproval(
+	_from common.Address,
+	_value *big.Int,
+	_token common.Address,
+	arg3 []uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction receiveApproval",
+		"params: ",
+		fmt.Sprint(
+			_from,
+			_value,
+			_token,
+			arg3,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.ReceiveApproval(
+		transactorOptions,
+		_from,
+		_value,
+		_token,
+		arg3,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"receiveApproval",
+			_from,
+			_value,
+			_token,
+			arg3,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction receiveApproval with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.ReceiveApproval(
+				transactorOptions,
+				_from,
+				_value,
+				_token,
+				arg3,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"receiveApproval",
+					_from,
+					_value,
+					_token,
+					arg3,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction receiveApproval with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallReceiveApproval(
+	_from common.Address,
+	_value *big.Int,
+	_token common.Address,
+	arg3 []uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"receiveApproval",
+		&result,
+		_from,
+		_value,
+		_token,
+		arg3,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) ReceiveApprovalGasEstimate(
+	_from common.Address,
+	_value *big.Int,
+	_token common.Address,
+	arg3 []uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"receiveApproval",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		_from,
+		_value,
+		_token,
+		arg3,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) AllocateRewards(
+	interval *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction allocateRewards",
+		"params: ",
+		fmt.Sprint(
+			interval,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.AllocateRewards(
+		transactorOptions,
+		interval,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"allocateRewards",
+			interval,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction allocateRewards with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.AllocateRewards(
+				transactorOptions,
+				interval,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"allocateRewards",
+					interval,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction allocateRewards with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallAllocateRewards(
+	interval *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"allocateRewards",
+		&result,
+		interval,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) AllocateRewardsGasEstimate(
+	interval *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"allocateRewards",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		interval,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) FinalizeRewardsUpgrade(
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction finalizeRewardsUpgrade",
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.FinalizeRewardsUpgrade(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"finalizeRewardsUpgrade",
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction finalizeRewardsUpgrade with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.FinalizeRewardsUpgrade(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"finalizeRewardsUpgrade",
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction finalizeRewardsUpgrade with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallFinalizeRewardsUpgrade(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"finalizeRewardsUpgrade",
+		&result,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) FinalizeRewardsUpgradeGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"finalizeRewardsUpgrade",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) KeepsInInterval(
+	interval *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction keepsInInterval",
+		"params: ",
+		fmt.Sprint(
+			interval,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.KeepsInInterval(
+		transactorOptions,
+		interval,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"keepsInInterval",
+			interval,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction keepsInInterval with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.KeepsInInterval(
+				transactorOptions,
+				interval,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"keepsInInterval",
+					interval,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction keepsInInterval with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallKeepsInInterval(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"keepsInInterval",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) KeepsInIntervalGasEstimate(
+	interval *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"keepsInInterval",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		interval,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) MarkAsFunded(
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction markAsFunded",
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.MarkAsFunded(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"markAsFunded",
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction markAsFunded with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.MarkAsFunded(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"markAsFunded",
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction markAsFunded with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallMarkAsFunded(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"markAsFunded",
+		&result,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) MarkAsFundedGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"markAsFunded",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) RenounceOwnership(
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction renounceOwnership",
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.RenounceOwnership(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"renounceOwnership",
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction renounceOwnership with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.RenounceOwnership(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"renounceOwnership",
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction renounceOwnership with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallRenounceOwnership(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"renounceOwnership",
+		&result,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) RenounceOwnershipGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"renounceOwnership",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) ReportTermination(
+	keepIdentifier [32]uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction reportTermination",
+		"params: ",
+		fmt.Sprint(
+			keepIdentifier,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.ReportTermination(
+		transactorOptions,
+		keepIdentifier,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"reportTermination",
+			keepIdentifier,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction reportTermination with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.ReportTermination(
+				transactorOptions,
+				keepIdentifier,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"reportTermination",
+					keepIdentifier,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction reportTermination with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallReportTermination(
+	keepIdentifier [32]uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"reportTermination",
+		&result,
+		keepIdentifier,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) ReportTerminationGasEstimate(
+	keepIdentifier [32]uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"reportTermination",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		keepIdentifier,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) WithdrawRewards(
+	interval *big.Int,
+	operator common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction withdrawRewards",
+		"params: ",
+		fmt.Sprint(
+			interval,
+			operator,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.WithdrawRewards(
+		transactorOptions,
+		interval,
+		operator,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"withdrawRewards",
+			interval,
+			operator,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction withdrawRewards with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.WithdrawRewards(
+				transactorOptions,
+				interval,
+				operator,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"withdrawRewards",
+					interval,
+					operator,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction withdrawRewards with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallWithdrawRewards(
+	interval *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"withdrawRewards",
+		&result,
+		interval,
+		operator,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) WithdrawRewardsGasEstimate(
+	interval *big.Int,
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"withdrawRewards",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		interval,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (ecdsar *ECDSARewards) TransferOwnership(
+	newOwner common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	ecdsarLogger.Debug(
+		"submitting transaction transferOwnership",
+		"params: ",
+		fmt.Sprint(
+			newOwner,
+		),
+	)
+
+	ecdsar.transactionMutex.Lock()
+	defer ecdsar.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *ecdsar.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := ecdsar.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := ecdsar.contract.TransferOwnership(
+		transactorOptions,
+		newOwner,
+	)
+	if err != nil {
+		return transaction, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.transactorOptions.From,
+			nil,
+			"transferOwnership",
+			newOwner,
+		)
+	}
+
+	ecdsarLogger.Infof(
+		"submitted transaction transferOwnership with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go ecdsar.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := ecdsar.contract.TransferOwnership(
+				transactorOptions,
+				newOwner,
+			)
+			if err != nil {
+				return transaction, ecdsar.errorResolver.ResolveError(
+					err,
+					ecdsar.transactorOptions.From,
+					nil,
+					"transferOwnership",
+					newOwner,
+				)
+			}
+
+			ecdsarLogger.Infof(
+				"submitted transaction transferOwnership with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	ecdsar.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (ecdsar *ECDSARewards) CallTransferOwnership(
+	newOwner common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		ecdsar.transactorOptions.From,
+		blockNumber, nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"transferOwnership",
+		&result,
+		newOwner,
+	)
+
+	return err
+}
+
+func (ecdsar *ECDSARewards) TransferOwnershipGasEstimate(
+	newOwner common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		ecdsar.callerOptions.From,
+		ecdsar.contractAddress,
+		"transferOwnership",
+		ecdsar.contractABI,
+		ecdsar.transactor,
+		newOwner,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (ecdsar *ECDSARewards) IsOwner() (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.IsOwner(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"isOwner",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IsOwnerAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"isOwner",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) MinimumKeepsPerInterval() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.MinimumKeepsPerInterval(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"minimumKeepsPerInterval",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) MinimumKeepsPerIntervalAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"minimumKeepsPerInterval",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) EligibleForReward(
+	_keep [32]uint8,
+) (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.EligibleForReward(
+		ecdsar.callerOptions,
+		_keep,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"eligibleForReward",
+			_keep,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) EligibleForRewardAtBlock(
+	_keep [32]uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"eligibleForReward",
+		&result,
+		_keep,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IsFinished(
+	interval *big.Int,
+) (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.IsFinished(
+		ecdsar.callerOptions,
+		interval,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"isFinished",
+			interval,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IsFinishedAtBlock(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"isFinished",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) UnallocatedRewards() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.UnallocatedRewards(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"unallocatedRewards",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) UnallocatedRewardsAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"unallocatedRewards",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) DispensedRewards() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.DispensedRewards(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"dispensedRewards",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) DispensedRewardsAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"dispensedRewards",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetAllocatedRewards0(
+	interval *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.GetAllocatedRewards0(
+		ecdsar.callerOptions,
+		interval,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"getAllocatedRewards0",
+			interval,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetAllocatedRewards0AtBlock(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"getAllocatedRewards0",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) RewardClaimed(
+	_keep [32]uint8,
+) (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.RewardClaimed(
+		ecdsar.callerOptions,
+		_keep,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"rewardClaimed",
+			_keep,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) RewardClaimedAtBlock(
+	_keep [32]uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"rewardClaimed",
+		&result,
+		_keep,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) UpgradeFinalizedTimestamp() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.UpgradeFinalizedTimestamp(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"upgradeFinalizedTimestamp",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) UpgradeFinalizedTimestampAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"upgradeFinalizedTimestamp",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetIntervalWeight(
+	interval *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.GetIntervalWeight(
+		ecdsar.callerOptions,
+		interval,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"getIntervalWeight",
+			interval,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetIntervalWeightAtBlock(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"getIntervalWeight",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetWithdrawableRewards(
+	interval *big.Int,
+	operator common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.GetWithdrawableRewards(
+		ecdsar.callerOptions,
+		interval,
+		operator,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"getWithdrawableRewards",
+			interval,
+			operator,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetWithdrawableRewardsAtBlock(
+	interval *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"getWithdrawableRewards",
+		&result,
+		interval,
+		operator,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IntervalOf(
+	timestamp *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.IntervalOf(
+		ecdsar.callerOptions,
+		timestamp,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"intervalOf",
+			timestamp,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IntervalOfAtBlock(
+	timestamp *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"intervalOf",
+		&result,
+		timestamp,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) NewRewardsContract() (common.Address, error) {
+	var result common.Address
+	result, err := ecdsar.contract.NewRewardsContract(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"newRewardsContract",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) NewRewardsContractAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"newRewardsContract",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) UpgradeInitiatedTimestamp() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.UpgradeInitiatedTimestamp(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"upgradeInitiatedTimestamp",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) UpgradeInitiatedTimestampAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"upgradeInitiatedTimestamp",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) EndOf(
+	interval *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.EndOf(
+		ecdsar.callerOptions,
+		interval,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"endOf",
+			interval,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) EndOfAtBlock(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"endOf",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetIntervalCount() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.GetIntervalCount(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"getIntervalCount",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetIntervalCountAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"getIntervalCount",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetWithdrawnRewards(
+	interval *big.Int,
+	operator common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.GetWithdrawnRewards(
+		ecdsar.callerOptions,
+		interval,
+		operator,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"getWithdrawnRewards",
+			interval,
+			operator,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetWithdrawnRewardsAtBlock(
+	interval *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"getWithdrawnRewards",
+		&result,
+		interval,
+		operator,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) FirstIntervalStart() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.FirstIntervalStart(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"firstIntervalStart",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) FirstIntervalStartAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"firstIntervalStart",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) Owner() (common.Address, error) {
+	var result common.Address
+	result, err := ecdsar.contract.Owner(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"owner",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) OwnerAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"owner",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) StartOf(
+	interval *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.StartOf(
+		ecdsar.callerOptions,
+		interval,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"startOf",
+			interval,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) StartOfAtBlock(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"startOf",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) TotalRewards() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.TotalRewards(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"totalRewards",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) TotalRewardsAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"totalRewards",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) BeneficiaryRewardCap() (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.BeneficiaryRewardCap(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"beneficiaryRewardCap",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) BeneficiaryRewardCapAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"beneficiaryRewardCap",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) EligibleButTerminated(
+	_keep [32]uint8,
+) (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.EligibleButTerminated(
+		ecdsar.callerOptions,
+		_keep,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"eligibleButTerminated",
+			_keep,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) EligibleButTerminatedAtBlock(
+	_keep [32]uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"eligibleButTerminated",
+		&result,
+		_keep,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) Token() (common.Address, error) {
+	var result common.Address
+	result, err := ecdsar.contract.Token(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"token",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) TokenAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"token",
+		&result,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetAllocatedRewards(
+	interval *big.Int,
+	operator common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.GetAllocatedRewards(
+		ecdsar.callerOptions,
+		interval,
+		operator,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"getAllocatedRewards",
+			interval,
+			operator,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) GetAllocatedRewardsAtBlock(
+	interval *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"getAllocatedRewards",
+		&result,
+		interval,
+		operator,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IntervalKeepsProcessed(
+	arg0 *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := ecdsar.contract.IntervalKeepsProcessed(
+		ecdsar.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"intervalKeepsProcessed",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IntervalKeepsProcessedAtBlock(
+	arg0 *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"intervalKeepsProcessed",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IsAllocated(
+	interval *big.Int,
+) (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.IsAllocated(
+		ecdsar.callerOptions,
+		interval,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"isAllocated",
+			interval,
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) IsAllocatedAtBlock(
+	interval *big.Int,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"isAllocated",
+		&result,
+		interval,
+	)
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) Funded() (bool, error) {
+	var result bool
+	result, err := ecdsar.contract.Funded(
+		ecdsar.callerOptions,
+	)
+
+	if err != nil {
+		return result, ecdsar.errorResolver.ResolveError(
+			err,
+			ecdsar.callerOptions.From,
+			nil,
+			"funded",
+		)
+	}
+
+	return result, err
+}
+
+func (ecdsar *ECDSARewards) FundedAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		ecdsar.callerOptions.From,
+		blockNumber,
+		nil,
+		ecdsar.contractABI,
+		ecdsar.caller,
+		ecdsar.errorResolver,
+		ecdsar.contractAddress,
+		"funded",
+		&result,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+type eCDSARewardsRewardReceivedFunc func(
+	Keep [32]uint8,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (ecdsar *ECDSARewards) PastRewardReceivedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.ECDSARewardsRewardReceived, error) {
+	iterator, err := ecdsar.contract.FilterRewardReceived(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past RewardReceived events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.ECDSARewardsRewardReceived, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (ecdsar *ECDSARewards) WatchRewardReceived(
+	success eCDSARewardsRewardReceivedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := ecdsar.subscribeRewardReceived(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				ecdsarLogger.Warning(
+					"subscription to event RewardReceived terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (ecdsar *ECDSARewards) subscribeRewardReceived(
+	success eCDSARewardsRewardReceivedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.ECDSARewardsRewardReceived)
+	eventSubscription, err := ecdsar.contract.WatchRewardReceived(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for RewardReceived events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Keep,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type eCDSARewardsUpgradeFinalizedFunc func(
+	AmountTransferred *big.Int,
+	blockNumber uint64,
+)
+
+func (ecdsar *ECDSARewards) PastUpgradeFinalizedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.ECDSARewardsUpgradeFinalized, error) {
+	iterator, err := ecdsar.contract.FilterUpgradeFinalized(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UpgradeFinalized events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.ECDSARewardsUpgradeFinalized, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (ecdsar *ECDSARewards) WatchUpgradeFinalized(
+	success eCDSARewardsUpgradeFinalizedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := ecdsar.subscribeUpgradeFinalized(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				ecdsarLogger.Warning(
+					"subscription to event UpgradeFinalized terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (ecdsar *ECDSARewards) subscribeUpgradeFinalized(
+	success eCDSARewardsUpgradeFinalizedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.ECDSARewardsUpgradeFinalized)
+	eventSubscription, err := ecdsar.contract.WatchUpgradeFinalized(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for UpgradeFinalized events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.AmountTransferred,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type eCDSARewardsUpgradeInitiatedFunc func(
+	NewRewardsContract common.Address,
+	blockNumber uint64,
+)
+
+func (ecdsar *ECDSARewards) PastUpgradeInitiatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.ECDSARewardsUpgradeInitiated, error) {
+	iterator, err := ecdsar.contract.FilterUpgradeInitiated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UpgradeInitiated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.ECDSARewardsUpgradeInitiated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (ecdsar *ECDSARewards) WatchUpgradeInitiated(
+	success eCDSARewardsUpgradeInitiatedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := ecdsar.subscribeUpgradeInitiated(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				ecdsarLogger.Warning(
+					"subscription to event UpgradeInitiated terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (ecdsar *ECDSARewards) subscribeUpgradeInitiated(
+	success eCDSARewardsUpgradeInitiatedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.ECDSARewardsUpgradeInitiated)
+	eventSubscription, err := ecdsar.contract.WatchUpgradeInitiated(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for UpgradeInitiated events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.NewRewardsContract,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type eCDSARewardsOwnershipTransferredFunc func(
+	PreviousOwner common.Address,
+	NewOwner common.Address,
+	blockNumber uint64,
+)
+
+func (ecdsar *ECDSARewards) PastOwnershipTransferredEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	previousOwnerFilter []common.Address,
+	newOwnerFilter []common.Address,
+) ([]*abi.ECDSARewardsOwnershipTransferred, error) {
+	iterator, err := ecdsar.contract.FilterOwnershipTransferred(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		previousOwnerFilter,
+		newOwnerFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OwnershipTransferred events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.ECDSARewardsOwnershipTransferred, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (ecdsar *ECDSARewards) WatchOwnershipTransferred(
+	success eCDSARewardsOwnershipTransferredFunc,
+	fail func(err error) error,
+	previousOwnerFilter []common.Address,
+	newOwnerFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := ecdsar.subscribeOwnershipTransferred(
+			success,
+			failCallback,
+			previousOwnerFilter,
+			newOwnerFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				ecdsarLogger.Warning(
+					"subscription to event OwnershipTransferred terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (ecdsar *ECDSARewards) subscribeOwnershipTransferred(
+	success eCDSARewardsOwnershipTransferredFunc,
+	fail func(err error) error,
+	previousOwnerFilter []common.Address,
+	newOwnerFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.ECDSARewardsOwnershipTransferred)
+	eventSubscription, err := ecdsar.contract.WatchOwnershipTransferred(
+		nil,
+		eventChan,
+		previousOwnerFilter,
+		newOwnerFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for OwnershipTransferred events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.PreviousOwner,
+					event.NewOwner,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
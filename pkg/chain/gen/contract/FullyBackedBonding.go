@@ -0,0 +1,4167 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereumabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var fbbLogger = log.Logger("keep-contract-FullyBackedBonding")
+
+type FullyBackedBonding struct {
+	contract          *abi.FullyBackedBonding
+	contractAddress   common.Address
+	contractABI       *ethereumabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *ethutil.ErrorResolver
+	nonceManager      *ethutil.NonceManager
+	miningWaiter      *ethutil.MiningWaiter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewFullyBackedBonding(
+	contractAddress common.Address,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	transactionMutex *sync.Mutex,
+) (*FullyBackedBonding, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	transactorOptions := bind.NewKeyedTransactor(
+		accountKey.PrivateKey,
+	)
+
+	randomBeaconContract, err := abi.NewFullyBackedBonding(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := ethereumabi.JSON(strings.NewReader(abi.FullyBackedBondingABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &FullyBackedBonding{
+		contract:          randomBeaconContract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     ethutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Withdraw(
+	amount *big.Int,
+	operator common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction withdraw",
+		"params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Withdraw(
+		transactorOptions,
+		amount,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"withdraw",
+			amount,
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction withdraw with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.Withdraw(
+				transactorOptions,
+				amount,
+				operator,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"withdraw",
+					amount,
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction withdraw with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallWithdraw(
+	amount *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"withdraw",
+		&result,
+		amount,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) WithdrawGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"withdraw",
+		fbb.contractABI,
+		fbb.transactor,
+		amount,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) SeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction seizeBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.SeizeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"seizeBond",
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction seizeBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.SeizeBond(
+				transactorOptions,
+				operator,
+				referenceID,
+				amount,
+				destination,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"seizeBond",
+					operator,
+					referenceID,
+					amount,
+					destination,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction seizeBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallSeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"seizeBond",
+		&result,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) SeizeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"seizeBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Deposit(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction deposit",
+		"params: ",
+		fmt.Sprint(
+			operator,
+		),
+		"value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Deposit(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"deposit",
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction deposit with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.Deposit(
+				transactorOptions,
+				operator,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"deposit",
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction deposit with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDeposit(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"deposit",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DepositGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"deposit",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) AuthorizeOperatorContract(
+	_operator common.Address,
+	_operatorContract common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction authorizeOperatorContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_operatorContract,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.AuthorizeOperatorContract(
+		transactorOptions,
+		_operator,
+		_operatorContract,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"authorizeOperatorContract",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction authorizeOperatorContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.AuthorizeOperatorContract(
+				transactorOptions,
+				_operator,
+				_operatorContract,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"authorizeOperatorContract",
+					_operator,
+					_operatorContract,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction authorizeOperatorContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallAuthorizeOperatorContract(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizeOperatorContract",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) AuthorizeOperatorContractGasEstimate(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"authorizeOperatorContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) DeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction deauthorizeSortitionPoolContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.DeauthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"deauthorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction deauthorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.DeauthorizeSortitionPoolContract(
+				transactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"deauthorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction deauthorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DeauthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) FreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction freeBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.FreeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"freeBond",
+			operator,
+			referenceID,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction freeBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.FreeBond(
+				transactorOptions,
+				operator,
+				referenceID,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"freeBond",
+					operator,
+					referenceID,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction freeBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallFreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"freeBond",
+		&result,
+		operator,
+		referenceID,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) FreeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"freeBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Delegate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction delegate",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			beneficiary,
+			authorizer,
+		),
+		"value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Delegate(
+		transactorOptions,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"delegate",
+			operator,
+			beneficiary,
+			authorizer,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction delegate with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.Delegate(
+				transactorOptions,
+				operator,
+				beneficiary,
+				authorizer,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"delegate",
+					operator,
+					beneficiary,
+					authorizer,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction delegate with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDelegate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"delegate",
+		&result,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DelegateGasEstimate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"delegate",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) ClaimDelegatedAuthority(
+	delegatedAuthoritySource common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction claimDelegatedAuthority",
+		"params: ",
+		fmt.Sprint(
+			delegatedAuthoritySource,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.ClaimDelegatedAuthority(
+		transactorOptions,
+		delegatedAuthoritySource,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"claimDelegatedAuthority",
+			delegatedAuthoritySource,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction claimDelegatedAuthority with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.ClaimDelegatedAuthority(
+				transactorOptions,
+				delegatedAuthoritySource,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"claimDelegatedAuthority",
+					delegatedAuthoritySource,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction claimDelegatedAuthority with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallClaimDelegatedAuthority(
+	delegatedAuthoritySource common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"claimDelegatedAuthority",
+		&result,
+		delegatedAuthoritySource,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) ClaimDelegatedAuthorityGasEstimate(
+	delegatedAuthoritySource common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"claimDelegatedAuthority",
+		fbb.contractABI,
+		fbb.transactor,
+		delegatedAuthoritySource,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) ReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction reassignBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.ReassignBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"reassignBond",
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction reassignBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.ReassignBond(
+				transactorOptions,
+				operator,
+				referenceID,
+				newHolder,
+				newReferenceID,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"reassignBond",
+					operator,
+					referenceID,
+					newHolder,
+					newReferenceID,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction reassignBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"reassignBond",
+		&result,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) ReassignBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"reassignBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) IsRecognized(
+	delegatedAuthorityRecipient common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction isRecognized",
+		"params: ",
+		fmt.Sprint(
+			delegatedAuthorityRecipient,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.IsRecognized(
+		transactorOptions,
+		delegatedAuthorityRecipient,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"isRecognized",
+			delegatedAuthorityRecipient,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction isRecognized with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.IsRecognized(
+				transactorOptions,
+				delegatedAuthorityRecipient,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"isRecognized",
+					delegatedAuthorityRecipient,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction isRecognized with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallIsRecognized(
+	delegatedAuthorityRecipient common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isRecognized",
+		&result,
+		delegatedAuthorityRecipient,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsRecognizedGasEstimate(
+	delegatedAuthorityRecipient common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"isRecognized",
+		fbb.contractABI,
+		fbb.transactor,
+		delegatedAuthorityRecipient,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) TopUp(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction topUp",
+		"params: ",
+		fmt.Sprint(
+			operator,
+		),
+		"value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.TopUp(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"topUp",
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction topUp with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.TopUp(
+				transactorOptions,
+				operator,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"topUp",
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction topUp with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallTopUp(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"topUp",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) TopUpGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"topUp",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) CreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction createBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.CreateBond(
+		transactorOptions,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"createBond",
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction createBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.CreateBond(
+				transactorOptions,
+				operator,
+				holder,
+				referenceID,
+				amount,
+				authorizedSortitionPool,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"createBond",
+					operator,
+					holder,
+					referenceID,
+					amount,
+					authorizedSortitionPool,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction createBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallCreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"createBond",
+		&result,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) CreateBondGasEstimate(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"createBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) AuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction authorizeSortitionPoolContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.AuthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"authorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction authorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbb.contract.AuthorizeSortitionPoolContract(
+				transactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return transaction, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"authorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction authorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallAuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) AuthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"authorizeSortitionPoolContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (fbb *FullyBackedBonding) BondAmount(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.BondAmount(
+		fbb.callerOptions,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"bondAmount",
+			operator,
+			holder,
+			referenceID,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BondAmountAtBlock(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"bondAmount",
+		&result,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsApprovedOperatorContract(
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsApprovedOperatorContract(
+		fbb.callerOptions,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isApprovedOperatorContract",
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsApprovedOperatorContractAtBlock(
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isApprovedOperatorContract",
+		&result,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AvailableUnbondedValue(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.AvailableUnbondedValue(
+		fbb.callerOptions,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"availableUnbondedValue",
+			operator,
+			bondCreator,
+			authorizedSortitionPool,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AvailableUnbondedValueAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"availableUnbondedValue",
+		&result,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsInitialized(
+	operator common.Address,
+	bondCreator common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsInitialized(
+		fbb.callerOptions,
+		operator,
+		bondCreator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isInitialized",
+			operator,
+			bondCreator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsInitializedAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isInitialized",
+		&result,
+		operator,
+		bondCreator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) MINIMUMDELEGATIONDEPOSIT() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.MINIMUMDELEGATIONDEPOSIT(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"mINIMUMDELEGATIONDEPOSIT",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) MINIMUMDELEGATIONDEPOSITAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"mINIMUMDELEGATIONDEPOSIT",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) InitializationPeriod() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.InitializationPeriod(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"initializationPeriod",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) InitializationPeriodAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"initializationPeriod",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsAuthorizedForOperator(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsAuthorizedForOperator(
+		fbb.callerOptions,
+		_operator,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isAuthorizedForOperator",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsAuthorizedForOperatorAtBlock(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isAuthorizedForOperator",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) DELEGATIONLOCKPERIOD() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.DELEGATIONLOCKPERIOD(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"dELEGATIONLOCKPERIOD",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) DELEGATIONLOCKPERIODAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"dELEGATIONLOCKPERIOD",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BalanceOf(
+	_address common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.BalanceOf(
+		fbb.callerOptions,
+		_address,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"balanceOf",
+			_address,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BalanceOfAtBlock(
+	_address common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"balanceOf",
+		&result,
+		_address,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetAuthoritySource(
+	operatorContract common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.GetAuthoritySource(
+		fbb.callerOptions,
+		operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"getAuthoritySource",
+			operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetAuthoritySourceAtBlock(
+	operatorContract common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"getAuthoritySource",
+		&result,
+		operatorContract,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AuthorizerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.AuthorizerOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"authorizerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AuthorizerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) HasSecondaryAuthorization(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.HasSecondaryAuthorization(
+		fbb.callerOptions,
+		_operator,
+		_poolAddress,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"hasSecondaryAuthorization",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) HasSecondaryAuthorizationAtBlock(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"hasSecondaryAuthorization",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BeneficiaryOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.BeneficiaryOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"beneficiaryOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BeneficiaryOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"beneficiaryOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+type DelegationInfo struct {
+	CreatedAt     *big.Int
+	UndelegatedAt *big.Int
+}
+
+func (fbb *FullyBackedBonding) GetDelegationInfo(
+	operator common.Address,
+) (DelegationInfo, error) {
+	var result DelegationInfo
+	result, err := fbb.contract.GetDelegationInfo(
+		fbb.callerOptions,
+		operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"getDelegationInfo",
+			operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetDelegationInfoAtBlock(
+	operator common.Address,
+	blockNumber *big.Int,
+) (DelegationInfo, error) {
+	var result DelegationInfo
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"getDelegationInfo",
+		&result,
+		operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) OwnerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.OwnerOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"ownerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) OwnerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"ownerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) UnbondedValue(
+	arg0 common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.UnbondedValue(
+		fbb.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"unbondedValue",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueAtBlock(
+	arg0 common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"unbondedValue",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+type fullyBackedBondingBondReassignedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	NewHolder common.Address,
+	NewReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastBondReassignedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondReassigned, error) {
+	iterator, err := fbb.contract.FilterBondReassigned(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondReassigned, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchBondReassigned(
+	success fullyBackedBondingBondReassignedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeBondReassigned(
+			success,
+			failCallback,
+			operatorFilter,
+			referenceIDFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event BondReassigned terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeBondReassigned(
+	success fullyBackedBondingBondReassignedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingBondReassigned)
+	eventSubscription, err := fbb.contract.WatchBondReassigned(
+		nil,
+		eventChan,
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.ReferenceID,
+					event.NewHolder,
+					event.NewReferenceID,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingBondReleasedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastBondReleasedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondReleased, error) {
+	iterator, err := fbb.contract.FilterBondReleased(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondReleased, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchBondReleased(
+	success fullyBackedBondingBondReleasedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeBondReleased(
+			success,
+			failCallback,
+			operatorFilter,
+			referenceIDFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event BondReleased terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeBondReleased(
+	success fullyBackedBondingBondReleasedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingBondReleased)
+	eventSubscription, err := fbb.contract.WatchBondReleased(
+		nil,
+		eventChan,
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.ReferenceID,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingDelegatedFunc func(
+	Owner common.Address,
+	Operator common.Address,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastDelegatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) ([]*abi.FullyBackedBondingDelegated, error) {
+	iterator, err := fbb.contract.FilterDelegated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		ownerFilter,
+		operatorFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past Delegated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingDelegated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchDelegated(
+	success fullyBackedBondingDelegatedFunc,
+	fail func(err error) error,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeDelegated(
+			success,
+			failCallback,
+			ownerFilter,
+			operatorFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event Delegated terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeDelegated(
+	success fullyBackedBondingDelegatedFunc,
+	fail func(err error) error,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingDelegated)
+	eventSubscription, err := fbb.contract.WatchDelegated(
+		nil,
+		eventChan,
+		ownerFilter,
+		operatorFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for Delegated events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Owner,
+					event.Operator,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingOperatorDelegatedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Authorizer common.Address,
+	Value *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastOperatorDelegatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) ([]*abi.FullyBackedBondingOperatorDelegated, error) {
+	iterator, err := fbb.contract.FilterOperatorDelegated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+		authorizerFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorDelegated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingOperatorDelegated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchOperatorDelegated(
+	success fullyBackedBondingOperatorDelegatedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeOperatorDelegated(
+			success,
+			failCallback,
+			operatorFilter,
+			beneficiaryFilter,
+			authorizerFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event OperatorDelegated terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeOperatorDelegated(
+	success fullyBackedBondingOperatorDelegatedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingOperatorDelegated)
+	eventSubscription, err := fbb.contract.WatchOperatorDelegated(
+		nil,
+		eventChan,
+		operatorFilter,
+		beneficiaryFilter,
+		authorizerFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for OperatorDelegated events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Beneficiary,
+					event.Authorizer,
+					event.Value,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingOperatorToppedUpFunc func(
+	Operator common.Address,
+	Value *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastOperatorToppedUpEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+) ([]*abi.FullyBackedBondingOperatorToppedUp, error) {
+	iterator, err := fbb.contract.FilterOperatorToppedUp(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorToppedUp events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingOperatorToppedUp, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchOperatorToppedUp(
+	success fullyBackedBondingOperatorToppedUpFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeOperatorToppedUp(
+			success,
+			failCallback,
+			operatorFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event OperatorToppedUp terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeOperatorToppedUp(
+	success fullyBackedBondingOperatorToppedUpFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingOperatorToppedUp)
+	eventSubscription, err := fbb.contract.WatchOperatorToppedUp(
+		nil,
+		eventChan,
+		operatorFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for OperatorToppedUp events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Value,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingUnbondedValueWithdrawnFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastUnbondedValueWithdrawnEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.FullyBackedBondingUnbondedValueWithdrawn, error) {
+	iterator, err := fbb.contract.FilterUnbondedValueWithdrawn(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingUnbondedValueWithdrawn, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchUnbondedValueWithdrawn(
+	success fullyBackedBondingUnbondedValueWithdrawnFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeUnbondedValueWithdrawn(
+			success,
+			failCallback,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event UnbondedValueWithdrawn terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeUnbondedValueWithdrawn(
+	success fullyBackedBondingUnbondedValueWithdrawnFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingUnbondedValueWithdrawn)
+	eventSubscription, err := fbb.contract.WatchUnbondedValueWithdrawn(
+		nil,
+		eventChan,
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingBondCreatedFunc func(
+	Operator common.Address,
+	Holder common.Address,
+	SortitionPool common.Address,
+	ReferenceID *big.Int,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastBondCreatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) ([]*abi.FullyBackedBondingBondCreated, error) {
+	iterator, err := fbb.contract.FilterBondCreated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondCreated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchBondCreated(
+	success fullyBackedBondingBondCreatedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeBondCreated(
+			success,
+			failCallback,
+			operatorFilter,
+			holderFilter,
+			sortitionPoolFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event BondCreated terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeBondCreated(
+	success fullyBackedBondingBondCreatedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingBondCreated)
+	eventSubscription, err := fbb.contract.WatchBondCreated(
+		nil,
+		eventChan,
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Holder,
+					event.SortitionPool,
+					event.ReferenceID,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingBondSeizedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	Destination common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastBondSeizedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondSeized, error) {
+	iterator, err := fbb.contract.FilterBondSeized(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondSeized, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchBondSeized(
+	success fullyBackedBondingBondSeizedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeBondSeized(
+			success,
+			failCallback,
+			operatorFilter,
+			referenceIDFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event BondSeized terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeBondSeized(
+	success fullyBackedBondingBondSeizedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingBondSeized)
+	eventSubscription, err := fbb.contract.WatchBondSeized(
+		nil,
+		eventChan,
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.ReferenceID,
+					event.Destination,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedBondingUnbondedValueDepositedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (fbb *FullyBackedBonding) PastUnbondedValueDepositedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.FullyBackedBondingUnbondedValueDeposited, error) {
+	iterator, err := fbb.contract.FilterUnbondedValueDeposited(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingUnbondedValueDeposited, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) WatchUnbondedValueDeposited(
+	success fullyBackedBondingUnbondedValueDepositedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbb.subscribeUnbondedValueDeposited(
+			success,
+			failCallback,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbbLogger.Warning(
+					"subscription to event UnbondedValueDeposited terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbb *FullyBackedBonding) subscribeUnbondedValueDeposited(
+	success fullyBackedBondingUnbondedValueDepositedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedBondingUnbondedValueDeposited)
+	eventSubscription, err := fbb.contract.WatchUnbondedValueDeposited(
+		nil,
+		eventChan,
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
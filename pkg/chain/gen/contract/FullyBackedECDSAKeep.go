@@ -0,0 +1,3486 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereumabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var fbecdsakLogger = log.Logger("keep-contract-FullyBackedECDSAKeep")
+
+type FullyBackedECDSAKeep struct {
+	contract          *abi.FullyBackedECDSAKeep
+	contractAddress   common.Address
+	contractABI       *ethereumabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *ethutil.ErrorResolver
+	nonceManager      *ethutil.NonceManager
+	miningWaiter      *ethutil.MiningWaiter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewFullyBackedECDSAKeep(
+	contractAddress common.Address,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	transactionMutex *sync.Mutex,
+) (*FullyBackedECDSAKeep, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	transactorOptions := bind.NewKeyedTransactor(
+		accountKey.PrivateKey,
+	)
+
+	randomBeaconContract, err := abi.NewFullyBackedECDSAKeep(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := ethereumabi.JSON(strings.NewReader(abi.FullyBackedECDSAKeepABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &FullyBackedECDSAKeep{
+		contract:          randomBeaconContract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     ethutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) ReturnPartialSignerBonds(
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction returnPartialSignerBonds",
+		"value: ", value,
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.ReturnPartialSignerBonds(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			value,
+			"returnPartialSignerBonds",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction returnPartialSignerBonds with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.ReturnPartialSignerBonds(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					value,
+					"returnPartialSignerBonds",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction returnPartialSignerBonds with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallReturnPartialSignerBonds(
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, value,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"returnPartialSignerBonds",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) ReturnPartialSignerBondsGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"returnPartialSignerBonds",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignature(
+	_r [32]uint8,
+	_s [32]uint8,
+	_recoveryID uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction submitSignature",
+		"params: ",
+		fmt.Sprint(
+			_r,
+			_s,
+			_recoveryID,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SubmitSignature(
+		transactorOptions,
+		_r,
+		_s,
+		_recoveryID,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"submitSignature",
+			_r,
+			_s,
+			_recoveryID,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction submitSignature with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.SubmitSignature(
+				transactorOptions,
+				_r,
+				_s,
+				_recoveryID,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"submitSignature",
+					_r,
+					_s,
+					_recoveryID,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction submitSignature with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSubmitSignature(
+	_r [32]uint8,
+	_s [32]uint8,
+	_recoveryID uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"submitSignature",
+		&result,
+		_r,
+		_s,
+		_recoveryID,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignatureGasEstimate(
+	_r [32]uint8,
+	_s [32]uint8,
+	_recoveryID uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"submitSignature",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_r,
+		_s,
+		_recoveryID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) Withdraw(
+	_member common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction withdraw",
+		"params: ",
+		fmt.Sprint(
+			_member,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.Withdraw(
+		transactorOptions,
+		_member,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"withdraw",
+			_member,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction withdraw with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.Withdraw(
+				transactorOptions,
+				_member,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"withdraw",
+					_member,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction withdraw with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallWithdraw(
+	_member common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"withdraw",
+		&result,
+		_member,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WithdrawGasEstimate(
+	_member common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"withdraw",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_member,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SeizeSignerBonds(
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction seizeSignerBonds",
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SeizeSignerBonds(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"seizeSignerBonds",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction seizeSignerBonds with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.SeizeSignerBonds(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"seizeSignerBonds",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction seizeSignerBonds with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSeizeSignerBonds(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"seizeSignerBonds",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SeizeSignerBondsGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"seizeSignerBonds",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) Sign(
+	_digest [32]uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction sign",
+		"params: ",
+		fmt.Sprint(
+			_digest,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.Sign(
+		transactorOptions,
+		_digest,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"sign",
+			_digest,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction sign with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.Sign(
+				transactorOptions,
+				_digest,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"sign",
+					_digest,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction sign with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSign(
+	_digest [32]uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"sign",
+		&result,
+		_digest,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SignGasEstimate(
+	_digest [32]uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"sign",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_digest,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SubmitPublicKey(
+	_publicKey []uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction submitPublicKey",
+		"params: ",
+		fmt.Sprint(
+			_publicKey,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SubmitPublicKey(
+		transactorOptions,
+		_publicKey,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"submitPublicKey",
+			_publicKey,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction submitPublicKey with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.SubmitPublicKey(
+				transactorOptions,
+				_publicKey,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"submitPublicKey",
+					_publicKey,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction submitPublicKey with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSubmitPublicKey(
+	_publicKey []uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"submitPublicKey",
+		&result,
+		_publicKey,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SubmitPublicKeyGasEstimate(
+	_publicKey []uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"submitPublicKey",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_publicKey,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignatureFraud(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction submitSignatureFraud",
+		"params: ",
+		fmt.Sprint(
+			_v,
+			_r,
+			_s,
+			_signedDigest,
+			_preimage,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SubmitSignatureFraud(
+		transactorOptions,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"submitSignatureFraud",
+			_v,
+			_r,
+			_s,
+			_signedDigest,
+			_preimage,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction submitSignatureFraud with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.SubmitSignatureFraud(
+				transactorOptions,
+				_v,
+				_r,
+				_s,
+				_signedDigest,
+				_preimage,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"submitSignatureFraud",
+					_v,
+					_r,
+					_s,
+					_signedDigest,
+					_preimage,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction submitSignatureFraud with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSubmitSignatureFraud(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"submitSignatureFraud",
+		&result,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignatureFraudGasEstimate(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"submitSignatureFraud",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CloseKeep(
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction closeKeep",
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.CloseKeep(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"closeKeep",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction closeKeep with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.CloseKeep(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"closeKeep",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction closeKeep with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallCloseKeep(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"closeKeep",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CloseKeepGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"closeKeep",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) DistributeERC20Reward(
+	_tokenAddress common.Address,
+	_value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction distributeERC20Reward",
+		"params: ",
+		fmt.Sprint(
+			_tokenAddress,
+			_value,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.DistributeERC20Reward(
+		transactorOptions,
+		_tokenAddress,
+		_value,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"distributeERC20Reward",
+			_tokenAddress,
+			_value,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction distributeERC20Reward with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.DistributeERC20Reward(
+				transactorOptions,
+				_tokenAddress,
+				_value,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"distributeERC20Reward",
+					_tokenAddress,
+					_value,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction distributeERC20Reward with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallDistributeERC20Reward(
+	_tokenAddress common.Address,
+	_value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"distributeERC20Reward",
+		&result,
+		_tokenAddress,
+		_value,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DistributeERC20RewardGasEstimate(
+	_tokenAddress common.Address,
+	_value *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"distributeERC20Reward",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_tokenAddress,
+		_value,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) DistributeETHReward(
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction distributeETHReward",
+		"value: ", value,
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.DistributeETHReward(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			value,
+			"distributeETHReward",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction distributeETHReward with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.DistributeETHReward(
+				transactorOptions,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					value,
+					"distributeETHReward",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction distributeETHReward with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallDistributeETHReward(
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, value,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"distributeETHReward",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DistributeETHRewardGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"distributeETHReward",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) Initialize(
+	_owner common.Address,
+	_members []common.Address,
+	_honestThreshold *big.Int,
+	_bonding common.Address,
+	_keepFactory common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction initialize",
+		"params: ",
+		fmt.Sprint(
+			_owner,
+			_members,
+			_honestThreshold,
+			_bonding,
+			_keepFactory,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.Initialize(
+		transactorOptions,
+		_owner,
+		_members,
+		_honestThreshold,
+		_bonding,
+		_keepFactory,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"initialize",
+			_owner,
+			_members,
+			_honestThreshold,
+			_bonding,
+			_keepFactory,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction initialize with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := fbecdsak.contract.Initialize(
+				transactorOptions,
+				_owner,
+				_members,
+				_honestThreshold,
+				_bonding,
+				_keepFactory,
+			)
+			if err != nil {
+				return transaction, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"initialize",
+					_owner,
+					_members,
+					_honestThreshold,
+					_bonding,
+					_keepFactory,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction initialize with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallInitialize(
+	_owner common.Address,
+	_members []common.Address,
+	_honestThreshold *big.Int,
+	_bonding common.Address,
+	_keepFactory common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"initialize",
+		&result,
+		_owner,
+		_members,
+		_honestThreshold,
+		_bonding,
+		_keepFactory,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) InitializeGasEstimate(
+	_owner common.Address,
+	_members []common.Address,
+	_honestThreshold *big.Int,
+	_bonding common.Address,
+	_keepFactory common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"initialize",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_owner,
+		_members,
+		_honestThreshold,
+		_bonding,
+		_keepFactory,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (fbecdsak *FullyBackedECDSAKeep) HonestThreshold() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.HonestThreshold(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"honestThreshold",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) HonestThresholdAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"honestThreshold",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMembers() ([]common.Address, error) {
+	var result []common.Address
+	result, err := fbecdsak.contract.GetMembers(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getMembers",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMembersAtBlock(
+	blockNumber *big.Int,
+) ([]common.Address, error) {
+	var result []common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getMembers",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOpenedTimestamp() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.GetOpenedTimestamp(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getOpenedTimestamp",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOpenedTimestampAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getOpenedTimestamp",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsTerminated() (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsTerminated(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isTerminated",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsTerminatedAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isTerminated",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PublicKey() ([]uint8, error) {
+	var result []uint8
+	result, err := fbecdsak.contract.PublicKey(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"publicKey",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PublicKeyAtBlock(
+	blockNumber *big.Int,
+) ([]uint8, error) {
+	var result []uint8
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"publicKey",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckSignatureFraud(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.CheckSignatureFraud(
+		fbecdsak.callerOptions,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"checkSignatureFraud",
+			_v,
+			_r,
+			_s,
+			_signedDigest,
+			_preimage,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckSignatureFraudAtBlock(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"checkSignatureFraud",
+		&result,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Digest() ([32]uint8, error) {
+	var result [32]uint8
+	result, err := fbecdsak.contract.Digest(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"digest",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DigestAtBlock(
+	blockNumber *big.Int,
+) ([32]uint8, error) {
+	var result [32]uint8
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"digest",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Digests(
+	arg0 [32]uint8,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.Digests(
+		fbecdsak.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"digests",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DigestsAtBlock(
+	arg0 [32]uint8,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"digests",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMemberETHBalance(
+	_member common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.GetMemberETHBalance(
+		fbecdsak.callerOptions,
+		_member,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getMemberETHBalance",
+			_member,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMemberETHBalanceAtBlock(
+	_member common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getMemberETHBalance",
+		&result,
+		_member,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOwner() (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsak.contract.GetOwner(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getOwner",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOwnerAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getOwner",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Owner() (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsak.contract.Owner(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"owner",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) OwnerAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"owner",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckBondAmount() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.CheckBondAmount(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"checkBondAmount",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckBondAmountAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"checkBondAmount",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetPublicKey() ([]uint8, error) {
+	var result []uint8
+	result, err := fbecdsak.contract.GetPublicKey(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getPublicKey",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetPublicKeyAtBlock(
+	blockNumber *big.Int,
+) ([]uint8, error) {
+	var result []uint8
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getPublicKey",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsActive() (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsActive(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isActive",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsActiveAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isActive",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsAwaitingSignature(
+	_digest [32]uint8,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsAwaitingSignature(
+		fbecdsak.callerOptions,
+		_digest,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isAwaitingSignature",
+			_digest,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsAwaitingSignatureAtBlock(
+	_digest [32]uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isAwaitingSignature",
+		&result,
+		_digest,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsClosed() (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsClosed(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isClosed",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsClosedAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isClosed",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Members(
+	arg0 *big.Int,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsak.contract.Members(
+		fbecdsak.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"members",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) MembersAtBlock(
+	arg0 *big.Int,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"members",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+type fullyBackedECDSAKeepPublicKeyPublishedFunc func(
+	PublicKey []uint8,
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastPublicKeyPublishedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepPublicKeyPublished, error) {
+	iterator, err := fbecdsak.contract.FilterPublicKeyPublished(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past PublicKeyPublished events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepPublicKeyPublished, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchPublicKeyPublished(
+	success fullyBackedECDSAKeepPublicKeyPublishedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribePublicKeyPublished(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event PublicKeyPublished terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribePublicKeyPublished(
+	success fullyBackedECDSAKeepPublicKeyPublishedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepPublicKeyPublished)
+	eventSubscription, err := fbecdsak.contract.WatchPublicKeyPublished(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for PublicKeyPublished events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.PublicKey,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepSignatureRequestedFunc func(
+	Digest [32]uint8,
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastSignatureRequestedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	digestFilter [][32]uint8,
+) ([]*abi.FullyBackedECDSAKeepSignatureRequested, error) {
+	iterator, err := fbecdsak.contract.FilterSignatureRequested(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		digestFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past SignatureRequested events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepSignatureRequested, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchSignatureRequested(
+	success fullyBackedECDSAKeepSignatureRequestedFunc,
+	fail func(err error) error,
+	digestFilter [][32]uint8,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeSignatureRequested(
+			success,
+			failCallback,
+			digestFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event SignatureRequested terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeSignatureRequested(
+	success fullyBackedECDSAKeepSignatureRequestedFunc,
+	fail func(err error) error,
+	digestFilter [][32]uint8,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepSignatureRequested)
+	eventSubscription, err := fbecdsak.contract.WatchSignatureRequested(
+		nil,
+		eventChan,
+		digestFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for SignatureRequested events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Digest,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepSignatureSubmittedFunc func(
+	Digest [32]uint8,
+	R [32]uint8,
+	S [32]uint8,
+	RecoveryID uint8,
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastSignatureSubmittedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	digestFilter [][32]uint8,
+) ([]*abi.FullyBackedECDSAKeepSignatureSubmitted, error) {
+	iterator, err := fbecdsak.contract.FilterSignatureSubmitted(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		digestFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past SignatureSubmitted events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepSignatureSubmitted, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchSignatureSubmitted(
+	success fullyBackedECDSAKeepSignatureSubmittedFunc,
+	fail func(err error) error,
+	digestFilter [][32]uint8,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeSignatureSubmitted(
+			success,
+			failCallback,
+			digestFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event SignatureSubmitted terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeSignatureSubmitted(
+	success fullyBackedECDSAKeepSignatureSubmittedFunc,
+	fail func(err error) error,
+	digestFilter [][32]uint8,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepSignatureSubmitted)
+	eventSubscription, err := fbecdsak.contract.WatchSignatureSubmitted(
+		nil,
+		eventChan,
+		digestFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for SignatureSubmitted events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Digest,
+					event.R,
+					event.S,
+					event.RecoveryID,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepConflictingPublicKeySubmittedFunc func(
+	SubmittingMember common.Address,
+	ConflictingPublicKey []uint8,
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastConflictingPublicKeySubmittedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	submittingMemberFilter []common.Address,
+) ([]*abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted, error) {
+	iterator, err := fbecdsak.contract.FilterConflictingPublicKeySubmitted(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		submittingMemberFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past ConflictingPublicKeySubmitted events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchConflictingPublicKeySubmitted(
+	success fullyBackedECDSAKeepConflictingPublicKeySubmittedFunc,
+	fail func(err error) error,
+	submittingMemberFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeConflictingPublicKeySubmitted(
+			success,
+			failCallback,
+			submittingMemberFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event ConflictingPublicKeySubmitted terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeConflictingPublicKeySubmitted(
+	success fullyBackedECDSAKeepConflictingPublicKeySubmittedFunc,
+	fail func(err error) error,
+	submittingMemberFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted)
+	eventSubscription, err := fbecdsak.contract.WatchConflictingPublicKeySubmitted(
+		nil,
+		eventChan,
+		submittingMemberFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for ConflictingPublicKeySubmitted events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.SubmittingMember,
+					event.ConflictingPublicKey,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepERC20RewardDistributedFunc func(
+	Token common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastERC20RewardDistributedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	tokenFilter []common.Address,
+) ([]*abi.FullyBackedECDSAKeepERC20RewardDistributed, error) {
+	iterator, err := fbecdsak.contract.FilterERC20RewardDistributed(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		tokenFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past ERC20RewardDistributed events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepERC20RewardDistributed, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchERC20RewardDistributed(
+	success fullyBackedECDSAKeepERC20RewardDistributedFunc,
+	fail func(err error) error,
+	tokenFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeERC20RewardDistributed(
+			success,
+			failCallback,
+			tokenFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event ERC20RewardDistributed terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeERC20RewardDistributed(
+	success fullyBackedECDSAKeepERC20RewardDistributedFunc,
+	fail func(err error) error,
+	tokenFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepERC20RewardDistributed)
+	eventSubscription, err := fbecdsak.contract.WatchERC20RewardDistributed(
+		nil,
+		eventChan,
+		tokenFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for ERC20RewardDistributed events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Token,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepETHRewardDistributedFunc func(
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastETHRewardDistributedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepETHRewardDistributed, error) {
+	iterator, err := fbecdsak.contract.FilterETHRewardDistributed(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past ETHRewardDistributed events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepETHRewardDistributed, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchETHRewardDistributed(
+	success fullyBackedECDSAKeepETHRewardDistributedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeETHRewardDistributed(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event ETHRewardDistributed terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeETHRewardDistributed(
+	success fullyBackedECDSAKeepETHRewardDistributedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepETHRewardDistributed)
+	eventSubscription, err := fbecdsak.contract.WatchETHRewardDistributed(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for ETHRewardDistributed events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepKeepClosedFunc func(
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastKeepClosedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepKeepClosed, error) {
+	iterator, err := fbecdsak.contract.FilterKeepClosed(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past KeepClosed events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepKeepClosed, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchKeepClosed(
+	success fullyBackedECDSAKeepKeepClosedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeKeepClosed(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event KeepClosed terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeKeepClosed(
+	success fullyBackedECDSAKeepKeepClosedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepKeepClosed)
+	eventSubscription, err := fbecdsak.contract.WatchKeepClosed(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for KeepClosed events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type fullyBackedECDSAKeepKeepTerminatedFunc func(
+	blockNumber uint64,
+)
+
+func (fbecdsak *FullyBackedECDSAKeep) PastKeepTerminatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepKeepTerminated, error) {
+	iterator, err := fbecdsak.contract.FilterKeepTerminated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past KeepTerminated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepKeepTerminated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WatchKeepTerminated(
+	success fullyBackedECDSAKeepKeepTerminatedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := fbecdsak.subscribeKeepTerminated(
+			success,
+			failCallback,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				fbecdsakLogger.Warning(
+					"subscription to event KeepTerminated terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) subscribeKeepTerminated(
+	success fullyBackedECDSAKeepKeepTerminatedFunc,
+	fail func(err error) error,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepKeepTerminated)
+	eventSubscription, err := fbecdsak.contract.WatchKeepTerminated(
+		nil,
+		eventChan,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for KeepTerminated events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
@@ -0,0 +1,2755 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereumabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var kbLogger = log.Logger("keep-contract-KeepBonding")
+
+type KeepBonding struct {
+	contract          *abi.KeepBonding
+	contractAddress   common.Address
+	contractABI       *ethereumabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *ethutil.ErrorResolver
+	nonceManager      *ethutil.NonceManager
+	miningWaiter      *ethutil.MiningWaiter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewKeepBonding(
+	contractAddress common.Address,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethutil.NonceManager,
+	miningWaiter *ethutil.MiningWaiter,
+	transactionMutex *sync.Mutex,
+) (*KeepBonding, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	transactorOptions := bind.NewKeyedTransactor(
+		accountKey.PrivateKey,
+	)
+
+	randomBeaconContract, err := abi.NewKeepBonding(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := ethereumabi.JSON(strings.NewReader(abi.KeepBondingABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &KeepBonding{
+		contract:          randomBeaconContract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     ethutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (kb *KeepBonding) CreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction createBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.CreateBond(
+		transactorOptions,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"createBond",
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction createBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.CreateBond(
+				transactorOptions,
+				operator,
+				holder,
+				referenceID,
+				amount,
+				authorizedSortitionPool,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"createBond",
+					operator,
+					holder,
+					referenceID,
+					amount,
+					authorizedSortitionPool,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction createBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallCreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"createBond",
+		&result,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) CreateBondGasEstimate(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"createBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) Deposit(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction deposit",
+		"params: ",
+		fmt.Sprint(
+			operator,
+		),
+		"value: ", value,
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.Deposit(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			value,
+			"deposit",
+			operator,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction deposit with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.Deposit(
+				transactorOptions,
+				operator,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					value,
+					"deposit",
+					operator,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction deposit with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallDeposit(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, value,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"deposit",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) DepositGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"deposit",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) FreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction freeBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.FreeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"freeBond",
+			operator,
+			referenceID,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction freeBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.FreeBond(
+				transactorOptions,
+				operator,
+				referenceID,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"freeBond",
+					operator,
+					referenceID,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction freeBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallFreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"freeBond",
+		&result,
+		operator,
+		referenceID,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) FreeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"freeBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		referenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) ReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction reassignBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.ReassignBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"reassignBond",
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction reassignBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.ReassignBond(
+				transactorOptions,
+				operator,
+				referenceID,
+				newHolder,
+				newReferenceID,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"reassignBond",
+					operator,
+					referenceID,
+					newHolder,
+					newReferenceID,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction reassignBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"reassignBond",
+		&result,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) ReassignBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"reassignBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) SeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction seizeBond",
+		"params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.SeizeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"seizeBond",
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction seizeBond with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.SeizeBond(
+				transactorOptions,
+				operator,
+				referenceID,
+				amount,
+				destination,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"seizeBond",
+					operator,
+					referenceID,
+					amount,
+					destination,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction seizeBond with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallSeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"seizeBond",
+		&result,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) SeizeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"seizeBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) WithdrawAsManagedGrantee(
+	amount *big.Int,
+	operator common.Address,
+	managedGrant common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction withdrawAsManagedGrantee",
+		"params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+			managedGrant,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.WithdrawAsManagedGrantee(
+		transactorOptions,
+		amount,
+		operator,
+		managedGrant,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"withdrawAsManagedGrantee",
+			amount,
+			operator,
+			managedGrant,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction withdrawAsManagedGrantee with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.WithdrawAsManagedGrantee(
+				transactorOptions,
+				amount,
+				operator,
+				managedGrant,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"withdrawAsManagedGrantee",
+					amount,
+					operator,
+					managedGrant,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction withdrawAsManagedGrantee with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallWithdrawAsManagedGrantee(
+	amount *big.Int,
+	operator common.Address,
+	managedGrant common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"withdrawAsManagedGrantee",
+		&result,
+		amount,
+		operator,
+		managedGrant,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) WithdrawAsManagedGranteeGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+	managedGrant common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"withdrawAsManagedGrantee",
+		kb.contractABI,
+		kb.transactor,
+		amount,
+		operator,
+		managedGrant,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) AuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction authorizeSortitionPoolContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.AuthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"authorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction authorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.AuthorizeSortitionPoolContract(
+				transactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"authorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction authorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallAuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"authorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) AuthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"authorizeSortitionPoolContract",
+		kb.contractABI,
+		kb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) Withdraw(
+	amount *big.Int,
+	operator common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction withdraw",
+		"params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.Withdraw(
+		transactorOptions,
+		amount,
+		operator,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"withdraw",
+			amount,
+			operator,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction withdraw with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.Withdraw(
+				transactorOptions,
+				amount,
+				operator,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"withdraw",
+					amount,
+					operator,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction withdraw with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallWithdraw(
+	amount *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"withdraw",
+		&result,
+		amount,
+		operator,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) WithdrawGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"withdraw",
+		kb.contractABI,
+		kb.transactor,
+		amount,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) DeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...ethutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction deauthorizeSortitionPoolContract",
+		"params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.DeauthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"deauthorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction deauthorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+		transaction.Hash().Hex(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		func(newGasPrice *big.Int) (*types.Transaction, error) {
+			transactorOptions.GasLimit = transaction.Gas()
+			transactorOptions.GasPrice = newGasPrice
+
+			transaction, err := kb.contract.DeauthorizeSortitionPoolContract(
+				transactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return transaction, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"deauthorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction deauthorizeSortitionPoolContract with id: [%v] and nonce [%v]",
+				transaction.Hash().Hex(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallDeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := ethutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) DeauthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := ethutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		kb.contractABI,
+		kb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (kb *KeepBonding) IsAuthorizedForOperator(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := kb.contract.IsAuthorizedForOperator(
+		kb.callerOptions,
+		_operator,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"isAuthorizedForOperator",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) IsAuthorizedForOperatorAtBlock(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"isAuthorizedForOperator",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) UnbondedValue(
+	arg0 common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := kb.contract.UnbondedValue(
+		kb.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"unbondedValue",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) UnbondedValueAtBlock(
+	arg0 common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"unbondedValue",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) AuthorizerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := kb.contract.AuthorizerOf(
+		kb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"authorizerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) AuthorizerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"authorizerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) BeneficiaryOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := kb.contract.BeneficiaryOf(
+		kb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"beneficiaryOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) BeneficiaryOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"beneficiaryOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) HasSecondaryAuthorization(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (bool, error) {
+	var result bool
+	result, err := kb.contract.HasSecondaryAuthorization(
+		kb.callerOptions,
+		_operator,
+		_poolAddress,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"hasSecondaryAuthorization",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) HasSecondaryAuthorizationAtBlock(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"hasSecondaryAuthorization",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) AvailableUnbondedValue(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := kb.contract.AvailableUnbondedValue(
+		kb.callerOptions,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"availableUnbondedValue",
+			operator,
+			bondCreator,
+			authorizedSortitionPool,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) AvailableUnbondedValueAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"availableUnbondedValue",
+		&result,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) BondAmount(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := kb.contract.BondAmount(
+		kb.callerOptions,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"bondAmount",
+			operator,
+			holder,
+			referenceID,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) BondAmountAtBlock(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := ethutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"bondAmount",
+		&result,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+type keepBondingBondSeizedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	Destination common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (kb *KeepBonding) PastBondSeizedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.KeepBondingBondSeized, error) {
+	iterator, err := kb.contract.FilterBondSeized(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondSeized, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) WatchBondSeized(
+	success keepBondingBondSeizedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := kb.subscribeBondSeized(
+			success,
+			failCallback,
+			operatorFilter,
+			referenceIDFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				kbLogger.Warning(
+					"subscription to event BondSeized terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (kb *KeepBonding) subscribeBondSeized(
+	success keepBondingBondSeizedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.KeepBondingBondSeized)
+	eventSubscription, err := kb.contract.WatchBondSeized(
+		nil,
+		eventChan,
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.ReferenceID,
+					event.Destination,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type keepBondingUnbondedValueDepositedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (kb *KeepBonding) PastUnbondedValueDepositedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.KeepBondingUnbondedValueDeposited, error) {
+	iterator, err := kb.contract.FilterUnbondedValueDeposited(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingUnbondedValueDeposited, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) WatchUnbondedValueDeposited(
+	success keepBondingUnbondedValueDepositedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := kb.subscribeUnbondedValueDeposited(
+			success,
+			failCallback,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				kbLogger.Warning(
+					"subscription to event UnbondedValueDeposited terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (kb *KeepBonding) subscribeUnbondedValueDeposited(
+	success keepBondingUnbondedValueDepositedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.KeepBondingUnbondedValueDeposited)
+	eventSubscription, err := kb.contract.WatchUnbondedValueDeposited(
+		nil,
+		eventChan,
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type keepBondingUnbondedValueWithdrawnFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (kb *KeepBonding) PastUnbondedValueWithdrawnEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.KeepBondingUnbondedValueWithdrawn, error) {
+	iterator, err := kb.contract.FilterUnbondedValueWithdrawn(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingUnbondedValueWithdrawn, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) WatchUnbondedValueWithdrawn(
+	success keepBondingUnbondedValueWithdrawnFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := kb.subscribeUnbondedValueWithdrawn(
+			success,
+			failCallback,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				kbLogger.Warning(
+					"subscription to event UnbondedValueWithdrawn terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (kb *KeepBonding) subscribeUnbondedValueWithdrawn(
+	success keepBondingUnbondedValueWithdrawnFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.KeepBondingUnbondedValueWithdrawn)
+	eventSubscription, err := kb.contract.WatchUnbondedValueWithdrawn(
+		nil,
+		eventChan,
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type keepBondingBondCreatedFunc func(
+	Operator common.Address,
+	Holder common.Address,
+	SortitionPool common.Address,
+	ReferenceID *big.Int,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (kb *KeepBonding) PastBondCreatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) ([]*abi.KeepBondingBondCreated, error) {
+	iterator, err := kb.contract.FilterBondCreated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondCreated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) WatchBondCreated(
+	success keepBondingBondCreatedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := kb.subscribeBondCreated(
+			success,
+			failCallback,
+			operatorFilter,
+			holderFilter,
+			sortitionPoolFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				kbLogger.Warning(
+					"subscription to event BondCreated terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (kb *KeepBonding) subscribeBondCreated(
+	success keepBondingBondCreatedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.KeepBondingBondCreated)
+	eventSubscription, err := kb.contract.WatchBondCreated(
+		nil,
+		eventChan,
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.Holder,
+					event.SortitionPool,
+					event.ReferenceID,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type keepBondingBondReassignedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	NewHolder common.Address,
+	NewReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (kb *KeepBonding) PastBondReassignedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.KeepBondingBondReassigned, error) {
+	iterator, err := kb.contract.FilterBondReassigned(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondReassigned, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) WatchBondReassigned(
+	success keepBondingBondReassignedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := kb.subscribeBondReassigned(
+			success,
+			failCallback,
+			operatorFilter,
+			referenceIDFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				kbLogger.Warning(
+					"subscription to event BondReassigned terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (kb *KeepBonding) subscribeBondReassigned(
+	success keepBondingBondReassignedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.KeepBondingBondReassigned)
+	eventSubscription, err := kb.contract.WatchBondReassigned(
+		nil,
+		eventChan,
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.ReferenceID,
+					event.NewHolder,
+					event.NewReferenceID,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+type keepBondingBondReleasedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (kb *KeepBonding) PastBondReleasedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.KeepBondingBondReleased, error) {
+	iterator, err := kb.contract.FilterBondReleased(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondReleased, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) WatchBondReleased(
+	success keepBondingBondReleasedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	errorChan := make(chan error)
+	unsubscribeChan := make(chan struct{})
+
+	// Delay which must be preserved before a new resubscription attempt.
+	// There is no sense to resubscribe immediately after the fail of current
+	// subscription because the publisher must have some time to recover.
+	retryDelay := 5 * time.Second
+
+	watch := func() {
+		failCallback := func(err error) error {
+			fail(err)
+			errorChan <- err // trigger resubscription signal
+			return err
+		}
+
+		subscription, err := kb.subscribeBondReleased(
+			success,
+			failCallback,
+			operatorFilter,
+			referenceIDFilter,
+		)
+		if err != nil {
+			errorChan <- err // trigger resubscription signal
+			return
+		}
+
+		// wait for unsubscription signal
+		<-unsubscribeChan
+		subscription.Unsubscribe()
+	}
+
+	// trigger the resubscriber goroutine
+	go func() {
+		go watch() // trigger first subscription
+
+		for {
+			select {
+			case <-errorChan:
+				kbLogger.Warning(
+					"subscription to event BondReleased terminated with error; " +
+						"resubscription attempt will be performed after the retry delay",
+				)
+				time.Sleep(retryDelay)
+				go watch()
+			case <-unsubscribeChan:
+				// shutdown the resubscriber goroutine on unsubscribe signal
+				return
+			}
+		}
+	}()
+
+	// closing the unsubscribeChan will trigger a unsubscribe signal and
+	// run unsubscription for all subscription instances
+	unsubscribeCallback := func() {
+		close(unsubscribeChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
+
+func (kb *KeepBonding) subscribeBondReleased(
+	success keepBondingBondReleasedFunc,
+	fail func(err error) error,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) (subscription.EventSubscription, error) {
+	eventChan := make(chan *abi.KeepBondingBondReleased)
+	eventSubscription, err := kb.contract.WatchBondReleased(
+		nil,
+		eventChan,
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		close(eventChan)
+		return eventSubscription, fmt.Errorf(
+			"error creating watch for BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	var subscriptionMutex = &sync.Mutex{}
+
+	go func() {
+		for {
+			select {
+			case event, subscribed := <-eventChan:
+				subscriptionMutex.Lock()
+				// if eventChan has been closed, it means we have unsubscribed
+				if !subscribed {
+					subscriptionMutex.Unlock()
+					return
+				}
+				success(
+					event.Operator,
+					event.ReferenceID,
+					event.Raw.BlockNumber,
+				)
+				subscriptionMutex.Unlock()
+			case ee := <-eventSubscription.Err():
+				fail(ee)
+				return
+			}
+		}
+	}()
+
+	unsubscribeCallback := func() {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+
+		eventSubscription.Unsubscribe()
+		close(eventChan)
+	}
+
+	return subscription.NewEventSubscription(unsubscribeCallback), nil
+}
@@ -2,6 +2,8 @@ package local
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -18,15 +20,24 @@ const (
 type localKeep struct {
 	publicKey    [64]byte
 	members      []common.Address
+	owner        common.Address
 	status       keepStatus
 	latestDigest [32]byte
 
+	openedTimestamp time.Time
+
+	memberETHBalances map[common.Address]*big.Int
+
 	signatureRequestedHandlers map[int]func(event *eth.SignatureRequestedEvent)
+	signatureSubmittedHandlers map[int]func(event *eth.SignatureSubmittedEvent)
 
 	keepClosedHandlers     map[int]func(event *eth.KeepClosedEvent)
 	keepTerminatedHandlers map[int]func(event *eth.KeepTerminatedEvent)
 
 	signatureSubmittedEvents []*eth.SignatureSubmittedEvent
+	signatureRequestedEvents []*eth.SignatureRequestedEvent
+	keepClosedEvents         []*eth.KeepClosedEvent
+	keepTerminatedEvents     []*eth.KeepTerminatedEvent
 }
 
 func (c *localChain) requestSignature(keepAddress common.Address, digest [32]byte) error {
@@ -52,9 +63,15 @@ func (c *localChain) requestSignature(keepAddress common.Address, digest [32]byt
 	keep.latestDigest = digest
 
 	signatureRequestedEvent := &eth.SignatureRequestedEvent{
-		Digest: digest,
+		Digest:      digest,
+		BlockNumber: c.currentBlock(),
 	}
 
+	keep.signatureRequestedEvents = append(
+		keep.signatureRequestedEvents,
+		signatureRequestedEvent,
+	)
+
 	for _, handler := range keep.signatureRequestedHandlers {
 		go func(handler func(event *eth.SignatureRequestedEvent), signatureRequestedEvent *eth.SignatureRequestedEvent) {
 			handler(signatureRequestedEvent)
@@ -82,7 +99,11 @@ func (c *localChain) closeKeep(keepAddress common.Address) error {
 
 	keep.status = closed
 
-	keepClosedEvent := &eth.KeepClosedEvent{}
+	keepClosedEvent := &eth.KeepClosedEvent{
+		BlockNumber: c.currentBlock(),
+	}
+
+	keep.keepClosedEvents = append(keep.keepClosedEvents, keepClosedEvent)
 
 	for _, handler := range keep.keepClosedHandlers {
 		go func(
@@ -114,7 +135,14 @@ func (c *localChain) terminateKeep(keepAddress common.Address) error {
 
 	keep.status = terminated
 
-	keepTerminatedEvent := &eth.KeepTerminatedEvent{}
+	keepTerminatedEvent := &eth.KeepTerminatedEvent{
+		BlockNumber: c.currentBlock(),
+	}
+
+	keep.keepTerminatedEvents = append(
+		keep.keepTerminatedEvents,
+		keepTerminatedEvent,
+	)
 
 	for _, handler := range keep.keepTerminatedHandlers {
 		go func(
@@ -2,13 +2,15 @@ package local
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	chain "github.com/keep-network/keep-ecdsa/pkg/chain"
 )
 
 func (c *localChain) createKeep(keepAddress common.Address) error {
-	return c.createKeepWithMembers(keepAddress, []common.Address{})
+	return c.createKeepWithMembers(keepAddress, nil)
 }
 
 func (c *localChain) createKeepWithMembers(
@@ -28,10 +30,13 @@ func (c *localChain) createKeepWithMembers(
 	localKeep := &localKeep{
 		publicKey:                  [64]byte{},
 		members:                    members,
+		openedTimestamp:            time.Now(),
 		signatureRequestedHandlers: make(map[int]func(event *chain.SignatureRequestedEvent)),
+		signatureSubmittedHandlers: make(map[int]func(event *chain.SignatureSubmittedEvent)),
 		keepClosedHandlers:         make(map[int]func(event *chain.KeepClosedEvent)),
 		keepTerminatedHandlers:     make(map[int]func(event *chain.KeepTerminatedEvent)),
 		signatureSubmittedEvents:   make([]*chain.SignatureSubmittedEvent, 0),
+		memberETHBalances:          make(map[common.Address]*big.Int),
 	}
 
 	c.keeps[keepAddress] = localKeep
@@ -39,8 +44,12 @@ func (c *localChain) createKeepWithMembers(
 
 	keepCreatedEvent := &chain.BondedECDSAKeepCreatedEvent{
 		KeepAddress: keepAddress,
+		Members:     members,
+		BlockNumber: c.currentBlock(),
 	}
 
+	c.keepCreatedEvents = append(c.keepCreatedEvents, keepCreatedEvent)
+
 	for _, handler := range c.keepCreatedHandlers {
 		go func(
 			handler func(event *chain.BondedECDSAKeepCreatedEvent),
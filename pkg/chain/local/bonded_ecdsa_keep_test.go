@@ -49,7 +49,7 @@ func TestRequestSignatureNoHandler(t *testing.T) {
 	var keepPubkey [64]byte
 	rand.Read(keepPubkey[:])
 
-	err = chain.SubmitKeepPublicKey(keepAddress, keepPubkey)
+	_, err = chain.SubmitKeepPublicKey(keepAddress, keepPubkey)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -80,7 +80,7 @@ func TestRequestSignature(t *testing.T) {
 	var keepPubkey [64]byte
 	rand.Read(keepPubkey[:])
 
-	err = chain.SubmitKeepPublicKey(keepAddress, keepPubkey)
+	_, err = chain.SubmitKeepPublicKey(keepAddress, keepPubkey)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1,7 +1,9 @@
 package local
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -12,6 +14,7 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-core/pkg/chain"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -26,7 +29,9 @@ type Chain interface {
 	OpenKeep(keepAddress common.Address, members []common.Address)
 	CloseKeep(keepAddress common.Address) error
 	TerminateKeep(keepAddress common.Address) error
+	SetKeepOwner(keepAddress common.Address, owner common.Address) error
 	AuthorizeOperator(operatorAddress common.Address)
+	DistributeETHReward(keepAddress common.Address, value *big.Int) error
 }
 
 // localChain is an implementation of ethereum blockchain interface.
@@ -43,6 +48,7 @@ type localChain struct {
 	keeps         map[common.Address]*localKeep
 
 	keepCreatedHandlers map[int]func(event *eth.BondedECDSAKeepCreatedEvent)
+	keepCreatedEvents   []*eth.BondedECDSAKeepCreatedEvent
 
 	clientAddress common.Address
 
@@ -101,6 +107,23 @@ func (lc *localChain) TerminateKeep(keepAddress common.Address) error {
 	return lc.terminateKeep(keepAddress)
 }
 
+func (lc *localChain) SetKeepOwner(
+	keepAddress common.Address,
+	owner common.Address,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	keep.owner = owner
+
+	return nil
+}
+
 func (lc *localChain) AuthorizeOperator(operator common.Address) {
 	lc.localChainMutex.Lock()
 	defer lc.localChainMutex.Unlock()
@@ -108,6 +131,43 @@ func (lc *localChain) AuthorizeOperator(operator common.Address) {
 	lc.authorizations[operator] = true
 }
 
+// DistributeETHReward distributes the value evenly across keep members. The
+// remainder of the division is added to the last member.
+func (lc *localChain) DistributeETHReward(
+	keepAddress common.Address,
+	value *big.Int,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	if len(keep.members) == 0 {
+		return fmt.Errorf("keep [%v] has no members", keepAddress)
+	}
+
+	membersCount := big.NewInt(int64(len(keep.members)))
+	dividend, remainder := new(big.Int).DivMod(value, membersCount, new(big.Int))
+
+	for i, member := range keep.members {
+		reward := new(big.Int).Set(dividend)
+		if i == len(keep.members)-1 {
+			reward.Add(reward, remainder)
+		}
+
+		balance, ok := keep.memberETHBalances[member]
+		if !ok {
+			balance = big.NewInt(0)
+		}
+		keep.memberETHBalances[member] = balance.Add(balance, reward)
+	}
+
+	return nil
+}
+
 // Address returns client's ethereum address.
 func (lc *localChain) Address() common.Address {
 	return lc.clientAddress
@@ -177,24 +237,25 @@ func (lc *localChain) OnSignatureRequested(
 }
 
 // SubmitKeepPublicKey checks if public key has been already submitted for given
-// keep address, if not it stores the key in a map.
+// keep address, if not it stores the key in a map. It returns a random
+// transaction hash.
 func (lc *localChain) SubmitKeepPublicKey(
 	keepAddress common.Address,
 	publicKey [64]byte,
-) error {
+) (common.Hash, error) {
 	lc.localChainMutex.Lock()
 	defer lc.localChainMutex.Unlock()
 
 	keep, ok := lc.keeps[keepAddress]
 	if !ok {
-		return fmt.Errorf(
+		return common.Hash{}, fmt.Errorf(
 			"failed to find keep with address: [%s]",
 			keepAddress.String(),
 		)
 	}
 
 	if keep.publicKey != [64]byte{} {
-		return fmt.Errorf(
+		return common.Hash{}, fmt.Errorf(
 			"public key already submitted for keep [%s]",
 			keepAddress.String(),
 		)
@@ -202,21 +263,21 @@ func (lc *localChain) SubmitKeepPublicKey(
 
 	keep.publicKey = publicKey
 
-	return nil
+	return generateTransactionHash(), nil
 }
 
 // SubmitSignature submits a signature to a keep contract deployed under a
-// given address.
+// given address. It returns a random transaction hash.
 func (lc *localChain) SubmitSignature(
 	keepAddress common.Address,
 	signature *ecdsa.Signature,
-) error {
+) (common.Hash, error) {
 	lc.localChainMutex.Lock()
 	defer lc.localChainMutex.Unlock()
 
 	keep, ok := lc.keeps[keepAddress]
 	if !ok {
-		return fmt.Errorf(
+		return common.Hash{}, fmt.Errorf(
 			"failed to find keep with address: [%s]",
 			keepAddress.String(),
 		)
@@ -224,7 +285,7 @@ func (lc *localChain) SubmitSignature(
 
 	// force the right workflow sequence
 	if keep.latestDigest == [32]byte{} {
-		return fmt.Errorf(
+		return common.Hash{}, fmt.Errorf(
 			"keep [%s] is not awaiting for a signature",
 			keepAddress.String(),
 		)
@@ -232,25 +293,164 @@ func (lc *localChain) SubmitSignature(
 
 	rBytes, err := byteutils.BytesTo32Byte(signature.R.Bytes())
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
 	sBytes, err := byteutils.BytesTo32Byte(signature.S.Bytes())
 	if err != nil {
-		return err
+		return common.Hash{}, err
+	}
+
+	signatureSubmittedEvent := &eth.SignatureSubmittedEvent{
+		Digest:     keep.latestDigest,
+		R:          rBytes,
+		S:          sBytes,
+		RecoveryID: uint8(signature.RecoveryID),
 	}
 
 	keep.signatureSubmittedEvents = append(
 		keep.signatureSubmittedEvents,
-		&eth.SignatureSubmittedEvent{
-			Digest:     keep.latestDigest,
-			R:          rBytes,
-			S:          sBytes,
-			RecoveryID: uint8(signature.RecoveryID),
-		},
+		signatureSubmittedEvent,
 	)
 
-	return nil
+	for _, handler := range keep.signatureSubmittedHandlers {
+		go func(
+			handler func(event *eth.SignatureSubmittedEvent),
+			signatureSubmittedEvent *eth.SignatureSubmittedEvent,
+		) {
+			handler(signatureSubmittedEvent)
+		}(handler, signatureSubmittedEvent)
+	}
+
+	return generateTransactionHash(), nil
+}
+
+// OnSignatureSubmitted is a callback that is invoked when a signature is
+// submitted to the given keep.
+func (lc *localChain) OnSignatureSubmitted(
+	keepAddress common.Address,
+	handler func(event *eth.SignatureSubmittedEvent),
+) (subscription.EventSubscription, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	handlerID := generateHandlerID()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	keep.signatureSubmittedHandlers[handlerID] = handler
+
+	return subscription.NewEventSubscription(func() {
+		lc.localChainMutex.Lock()
+		defer lc.localChainMutex.Unlock()
+
+		delete(keep.signatureSubmittedHandlers, handlerID)
+	}), nil
+}
+
+// CheckSignatureFraud checks if the signature has been calculated with the
+// key of the keep over a digest the keep has never been requested to sign.
+// The signed digest has to be a sha256 hash of the preimage.
+func (lc *localChain) CheckSignatureFraud(
+	keepAddress common.Address,
+	signature *ecdsa.Signature,
+	signedDigest [32]byte,
+	preimage []byte,
+) (bool, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	return lc.checkSignatureFraud(keepAddress, signature, signedDigest, preimage)
+}
+
+// SubmitSignatureFraud terminates the keep if the signature is a fraud.
+// It fails if the signature is not a fraud.
+func (lc *localChain) SubmitSignatureFraud(
+	keepAddress common.Address,
+	signature *ecdsa.Signature,
+	signedDigest [32]byte,
+	preimage []byte,
+) error {
+	lc.localChainMutex.Lock()
+
+	isFraud, err := lc.checkSignatureFraud(
+		keepAddress,
+		signature,
+		signedDigest,
+		preimage,
+	)
+	lc.localChainMutex.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	if !isFraud {
+		return fmt.Errorf("signature is not fraudulent")
+	}
+
+	return lc.terminateKeep(keepAddress)
+}
+
+func (lc *localChain) checkSignatureFraud(
+	keepAddress common.Address,
+	signature *ecdsa.Signature,
+	signedDigest [32]byte,
+	preimage []byte,
+) (bool, error) {
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return false, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	if keep.publicKey == [64]byte{} {
+		return false, fmt.Errorf("public key was not set yet")
+	}
+
+	if sha256.Sum256(preimage) != signedDigest {
+		return false, fmt.Errorf(
+			"signed digest does not match sha256 hash of the preimage",
+		)
+	}
+
+	rBytes, err := byteutils.LeftPadTo32Bytes(signature.R.Bytes())
+	if err != nil {
+		return false, err
+	}
+
+	sBytes, err := byteutils.LeftPadTo32Bytes(signature.S.Bytes())
+	if err != nil {
+		return false, err
+	}
+
+	serializedSignature := make([]byte, 0, 65)
+	serializedSignature = append(serializedSignature, rBytes...)
+	serializedSignature = append(serializedSignature, sBytes...)
+	serializedSignature = append(serializedSignature, byte(signature.RecoveryID))
+
+	recoveredPublicKey, err := crypto.Ecrecover(signedDigest[:], serializedSignature)
+	if err != nil {
+		return false, nil
+	}
+
+	// Recovered public key is in the uncompressed form prefixed with 0x04.
+	if !bytes.Equal(recoveredPublicKey[1:], keep.publicKey[:]) {
+		return false, nil
+	}
+
+	for _, event := range keep.signatureRequestedEvents {
+		if event.Digest == signedDigest {
+			return false, nil
+		}
+	}
+
+	return true, nil
 }
 
 // IsAwaitingSignature checks if the keep is waiting for a signature to be
@@ -404,7 +604,19 @@ func (lc *localChain) SignatureRequestedBlock(
 }
 
 func (lc *localChain) GetPublicKey(keepAddress common.Address) ([]uint8, error) {
-	panic("implement")
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	if keep.publicKey == [64]byte{} {
+		return []uint8{}, nil
+	}
+
+	return keep.publicKey[:], nil
 }
 
 func (lc *localChain) GetMembers(
@@ -426,8 +638,72 @@ func (lc *localChain) GetHonestThreshold(
 	panic("implement")
 }
 
+func (lc *localChain) GetOwner(
+	keepAddress common.Address,
+) (common.Address, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return common.Address{}, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	return keep.owner, nil
+}
+
+func (lc *localChain) GetMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) (*big.Int, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	balance, ok := keep.memberETHBalances[member]
+	if !ok {
+		return big.NewInt(0), nil
+	}
+
+	return new(big.Int).Set(balance), nil
+}
+
+func (lc *localChain) WithdrawMemberETHBalance(
+	keepAddress common.Address,
+	member common.Address,
+) (common.Hash, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	balance, ok := keep.memberETHBalances[member]
+	if !ok || balance.Sign() == 0 {
+		return common.Hash{}, fmt.Errorf("no balance for member [%v]", member)
+	}
+
+	delete(keep.memberETHBalances, member)
+
+	return generateTransactionHash(), nil
+}
+
 func (lc *localChain) GetOpenedTimestamp(keepAddress common.Address) (time.Time, error) {
-	panic("implement")
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return time.Unix(0, 0), fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	return keep.openedTimestamp, nil
 }
 
 func (lc *localChain) PastSignatureSubmittedEvents(
@@ -445,6 +721,94 @@ func (lc *localChain) PastSignatureSubmittedEvents(
 	return keep.signatureSubmittedEvents, nil
 }
 
+func (lc *localChain) PastSignatureRequestedEvents(
+	keepAddress string,
+	startBlock uint64,
+) ([]*eth.SignatureRequestedEvent, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[common.HexToAddress(keepAddress)]
+	if !ok {
+		return nil, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	result := make([]*eth.SignatureRequestedEvent, 0)
+	for _, event := range keep.signatureRequestedEvents {
+		if event.BlockNumber >= startBlock {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+func (lc *localChain) PastKeepClosedEvents(
+	keepAddress string,
+	startBlock uint64,
+) ([]*eth.KeepClosedEvent, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[common.HexToAddress(keepAddress)]
+	if !ok {
+		return nil, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	result := make([]*eth.KeepClosedEvent, 0)
+	for _, event := range keep.keepClosedEvents {
+		if event.BlockNumber >= startBlock {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+func (lc *localChain) PastKeepTerminatedEvents(
+	keepAddress string,
+	startBlock uint64,
+) ([]*eth.KeepTerminatedEvent, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[common.HexToAddress(keepAddress)]
+	if !ok {
+		return nil, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	result := make([]*eth.KeepTerminatedEvent, 0)
+	for _, event := range keep.keepTerminatedEvents {
+		if event.BlockNumber >= startBlock {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+func (lc *localChain) PastBondedECDSAKeepCreatedEvents(
+	startBlock uint64,
+) ([]*eth.BondedECDSAKeepCreatedEvent, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	result := make([]*eth.BondedECDSAKeepCreatedEvent, 0)
+	for _, event := range lc.keepCreatedEvents {
+		if event.BlockNumber >= startBlock {
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+func (lc *localChain) currentBlock() uint64 {
+	// Local block counter never fails so it's safe to ignore the error.
+	currentBlock, _ := lc.blockCounter.CurrentBlock()
+	return currentBlock
+}
+
 func (lc *localChain) BlockTimestamp(blockNumber *big.Int) (uint64, error) {
 	blockTimestamp, ok := lc.blocksTimestamps.Load(blockNumber.Uint64())
 	if !ok {
@@ -460,6 +824,14 @@ func generateHandlerID() int {
 	return rand.Int()
 }
 
+func generateTransactionHash() common.Hash {
+	var hash common.Hash
+	// #nosec G404 (insecure random number source (rand))
+	// Local chain implementation doesn't require secure randomness.
+	rand.Read(hash[:])
+	return hash
+}
+
 func RandomSigningGroup(size int) []common.Address {
 	signers := make([]common.Address, size)
 
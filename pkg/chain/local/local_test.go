@@ -2,6 +2,7 @@ package local
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -13,6 +14,7 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
 )
 
@@ -70,7 +72,7 @@ func TestOnSignatureRequested(t *testing.T) {
 	var keepPubkey [64]byte
 	rand.Read(keepPubkey[:])
 
-	err = chain.SubmitKeepPublicKey(keepAddress, keepPubkey)
+	_, err = chain.SubmitKeepPublicKey(keepAddress, keepPubkey)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -126,7 +128,7 @@ func TestSubmitKeepPublicKey(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = chain.SubmitKeepPublicKey(
+	_, err = chain.SubmitKeepPublicKey(
 		keepAddress,
 		keepPublicKey,
 	)
@@ -142,7 +144,7 @@ func TestSubmitKeepPublicKey(t *testing.T) {
 		)
 	}
 
-	err = chain.SubmitKeepPublicKey(
+	_, err = chain.SubmitKeepPublicKey(
 		keepAddress,
 		keepPublicKey,
 	)
@@ -169,7 +171,7 @@ func TestSubmitSignature(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = chain.SubmitKeepPublicKey(
+	_, err = chain.SubmitKeepPublicKey(
 		keepAddress,
 		keepPublicKey,
 	)
@@ -190,7 +192,7 @@ func TestSubmitSignature(t *testing.T) {
 		RecoveryID: 1,
 	}
 
-	err = chain.SubmitSignature(keepAddress, signature)
+	_, err = chain.SubmitSignature(keepAddress, signature)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -225,6 +227,115 @@ func TestSubmitSignature(t *testing.T) {
 	}
 }
 
+func TestSignatureFraud(t *testing.T) {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancelCtx()
+
+	chain := initializeLocalChain(ctx)
+	keepAddress := common.HexToAddress("0x41048F9B90290A2e96D07f537F3A7E97620E9e47")
+
+	if err := chain.createKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keepPublicKey [64]byte
+	copy(keepPublicKey[:], crypto.FromECDSAPub(&privateKey.PublicKey)[1:])
+
+	if _, err := chain.SubmitKeepPublicKey(keepAddress, keepPublicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(preimage []byte) ([32]byte, *ecdsa.Signature) {
+		digest := sha256.Sum256(preimage)
+
+		serialized, err := crypto.Sign(digest[:], privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return digest, &ecdsa.Signature{
+			R:          new(big.Int).SetBytes(serialized[:32]),
+			S:          new(big.Int).SetBytes(serialized[32:64]),
+			RecoveryID: int(serialized[64]),
+		}
+	}
+
+	requestedPreimage := []byte("requested")
+	requestedDigest, requestedSignature := sign(requestedPreimage)
+	if err := chain.requestSignature(keepAddress, requestedDigest); err != nil {
+		t.Fatal(err)
+	}
+
+	isFraud, err := chain.CheckSignatureFraud(
+		keepAddress,
+		requestedSignature,
+		requestedDigest,
+		requestedPreimage,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isFraud {
+		t.Error("signature over requested digest should not be a fraud")
+	}
+
+	if err := chain.SubmitSignatureFraud(
+		keepAddress,
+		requestedSignature,
+		requestedDigest,
+		requestedPreimage,
+	); err == nil {
+		t.Error("expected fraud submission failure")
+	}
+
+	fraudulentPreimage := []byte("fraudulent")
+	fraudulentDigest, fraudulentSignature := sign(fraudulentPreimage)
+
+	if _, err := chain.CheckSignatureFraud(
+		keepAddress,
+		fraudulentSignature,
+		fraudulentDigest,
+		requestedPreimage,
+	); err == nil {
+		t.Error("expected preimage mismatch failure")
+	}
+
+	isFraud, err = chain.CheckSignatureFraud(
+		keepAddress,
+		fraudulentSignature,
+		fraudulentDigest,
+		fraudulentPreimage,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isFraud {
+		t.Error("signature over not requested digest should be a fraud")
+	}
+
+	if err := chain.SubmitSignatureFraud(
+		keepAddress,
+		fraudulentSignature,
+		fraudulentDigest,
+		fraudulentPreimage,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	isActive, err := chain.IsActive(keepAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isActive {
+		t.Error("keep should be terminated after fraud submission")
+	}
+}
+
 func initializeLocalChain(ctx context.Context) *localChain {
 	return Connect(ctx).(*localChain)
 }
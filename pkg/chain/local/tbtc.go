@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"sort"
 	"sync"
 
 	chain "github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -31,6 +32,7 @@ type localDeposit struct {
 	redemptionSignature *Signature
 	redemptionProof     *TxProof
 
+	createdEvent              *chain.DepositCreatedEvent
 	redemptionRequestedEvents []*chain.DepositRedemptionRequestedEvent
 }
 
@@ -122,10 +124,18 @@ func (tlc *TBTCLocalChain) CreateDeposit(
 	keepAddress := generateAddress()
 	tlc.OpenKeep(keepAddress, signers)
 
+	// Local block counter never fails so it's safe to ignore the error.
+	currentBlock, _ := tlc.BlockCounter().CurrentBlock()
+
 	tlc.deposits[depositAddress] = &localDeposit{
-		keepAddress:               keepAddress.Hex(),
-		state:                     chain.AwaitingSignerSetup,
-		utxoValue:                 big.NewInt(defaultUTXOValue),
+		keepAddress: keepAddress.Hex(),
+		state:       chain.AwaitingSignerSetup,
+		utxoValue:   big.NewInt(defaultUTXOValue),
+		createdEvent: &chain.DepositCreatedEvent{
+			DepositAddress: depositAddress,
+			KeepAddress:    keepAddress.Hex(),
+			BlockNumber:    currentBlock,
+		},
 		redemptionRequestedEvents: make([]*chain.DepositRedemptionRequestedEvent, 0),
 	}
 
@@ -307,6 +317,50 @@ func (tlc *TBTCLocalChain) PastDepositRedemptionRequestedEvents(
 	return deposit.redemptionRequestedEvents, nil
 }
 
+func (tlc *TBTCLocalChain) PastDepositCreatedEvents(
+	startBlock uint64,
+) ([]*chain.DepositCreatedEvent, error) {
+	tlc.tbtcLocalChainMutex.Lock()
+	defer tlc.tbtcLocalChainMutex.Unlock()
+
+	result := make([]*chain.DepositCreatedEvent, 0)
+
+	for _, deposit := range tlc.deposits {
+		if deposit.createdEvent.BlockNumber >= startBlock {
+			result = append(result, deposit.createdEvent)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
+func (tlc *TBTCLocalChain) PastAllDepositRedemptionRequestedEvents(
+	startBlock uint64,
+) ([]*chain.DepositRedemptionRequestedEvent, error) {
+	tlc.tbtcLocalChainMutex.Lock()
+	defer tlc.tbtcLocalChainMutex.Unlock()
+
+	result := make([]*chain.DepositRedemptionRequestedEvent, 0)
+
+	for _, deposit := range tlc.deposits {
+		for _, event := range deposit.redemptionRequestedEvents {
+			if event.BlockNumber >= startBlock {
+				result = append(result, event)
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].BlockNumber < result[j].BlockNumber
+	})
+
+	return result, nil
+}
+
 func (tlc *TBTCLocalChain) KeepAddress(depositAddress string) (string, error) {
 	tlc.tbtcLocalChainMutex.Lock()
 	defer tlc.tbtcLocalChainMutex.Unlock()
@@ -0,0 +1,38 @@
+package eth
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Rewards is an interface that provides ability to interact with the
+// ECDSARewards ethereum contract distributing KEEP rewards to members of
+// closed keeps. Rewards are allocated to the beneficiary of the operator
+// for each interval and they are withdrawn per interval. All amounts are
+// expressed in the smallest unit of KEEP.
+type Rewards interface {
+	// CurrentRewardsInterval returns the rewards interval the current time
+	// falls within. Intervals are numbered from zero.
+	CurrentRewardsInterval() (uint64, error)
+
+	// AllocatedRewards returns rewards allocated to the beneficiary of the
+	// operator in the given interval.
+	AllocatedRewards(interval uint64, operator common.Address) (*big.Int, error)
+
+	// WithdrawnRewards returns rewards already withdrawn to the beneficiary
+	// of the operator in the given interval.
+	WithdrawnRewards(interval uint64, operator common.Address) (*big.Int, error)
+
+	// WithdrawableRewards returns rewards allocated to the beneficiary of the
+	// operator in the given interval which have not been withdrawn yet.
+	WithdrawableRewards(
+		interval uint64,
+		operator common.Address,
+	) (*big.Int, error)
+
+	// WithdrawRewards withdraws all rewards available for the operator in
+	// the given interval to the beneficiary of the operator. It returns the
+	// hash of the withdrawal transaction.
+	WithdrawRewards(interval uint64, operator common.Address) (common.Hash, error)
+}
@@ -93,6 +93,13 @@ type TBTCSystem interface {
 		handler func(depositAddress string),
 	) (subscription.EventSubscription, error)
 
+	// PastDepositCreatedEvents returns all deposit created events which
+	// occurred after the provided start block. All implementations should
+	// returns those events sorted by the block number in the ascending order.
+	PastDepositCreatedEvents(
+		startBlock uint64,
+	) ([]*DepositCreatedEvent, error)
+
 	// PastDepositRedemptionRequestedEvents returns all redemption requested
 	// events for the given deposit which occurred after the provided start block.
 	// All implementations should returns those events sorted by the
@@ -101,6 +108,21 @@ type TBTCSystem interface {
 		startBlock uint64,
 		depositAddress string,
 	) ([]*DepositRedemptionRequestedEvent, error)
+
+	// PastAllDepositRedemptionRequestedEvents returns redemption requested
+	// events for all deposits which occurred after the provided start block.
+	// All implementations should returns those events sorted by the
+	// block number in the ascending order.
+	PastAllDepositRedemptionRequestedEvents(
+		startBlock uint64,
+	) ([]*DepositRedemptionRequestedEvent, error)
+}
+
+// DepositCreatedEvent is an event emitted when a new deposit has been created.
+type DepositCreatedEvent struct {
+	DepositAddress string
+	KeepAddress    string
+	BlockNumber    uint64
 }
 
 // DepositRedemptionRequestedEvent is an event emitted when a deposit
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BlockCheckpoint is a disk-backed record of the last block up to which chain
+// events have been processed by the client. On the client start, events emitted
+// since the checkpoint are replayed so requests and closures emitted while the
+// client was offline are not missed.
+type BlockCheckpoint struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewBlockCheckpoint creates a block checkpoint stored in the file under the
+// given path. The file is created on the first save.
+func NewBlockCheckpoint(path string) *BlockCheckpoint {
+	return &BlockCheckpoint{path: path}
+}
+
+// Load returns the last processed block. The returned flag is false if no
+// block has been saved yet.
+func (bc *BlockCheckpoint) Load() (uint64, bool, error) {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	content, err := ioutil.ReadFile(bc.path)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf(
+			"failed to read block checkpoint [%v]: [%v]",
+			bc.path,
+			err,
+		)
+	}
+
+	block, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf(
+			"failed to parse block checkpoint [%v]: [%v]",
+			bc.path,
+			err,
+		)
+	}
+
+	return block, true, nil
+}
+
+// Save replaces the last processed block with the given one. The file is
+// replaced atomically so a failure during the save does not corrupt the
+// previously saved block.
+func (bc *BlockCheckpoint) Save(block uint64) error {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	tmpPath := bc.path + ".tmp"
+
+	err := ioutil.WriteFile(
+		tmpPath,
+		[]byte(strconv.FormatUint(block, 10)),
+		0600,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to write block checkpoint [%v]: [%v]",
+			tmpPath,
+			err,
+		)
+	}
+
+	if err := os.Rename(tmpPath, bc.path); err != nil {
+		return fmt.Errorf(
+			"failed to replace block checkpoint [%v]: [%v]",
+			bc.path,
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBlockCheckpoint_LoadWhenNotSaved(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	checkpoint := NewBlockCheckpoint(filepath.Join(dir, "block_checkpoint"))
+
+	_, ok, err := checkpoint.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok {
+		t.Errorf("expected no block checkpoint")
+	}
+}
+
+func TestBlockCheckpoint_SaveAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "block_checkpoint")
+
+	if err := NewBlockCheckpoint(path).Save(1200); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewBlockCheckpoint(path).Save(1250); err != nil {
+		t.Fatal(err)
+	}
+
+	block, ok, err := NewBlockCheckpoint(path).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ok {
+		t.Fatalf("expected block checkpoint")
+	}
+
+	if block != 1250 {
+		t.Errorf(
+			"unexpected block\nexpected: [%v]\nactual:   [%v]",
+			1250,
+			block,
+		)
+	}
+}
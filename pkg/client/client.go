@@ -12,26 +12,73 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ipfs/go-log"
 
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/bitcoin"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/node"
 	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
 )
 
 var logger = log.Logger("keep-ecdsa")
 
-// The number of block confirmations the client waits until it starts the
-// requested signing process. This value prevents from reporting unauthorized
-// signings by adversaries in case of a chain fork.
-const blockConfirmations = 12
+// Determines how long the client waits for aborted in-flight operations to
+// return when it is stopping, so their state can still be noted, e.g. in the
+// signing journal.
+const abortedOperationsTimeout = 5 * time.Second
 
 // Handle represents a handle to the ECDSA client.
 type Handle struct {
-	tssNode *node.Node
+	tssNode            *node.Node
+	fraudDetector      *signatureFraudDetector
+	clientConfig       *Config
+	ethereumChain      eth.Handle
+	keepsRegistry      *registry.Keeps
+	signingJournal     *signing.Journal
+	signingPolicy      *signingPolicy
+	registrationStatus *registrationStatusTrack
+	keepActivity       *keepActivityCache
+
+	cancel                    context.CancelFunc
+	subscriptionOnKeepCreated subscription.EventSubscription
+}
+
+// Stop stops the client. It cancels operator registration loops, unsubscribes
+// from events of all keeps and waits for in-flight key generation and signature
+// calculation to complete, no longer than the configured shutdown timeout.
+// Operations which did not complete within the timeout are aborted. Aborted
+// signing requests stay unfinished in the signing journal and are resumed when
+// the client is started again. Aborted key generations are not resumed.
+func (h *Handle) Stop() {
+	logger.Info("stopping client")
+
+	h.subscriptionOnKeepCreated.Unsubscribe()
+	h.cancel()
+
+	shutdownTimeout := h.clientConfig.GetShutdownTimeout()
+	if h.tssNode.WaitForInFlightOperations(shutdownTimeout) {
+		logger.Info("all in-flight operations completed")
+	} else {
+		logger.Warningf(
+			"in-flight operations did not complete within [%v]; "+
+				"aborting them",
+			shutdownTimeout,
+		)
+
+		h.tssNode.AbortInFlightOperations()
+		if !h.tssNode.WaitForInFlightOperations(abortedOperationsTimeout) {
+			logger.Warningf(
+				"aborted operations did not return within [%v]",
+				abortedOperationsTimeout,
+			)
+		}
+	}
+
+	logger.Info("client stopped")
 }
 
 // TSSPreParamsPoolSize returns the current size of the TSS params pool.
@@ -39,25 +86,130 @@ func (h *Handle) TSSPreParamsPoolSize() int {
 	return h.tssNode.TSSPreParamsPoolSize()
 }
 
+// ProtocolMetrics returns a snapshot of the key generation and signing
+// metrics.
+func (h *Handle) ProtocolMetrics() node.ProtocolMetrics {
+	return h.tssNode.ProtocolMetrics()
+}
+
+// KeepsCount returns the number of active keeps the client is a member of.
+func (h *Handle) KeepsCount() int {
+	return len(h.keepsRegistry.GetKeepsAddresses())
+}
+
+// PendingSignaturesCount returns the number of signing requests which are
+// in progress.
+func (h *Handle) PendingSignaturesCount() int {
+	return len(h.signingJournal.Unfinished())
+}
+
+// SigningPolicyMetrics returns a snapshot of the counters of signing requests
+// refused by the signing policy.
+func (h *Handle) SigningPolicyMetrics() SigningPolicyMetrics {
+	return h.signingPolicy.metrics()
+}
+
 // Initialize initializes the ECDSA client with rules related to events handling.
 // Expects a slice of sanctioned applications selected by the operator for which
 // operator will be registered as a member candidate.
+//
+// Signers and their snapshots are persisted in the given keeps storage.
+// Signers found only in snapshots are registered on initialization if their
+// public keys match keeps on-chain.
+//
+// Signing requests are noted in the signing journal. Requests which have not
+// been finished before the client restart, including requests refused by the
+// signing policy, are replayed on initialization.
+//
+// Key generation and signing operations are recorded in the given audit log.
+//
+// The last block whose events have been processed is saved in the given block
+// checkpoint. Keep creations, signing requests and keep closures emitted since
+// the checkpoint, e.g. while the client was offline, are replayed on
+// initialization. If there is no checkpoint yet, keeps awaiting key generation
+// are looked up within the configured lookback period.
+//
+// Signing requests are evaluated against the signing policy from the client
+// configuration before the signature calculation starts. Requests deferred by
+// the policy are evaluated again once the deferral ends. The configuration is
+// expected to be validated.
+//
+// Signatures calculated with keys of keeps over digests the keeps have never
+// been requested to sign are proved on-chain as a fraud. Such signatures are
+// looked for in transactions spending funds held by keeps on the Bitcoin
+// chain, if the Bitcoin chain is configured.
+//
+// The client runs until the parent context is done or the returned handle
+// is stopped. Key generation and signature calculation in progress are not
+// bound to the parent context so they can complete when the client is stopping.
 func Initialize(
-	ctx context.Context,
+	parentCtx context.Context,
 	operatorPublicKey *operator.PublicKey,
 	ethereumChain eth.Handle,
 	networkProvider net.Provider,
-	persistence persistence.Handle,
+	keepsStorage registry.Storage,
+	signingJournal *signing.Journal,
+	blockCheckpoint *BlockCheckpoint,
 	sanctionedApplications []common.Address,
 	clientConfig *Config,
 	tssConfig *tss.Config,
+	retryPolicies *node.RetryPolicies,
+	auditLog *audit.Log,
 ) *Handle {
-	keepsRegistry := registry.NewKeepsRegistry(persistence)
+	ctx, cancel := context.WithCancel(parentCtx)
+
+	keepsRegistry := registry.NewKeepsRegistry(keepsStorage)
 
-	tssNode := node.NewNode(ethereumChain, networkProvider, tssConfig)
+	tssNode := node.NewNode(
+		ethereumChain,
+		networkProvider,
+		tssConfig,
+		retryPolicies,
+		auditLog,
+		clientConfig.GetBlockConfirmations(),
+	)
 
 	tssNode.InitializeTSSPreParamsPool()
 
+	fraudDetector := newSignatureFraudDetector(ethereumChain, keepsRegistry)
+	if bitcoinConfig := clientConfig.Bitcoin; len(bitcoinConfig.ElectrsURL) > 0 {
+		go fraudDetector.monitorBitcoinTransactions(
+			ctx,
+			bitcoin.NewEsploraHandle(bitcoinConfig.ElectrsURL),
+			bitcoinConfig.Network,
+			bitcoinConfig.GetPollInterval(),
+		)
+	}
+
+	rewardsWithdrawal := newETHRewardsWithdrawal(
+		ethereumChain,
+		clientConfig.GetETHRewardsWithdrawalThreshold(),
+	)
+	go rewardsWithdrawal.run(ctx, clientConfig.GetETHRewardsSweepInterval())
+
+	signingPolicy := newSigningPolicy(&clientConfig.SigningPolicy, ethereumChain)
+
+	// Keep events are delivered only once they are confirmed, so the client
+	// does not act on events removed by a chain reorganization.
+	confirmedEvents := eth.NewConfirmedEvents(
+		ethereumChain,
+		clientConfig.GetBlockConfirmations(),
+	)
+
+	replayStartBlock, hasCheckpoint, err := blockCheckpoint.Load()
+	if err != nil {
+		logger.Errorf(
+			"could not load block checkpoint; events emitted while the "+
+				"client was offline are not replayed: [%v]",
+			err,
+		)
+	}
+
+	// Events are replayed concurrently for each keep. The block checkpoint
+	// is not advanced until the replay completes so events are not skipped
+	// if the client stops in the meantime.
+	replayWaitGroup := &sync.WaitGroup{}
+
 	requestedSigners := &requestedSignersTrack{
 		data:  make(map[string]bool),
 		mutex: &sync.Mutex{},
@@ -70,6 +222,32 @@ func Initialize(
 	// Load current keeps' signers from storage and register for signing events.
 	keepsRegistry.LoadExistingKeeps()
 
+	// Register signers left in snapshots by a client failure that happened
+	// between the key generation and the signer registration. Recovered keeps
+	// are monitored for signing events the same way as loaded keeps.
+	recoverSignersFromSnapshots(ethereumChain, keepsRegistry)
+
+	// Verify loaded key shares against keeps on-chain so inconsistencies are
+	// reported before they make a signing attempt fail.
+	go checkKeyShares(
+		ethereumChain,
+		keepsRegistry,
+		clientConfig.GetAwaitingKeyGenerationLookback(),
+	)
+
+	// Load unfinished signing requests. Requests of keeps which are not
+	// in the registry anymore can not be finished.
+	signingJournal.Load()
+	for _, request := range signingJournal.Unfinished() {
+		if !keepsRegistry.HasSigner(request.KeepAddress) {
+			abandonSigningRequest(
+				signingJournal,
+				request.KeepAddress,
+				request.Digest,
+			)
+		}
+	}
+
 	confirmIsInactive := func(keepAddress common.Address) bool {
 		currentBlock, err := ethereumChain.BlockCounter().CurrentBlock()
 		if err != nil {
@@ -80,7 +258,7 @@ func Initialize(
 		isKeepActive, err := chainutil.WaitForBlockConfirmations(
 			ethereumChain.BlockCounter(),
 			currentBlock,
-			blockConfirmations,
+			clientConfig.GetBlockConfirmations(),
 			func() (bool, error) {
 				return ethereumChain.IsActive(keepAddress)
 			},
@@ -98,7 +276,10 @@ func Initialize(
 	}
 
 	for _, keepAddress := range keepsRegistry.GetKeepsAddresses() {
+		replayWaitGroup.Add(1)
 		go func(keepAddress common.Address) {
+			defer replayWaitGroup.Done()
+
 			isActive, err := ethereumChain.IsActive(keepAddress)
 			if err != nil {
 				logger.Errorf(
@@ -110,6 +291,19 @@ func Initialize(
 				return
 			}
 
+			if isActive && hasCheckpoint && wasKeepClosedSince(
+				ethereumChain,
+				keepAddress,
+				replayStartBlock,
+			) {
+				logger.Warningf(
+					"keep [%s] has been closed or terminated while "+
+						"the client was offline",
+					keepAddress.String(),
+				)
+				isActive = false
+			}
+
 			if !isActive {
 				logger.Infof(
 					"keep [%s] seems no longer active; confirming",
@@ -121,6 +315,14 @@ func Initialize(
 						keepAddress.String(),
 					)
 					keepsRegistry.UnregisterKeep(keepAddress)
+					go rewardsWithdrawal.keepArchived(keepAddress)
+					for _, request := range signingJournal.UnfinishedForKeep(keepAddress) {
+						abandonSigningRequest(
+							signingJournal,
+							keepAddress,
+							request.Digest,
+						)
+					}
 					return
 				}
 				logger.Warningf("keep [%s] is still active", keepAddress.String())
@@ -138,13 +340,26 @@ func Initialize(
 				return
 			}
 
+			var replayedDigests [][32]byte
+			if hasCheckpoint {
+				replayedDigests = pastSignatureRequests(
+					ethereumChain,
+					keepAddress,
+					replayStartBlock,
+				)
+			}
+
 			subscriptionOnSignatureRequested, err := monitorSigningRequests(
 				ethereumChain,
+				confirmedEvents,
 				clientConfig,
 				tssNode,
 				keepAddress,
 				signer,
 				requestedSignatures,
+				signingJournal,
+				signingPolicy,
+				replayedDigests,
 			)
 			if err != nil {
 				logger.Errorf(
@@ -157,34 +372,94 @@ func Initialize(
 				// further processing.
 				return
 			}
+
+			subscriptionOnSignatureSubmitted, err := fraudDetector.monitorSignatureSubmissions(
+				keepAddress,
+			)
+			if err != nil {
+				logger.Errorf(
+					"failed registering for submitted signature event for keep [%s]: [%v]",
+					keepAddress.String(),
+					err,
+				)
+				subscriptionOnSignatureRequested.Unsubscribe()
+				return
+			}
+
 			go monitorKeepClosedEvents(
+				ctx,
 				ethereumChain,
+				confirmedEvents,
 				keepAddress,
 				keepsRegistry,
+				rewardsWithdrawal,
 				subscriptionOnSignatureRequested,
+				subscriptionOnSignatureSubmitted,
 			)
 			go monitorKeepTerminatedEvent(
+				ctx,
 				ethereumChain,
+				confirmedEvents,
 				keepAddress,
 				keepsRegistry,
+				rewardsWithdrawal,
 				subscriptionOnSignatureRequested,
+				subscriptionOnSignatureSubmitted,
 			)
 
 		}(keepAddress)
 	}
 
-	go checkAwaitingKeyGeneration(
-		ctx,
-		ethereumChain,
-		clientConfig,
-		tssNode,
-		operatorPublicKey,
-		keepsRegistry,
-		requestedSignatures,
-	)
+	if hasCheckpoint {
+		replayWaitGroup.Add(1)
+		go func() {
+			defer replayWaitGroup.Done()
+
+			replayKeepCreatedEvents(
+				ctx,
+				ethereumChain,
+				confirmedEvents,
+				clientConfig,
+				tssNode,
+				operatorPublicKey,
+				keepsRegistry,
+				requestedSignatures,
+				signingJournal,
+				signingPolicy,
+				fraudDetector,
+				rewardsWithdrawal,
+				replayStartBlock,
+			)
+		}()
+	} else {
+		go checkAwaitingKeyGeneration(
+			ctx,
+			ethereumChain,
+			confirmedEvents,
+			clientConfig,
+			tssNode,
+			operatorPublicKey,
+			keepsRegistry,
+			requestedSignatures,
+			signingJournal,
+			signingPolicy,
+			fraudDetector,
+			rewardsWithdrawal,
+		)
+	}
+
+	go func() {
+		replayWaitGroup.Wait()
+		trackProcessedBlocks(
+			ctx,
+			ethereumChain,
+			blockCheckpoint,
+			clientConfig.GetBlockConfirmations(),
+		)
+	}()
 
 	// Watch for new keeps creation.
-	_ = ethereumChain.OnBondedECDSAKeepCreated(func(event *eth.BondedECDSAKeepCreatedEvent) {
+	subscriptionOnKeepCreated := confirmedEvents.OnBondedECDSAKeepCreated(func(event *eth.BondedECDSAKeepCreatedEvent) {
 		logger.Infof(
 			"new keep [%s] created with members: [%x]\n",
 			event.KeepAddress.String(),
@@ -213,11 +488,16 @@ func Initialize(
 				generateKeyForKeep(
 					ctx,
 					ethereumChain,
+					confirmedEvents,
 					clientConfig,
 					tssNode,
 					operatorPublicKey,
 					keepsRegistry,
 					requestedSignatures,
+					signingJournal,
+					signingPolicy,
+					fraudDetector,
+					rewardsWithdrawal,
 					event.KeepAddress,
 					event.Members,
 					event.HonestThreshold,
@@ -226,23 +506,46 @@ func Initialize(
 		}
 	})
 
+	registrationStatus := newRegistrationStatusTrack()
 	for _, application := range sanctionedApplications {
-		go checkStatusAndRegisterForApplication(ctx, ethereumChain, application)
+		go checkStatusAndRegisterForApplication(
+			ctx,
+			ethereumChain,
+			application,
+			retryPolicies.ChainCalls,
+			clientConfig.GetBlockConfirmations(),
+			registrationStatus,
+		)
 	}
 
 	return &Handle{
-		tssNode: tssNode,
+		tssNode:                   tssNode,
+		fraudDetector:             fraudDetector,
+		clientConfig:              clientConfig,
+		ethereumChain:             ethereumChain,
+		keepsRegistry:             keepsRegistry,
+		signingJournal:            signingJournal,
+		signingPolicy:             signingPolicy,
+		registrationStatus:        registrationStatus,
+		keepActivity:              newKeepActivityCache(),
+		cancel:                    cancel,
+		subscriptionOnKeepCreated: subscriptionOnKeepCreated,
 	}
 }
 
 func checkAwaitingKeyGeneration(
 	ctx context.Context,
 	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
 	clientConfig *Config,
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepsRegistry *registry.Keeps,
 	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	fraudDetector *signatureFraudDetector,
+	rewardsWithdrawal *ethRewardsWithdrawal,
 ) {
 	keepCount, err := ethereumChain.GetKeepCount()
 	if err != nil {
@@ -298,11 +601,16 @@ func checkAwaitingKeyGeneration(
 		err = checkAwaitingKeyGenerationForKeep(
 			ctx,
 			ethereumChain,
+			confirmedEvents,
 			clientConfig,
 			tssNode,
 			operatorPublicKey,
 			keepsRegistry,
 			requestedSignatures,
+			signingJournal,
+			signingPolicy,
+			fraudDetector,
+			rewardsWithdrawal,
 			keep,
 		)
 		if err != nil {
@@ -318,11 +626,16 @@ func checkAwaitingKeyGeneration(
 func checkAwaitingKeyGenerationForKeep(
 	ctx context.Context,
 	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
 	clientConfig *Config,
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepsRegistry *registry.Keeps,
 	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	fraudDetector *signatureFraudDetector,
+	rewardsWithdrawal *ethRewardsWithdrawal,
 	keep common.Address,
 ) error {
 	publicKey, err := ethereumChain.GetPublicKey(keep)
@@ -365,11 +678,16 @@ func checkAwaitingKeyGenerationForKeep(
 			go generateKeyForKeep(
 				ctx,
 				ethereumChain,
+				confirmedEvents,
 				clientConfig,
 				tssNode,
 				operatorPublicKey,
 				keepsRegistry,
 				requestedSignatures,
+				signingJournal,
+				signingPolicy,
+				fraudDetector,
+				rewardsWithdrawal,
 				keep,
 				members,
 				honestThreshold,
@@ -385,30 +703,23 @@ func checkAwaitingKeyGenerationForKeep(
 func generateKeyForKeep(
 	ctx context.Context,
 	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
 	clientConfig *Config,
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepsRegistry *registry.Keeps,
 	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	fraudDetector *signatureFraudDetector,
+	rewardsWithdrawal *ethRewardsWithdrawal,
 	keepAddress common.Address,
 	members []common.Address,
 	honestThreshold uint64,
 ) {
-	if len(members) < 2 {
-		// TODO: #408 Implement single signer support.
-		logger.Errorf(
-			"keep [%s] has [%d] members; only keeps with at least 2 members are supported",
-			keepAddress.String(),
-			len(members),
-		)
-		return
-	}
-
-	if honestThreshold != uint64(len(members)) {
-		// TODO: #325 Implement threshold support.
+	if honestThreshold < 1 || honestThreshold > uint64(len(members)) {
 		logger.Errorf(
-			"keep [%s] has honest threshold [%s] and [%d] members; "+
-				"only keeps with honest threshold same as group size are supported",
+			"keep [%s] has invalid honest threshold [%d] for [%d] members",
 			keepAddress.String(),
 			honestThreshold,
 			len(members),
@@ -423,12 +734,12 @@ func generateKeyForKeep(
 	)
 
 	signer, err := generateSignerForKeep(
-		ctx,
 		clientConfig,
 		tssNode,
 		operatorPublicKey,
 		keepAddress,
 		members,
+		honestThreshold,
 		keepsRegistry,
 	)
 	if err != nil {
@@ -458,11 +769,15 @@ func generateKeyForKeep(
 
 	subscriptionOnSignatureRequested, err := monitorSigningRequests(
 		ethereumChain,
+		confirmedEvents,
 		clientConfig,
 		tssNode,
 		keepAddress,
 		signer,
 		requestedSignatures,
+		signingJournal,
+		signingPolicy,
+		nil,
 	)
 	if err != nil {
 		logger.Errorf(
@@ -478,30 +793,58 @@ func generateKeyForKeep(
 		return
 	}
 
+	subscriptionOnSignatureSubmitted, err := fraudDetector.monitorSignatureSubmissions(
+		keepAddress,
+	)
+	if err != nil {
+		logger.Errorf(
+			"failed on registering for submitted signature event "+
+				"for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		subscriptionOnSignatureRequested.Unsubscribe()
+		return
+	}
+
 	go monitorKeepClosedEvents(
+		ctx,
 		ethereumChain,
+		confirmedEvents,
 		keepAddress,
 		keepsRegistry,
+		rewardsWithdrawal,
 		subscriptionOnSignatureRequested,
+		subscriptionOnSignatureSubmitted,
 	)
 	go monitorKeepTerminatedEvent(
+		ctx,
 		ethereumChain,
+		confirmedEvents,
 		keepAddress,
 		keepsRegistry,
+		rewardsWithdrawal,
 		subscriptionOnSignatureRequested,
+		subscriptionOnSignatureSubmitted,
 	)
 }
 
 func generateSignerForKeep(
-	ctx context.Context,
 	clientConfig *Config,
 	tssNode *node.Node,
 	operatorPublicKey *operator.PublicKey,
 	keepAddress common.Address,
 	members []common.Address,
+	honestThreshold uint64,
 	keepsRegistry *registry.Keeps,
 ) (*tss.ThresholdSigner, error) {
-	keygenCtx, cancel := context.WithTimeout(ctx, clientConfig.GetKeyGenerationTimeout())
+	// Key generation is not bound to the client context so that it can
+	// complete when the client is stopping. It is aborted if it does not
+	// complete within the shutdown timeout.
+	keygenCtx, cancel := context.WithTimeout(
+		tssNode.OperationsContext(),
+		clientConfig.GetKeyGenerationTimeout(),
+	)
 	defer cancel()
 
 	return tssNode.GenerateSignerForKeep(
@@ -509,19 +852,25 @@ func generateSignerForKeep(
 		operatorPublicKey,
 		keepAddress,
 		members,
+		honestThreshold,
 		keepsRegistry,
 	)
 }
 
 // monitorSigningRequests registers for signature requested events emitted by
-// specific keep contract.
+// specific keep contract. Digests of signing requests replayed from the past
+// events are checked along with the latest digest of the keep.
 func monitorSigningRequests(
 	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
 	clientConfig *Config,
 	tssNode *node.Node,
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	replayedDigests [][32]byte,
 ) (subscription.EventSubscription, error) {
 	go checkAwaitingSignature(
 		ethereumChain,
@@ -530,13 +879,17 @@ func monitorSigningRequests(
 		keepAddress,
 		signer,
 		requestedSignatures,
+		signingJournal,
+		signingPolicy,
+		replayedDigests,
 	)
 
-	return ethereumChain.OnSignatureRequested(
+	return confirmedEvents.OnSignatureRequested(
 		keepAddress,
 		func(event *eth.SignatureRequestedEvent) {
 			logger.Infof(
-				"new signature requested from keep [%s] for digest [%+x] at block [%d]",
+				"new signature requested from keep [%s] for digest [%+x] "+
+					"confirmed at block [%d]",
 				keepAddress.String(),
 				event.Digest,
 				event.BlockNumber,
@@ -553,13 +906,9 @@ func monitorSigningRequests(
 				}
 				defer requestedSignatures.remove(keepAddress, event.Digest)
 
-				isAwaitingSignature, err := chainutil.WaitForBlockConfirmations(
-					ethereumChain.BlockCounter(),
-					event.BlockNumber,
-					blockConfirmations,
-					func() (bool, error) {
-						return ethereumChain.IsAwaitingSignature(keepAddress, event.Digest)
-					},
+				isAwaitingSignature, err := ethereumChain.IsAwaitingSignature(
+					keepAddress,
+					event.Digest,
 				)
 				if err != nil {
 					logger.Errorf(
@@ -577,15 +926,43 @@ func monitorSigningRequests(
 						keepAddress.String(),
 						event.Digest,
 					)
+					recordSigningRequestRefused(
+						tssNode,
+						keepAddress,
+						event.Digest,
+						"keep is not awaiting a signature",
+					)
+					return
+				}
+
+				if !awaitSigningApproval(
+					ethereumChain,
+					signingPolicy,
+					tssNode,
+					signingJournal,
+					keepAddress,
+					event.Digest,
+				) {
 					return
 				}
 
-				generateSignatureForKeep(clientConfig, tssNode, keepAddress, signer, event.Digest)
+				generateSignatureForKeep(
+					clientConfig,
+					tssNode,
+					keepAddress,
+					signer,
+					event.Digest,
+					signingJournal,
+				)
 			}(event)
 		},
 	)
 }
 
+// checkAwaitingSignature checks if the keep is awaiting a signature for its
+// latest digest, for any digest of unfinished signing requests noted in the
+// journal, e.g. before the client restart, or for any of the replayed digests,
+// and if so, generates the signature.
 func checkAwaitingSignature(
 	ethereumChain eth.Handle,
 	clientConfig *Config,
@@ -593,104 +970,348 @@ func checkAwaitingSignature(
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	replayedDigests [][32]byte,
 ) {
 	logger.Debugf("checking awaiting signature for keep [%s]", keepAddress.String())
 
+	digests := make([][32]byte, 0)
+
+	for _, request := range signingJournal.UnfinishedForKeep(keepAddress) {
+		logger.Infof(
+			"found unfinished signing request for digest [%+x] "+
+				"in state [%v] for keep [%s]",
+			request.Digest,
+			request.State,
+			keepAddress.String(),
+		)
+		digests = append(digests, request.Digest)
+	}
+
+	for _, digest := range replayedDigests {
+		if !containsDigest(digests, digest) {
+			digests = append(digests, digest)
+		}
+	}
+
 	latestDigest, err := ethereumChain.LatestDigest(keepAddress)
 	if err != nil {
 		logger.Errorf("could not get latest digest for keep [%s]", keepAddress.String())
-		return
+	} else if !containsDigest(digests, latestDigest) {
+		digests = append(digests, latestDigest)
 	}
 
-	isAwaitingDigest, err := ethereumChain.IsAwaitingSignature(keepAddress, latestDigest)
+	for _, digest := range digests {
+		checkAwaitingSignatureForDigest(
+			ethereumChain,
+			clientConfig,
+			tssNode,
+			keepAddress,
+			signer,
+			requestedSignatures,
+			signingJournal,
+			signingPolicy,
+			digest,
+		)
+	}
+}
+
+func checkAwaitingSignatureForDigest(
+	ethereumChain eth.Handle,
+	clientConfig *Config,
+	tssNode *node.Node,
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	digest [32]byte,
+) {
+	isAwaitingDigest, err := ethereumChain.IsAwaitingSignature(keepAddress, digest)
 	if err != nil {
 		logger.Errorf(
 			"could not check awaiting signature of "+
 				"digest [%+x] for keep [%s]",
-			latestDigest,
+			digest,
 			keepAddress.String(),
 		)
 		return
 	}
 
-	if isAwaitingDigest {
-		logger.Infof(
-			"awaiting a signature from keep [%s] for digest [%+x]",
+	if !isAwaitingDigest {
+		abandonSigningRequest(signingJournal, keepAddress, digest)
+		return
+	}
+
+	logger.Infof(
+		"awaiting a signature from keep [%s] for digest [%+x]",
+		keepAddress.String(),
+		digest,
+	)
+
+	if ok := requestedSignatures.add(keepAddress, digest); !ok {
+		logger.Warningf(
+			"signature requested event for keep [%s] and digest [%x] already registered",
 			keepAddress.String(),
-			latestDigest,
+			digest,
 		)
+		return
+	}
+	defer requestedSignatures.remove(keepAddress, digest)
 
-		if ok := requestedSignatures.add(keepAddress, latestDigest); !ok {
-			logger.Warningf(
-				"signature requested event for keep [%s] and digest [%x] already registered",
-				keepAddress.String(),
-				latestDigest,
-			)
-			return
+	startBlock, err := ethereumChain.SignatureRequestedBlock(keepAddress, digest)
+	if err != nil {
+		logger.Errorf(
+			"failed to get signature request block height for keep [%s] and digest [%x]: [%v]",
+			keepAddress.String(),
+			digest,
+			err,
+		)
+		return
+	}
+
+	isStillAwaitingSignature, err := chainutil.WaitForBlockConfirmations(
+		ethereumChain.BlockCounter(),
+		startBlock,
+		clientConfig.GetBlockConfirmations(),
+		func() (bool, error) {
+			isAwaitingSignature, err := ethereumChain.IsAwaitingSignature(keepAddress, digest)
+			if err != nil {
+				return false, err
+			}
+
+			isActive, err := ethereumChain.IsActive(keepAddress)
+			if err != nil {
+				return false, err
+			}
+
+			return (isAwaitingSignature && isActive), nil
+		},
+	)
+	if err != nil {
+		logger.Errorf(
+			"failed to confirm signing request for digest [%+x] and keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	if !isStillAwaitingSignature {
+		logger.Warningf(
+			"keep [%s] is not awaiting a signature for digest [%+x]",
+			keepAddress.String(),
+			digest,
+		)
+		recordSigningRequestRefused(
+			tssNode,
+			keepAddress,
+			digest,
+			"keep is not awaiting a signature or is no longer active",
+		)
+		abandonSigningRequest(signingJournal, keepAddress, digest)
+		return
+	}
+
+	if !awaitSigningApproval(
+		ethereumChain,
+		signingPolicy,
+		tssNode,
+		signingJournal,
+		keepAddress,
+		digest,
+	) {
+		return
+	}
+
+	generateSignatureForKeep(
+		clientConfig,
+		tssNode,
+		keepAddress,
+		signer,
+		digest,
+		signingJournal,
+	)
+}
+
+func containsDigest(digests [][32]byte, digest [32]byte) bool {
+	for _, d := range digests {
+		if d == digest {
+			return true
 		}
-		defer requestedSignatures.remove(keepAddress, latestDigest)
+	}
+
+	return false
+}
 
-		startBlock, err := ethereumChain.SignatureRequestedBlock(keepAddress, latestDigest)
+// abandonSigningRequest removes the signing request which can not be finished
+// from the unfinished requests of the journal.
+func abandonSigningRequest(
+	signingJournal *signing.Journal,
+	keepAddress common.Address,
+	digest [32]byte,
+) {
+	if err := signingJournal.Abandon(keepAddress, digest); err != nil {
+		logger.Errorf(
+			"failed to abandon signing request for digest [%+x] "+
+				"and keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
+}
+
+// awaitSigningApproval evaluates the signing request against the signing
+// policy and returns true if the signature should be calculated. The request
+// is noted in the journal as pending before the evaluation, so requests
+// refused by the policy stay unfinished and are evaluated again on the client
+// restart, e.g. after the policy configuration has changed. Requests deferred
+// by the policy, e.g. during a maintenance window, are evaluated again once
+// the deferral ends, as long as the keep is still active and awaits the
+// signature. Refused and deferred requests are logged and noted in the audit
+// log.
+func awaitSigningApproval(
+	ethereumChain eth.Handle,
+	signingPolicy *signingPolicy,
+	tssNode *node.Node,
+	signingJournal *signing.Journal,
+	keepAddress common.Address,
+	digest [32]byte,
+) bool {
+	if err := signingJournal.Record(
+		keepAddress,
+		digest,
+		signing.Pending,
+	); err != nil {
+		logger.Errorf(
+			"failed to record pending signing request for digest [%+x] "+
+				"and keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	for {
+		err := signingPolicy.evaluate(keepAddress, digest)
+		if err == nil {
+			return true
+		}
+
+		logger.Warningf(
+			"signing policy refused signing request for digest [%+x] "+
+				"and keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+		recordSigningRequestRefused(tssNode, keepAddress, digest, err.Error())
+
+		deferral, ok := err.(*signingDeferral)
+		if !ok {
+			return false
+		}
+
+		time.Sleep(time.Until(deferral.until))
+
+		isAwaitingSignature, err := ethereumChain.IsAwaitingSignature(
+			keepAddress,
+			digest,
+		)
 		if err != nil {
 			logger.Errorf(
-				"failed to get signature request block height for keep [%s] and digest [%x]: [%v]",
+				"failed to verify if keep [%s] is still awaiting signature "+
+					"for deferred digest [%+x]: [%v]",
 				keepAddress.String(),
-				latestDigest,
+				digest,
 				err,
 			)
-			return
+			continue
 		}
 
-		isStillAwaitingSignature, err := chainutil.WaitForBlockConfirmations(
-			ethereumChain.BlockCounter(),
-			startBlock,
-			blockConfirmations,
-			func() (bool, error) {
-				isAwaitingSignature, err := ethereumChain.IsAwaitingSignature(keepAddress, latestDigest)
-				if err != nil {
-					return false, err
-				}
-
-				isActive, err := ethereumChain.IsActive(keepAddress)
-				if err != nil {
-					return false, err
-				}
-
-				return (isAwaitingSignature && isActive), nil
-			},
-		)
+		isActive, err := ethereumChain.IsActive(keepAddress)
 		if err != nil {
 			logger.Errorf(
-				"failed to confirm signing request for digest [%+x] and keep [%s]: [%v]",
-				latestDigest,
+				"failed to verify if keep [%s] is still active: [%v]",
 				keepAddress.String(),
 				err,
 			)
-			return
+			continue
 		}
 
-		if !isStillAwaitingSignature {
-			logger.Warningf(
-				"keep [%s] is not awaiting a signature for digest [%+x]",
+		if !isAwaitingSignature || !isActive {
+			logger.Infof(
+				"keep [%s] is no longer awaiting a signature for "+
+					"deferred digest [%+x]",
 				keepAddress.String(),
-				latestDigest,
+				digest,
 			)
-			return
+			abandonSigningRequest(signingJournal, keepAddress, digest)
+			return false
 		}
 
-		generateSignatureForKeep(clientConfig, tssNode, keepAddress, signer, latestDigest)
+		logger.Infof(
+			"evaluating deferred signing request for digest [%+x] "+
+				"and keep [%s] again",
+			digest,
+			keepAddress.String(),
+		)
 	}
 }
 
+// recordSigningRequestRefused notes in the audit log that the signing process
+// has not been started for the requested digest.
+func recordSigningRequestRefused(
+	tssNode *node.Node,
+	keepAddress common.Address,
+	digest [32]byte,
+	reason string,
+) {
+	tssNode.AuditLog().Record(
+		audit.SigningRequestRefused,
+		keepAddress,
+		digest,
+		map[string]string{"reason": reason},
+	)
+}
+
 func generateSignatureForKeep(
 	clientConfig *Config,
 	tssNode *node.Node,
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 	digest [32]byte,
+	signingJournal *signing.Journal,
 ) {
+	tssNode.AuditLog().Record(
+		audit.SigningRequestAccepted,
+		keepAddress,
+		digest,
+		nil,
+	)
+
+	if err := signingJournal.Record(
+		keepAddress,
+		digest,
+		signing.Confirmed,
+	); err != nil {
+		logger.Errorf(
+			"failed to record signing request for digest [%+x] "+
+				"and keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	// Signing is not bound to the client context so that it can complete
+	// when the client is stopping. It is aborted if it does not complete
+	// within the shutdown timeout.
 	signingCtx, cancel := context.WithTimeout(
-		context.Background(),
+		tssNode.OperationsContext(),
 		clientConfig.GetSigningTimeout(),
 	)
 	defer cancel()
@@ -699,6 +1320,7 @@ func generateSignatureForKeep(
 		signingCtx,
 		signer,
 		digest,
+		signingJournal,
 	); err != nil {
 		logger.Errorf(
 			"signature calculation failed for keep [%s]: [%v]",
@@ -709,48 +1331,30 @@ func generateSignatureForKeep(
 }
 
 // monitorKeepClosedEvent monitors KeepClosed event and if that event happens
-// unsubscribes from signing event for the given keep and unregisters it from
-// the keep registry.
+// cancels the given keep subscriptions and unregisters the keep from the keep
+// registry. Subscriptions are cancelled also when the context is done.
 func monitorKeepClosedEvents(
+	ctx context.Context,
 	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
 	keepAddress common.Address,
 	keepsRegistry *registry.Keeps,
-	subscriptionOnSignatureRequested subscription.EventSubscription,
+	rewardsWithdrawal *ethRewardsWithdrawal,
+	keepSubscriptions ...subscription.EventSubscription,
 ) {
 	keepClosed := make(chan *eth.KeepClosedEvent)
 
-	subscriptionOnKeepClosed, err := ethereumChain.OnKeepClosed(
+	subscriptionOnKeepClosed, err := confirmedEvents.OnKeepClosed(
 		keepAddress,
 		func(event *eth.KeepClosedEvent) {
 			logger.Infof(
-				"keep [%s] closed event received at block [%d]",
+				"keep [%s] closed event confirmed at block [%d]",
 				keepAddress.String(),
 				event.BlockNumber,
 			)
 
-			isKeepActive, err := chainutil.WaitForBlockConfirmations(
-				ethereumChain.BlockCounter(),
-				event.BlockNumber,
-				blockConfirmations,
-				func() (bool, error) {
-					return ethereumChain.IsActive(keepAddress)
-				},
-			)
-			if err != nil {
-				logger.Errorf(
-					"failed to confirm keep [%s] closure: [%v]",
-					keepAddress.String(),
-					err,
-				)
-				return
-			}
-
-			if isKeepActive {
-				logger.Warningf("keep [%s] has not been closed", keepAddress.String())
-				return
-			}
-
 			keepsRegistry.UnregisterKeep(keepAddress)
+			go rewardsWithdrawal.keepArchived(keepAddress)
 			keepClosed <- event
 		},
 	)
@@ -765,56 +1369,46 @@ func monitorKeepClosedEvents(
 	}
 
 	defer subscriptionOnKeepClosed.Unsubscribe()
-	defer subscriptionOnSignatureRequested.Unsubscribe()
-
-	<-keepClosed
+	for _, keepSubscription := range keepSubscriptions {
+		defer keepSubscription.Unsubscribe()
+	}
 
-	logger.Info("unsubscribing from events on keep closed")
+	select {
+	case <-keepClosed:
+		logger.Info("unsubscribing from events on keep closed")
+	case <-ctx.Done():
+		logger.Infof(
+			"unsubscribing from events of keep [%s] on client stop",
+			keepAddress.String(),
+		)
+	}
 }
 
 // monitorKeepTerminatedEvent monitors KeepTerminated event and if that event
-// happens unsubscribes from signing event for the given keep and unregisters it
-// from the keep registry.
+// happens cancels the given keep subscriptions and unregisters the keep from
+// the keep registry. Subscriptions are cancelled also when the context is done.
 func monitorKeepTerminatedEvent(
+	ctx context.Context,
 	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
 	keepAddress common.Address,
 	keepsRegistry *registry.Keeps,
-	subscriptionOnSignatureRequested subscription.EventSubscription,
+	rewardsWithdrawal *ethRewardsWithdrawal,
+	keepSubscriptions ...subscription.EventSubscription,
 ) {
 	keepTerminated := make(chan *eth.KeepTerminatedEvent)
 
-	subscriptionOnKeepTerminated, err := ethereumChain.OnKeepTerminated(
+	subscriptionOnKeepTerminated, err := confirmedEvents.OnKeepTerminated(
 		keepAddress,
 		func(event *eth.KeepTerminatedEvent) {
 			logger.Warningf(
-				"keep [%s] terminated event received at block [%d]",
+				"keep [%s] terminated event confirmed at block [%d]",
 				keepAddress.String(),
 				event.BlockNumber,
 			)
 
-			isKeepActive, err := chainutil.WaitForBlockConfirmations(
-				ethereumChain.BlockCounter(),
-				event.BlockNumber,
-				blockConfirmations,
-				func() (bool, error) {
-					return ethereumChain.IsActive(keepAddress)
-				},
-			)
-			if err != nil {
-				logger.Errorf(
-					"failed to confirm keep [%s] termination: [%v]",
-					keepAddress.String(),
-					err,
-				)
-				return
-			}
-
-			if isKeepActive {
-				logger.Warningf("keep [%s] has not been terminated", keepAddress.String())
-				return
-			}
-
 			keepsRegistry.UnregisterKeep(keepAddress)
+			go rewardsWithdrawal.keepArchived(keepAddress)
 			keepTerminated <- event
 		},
 	)
@@ -829,9 +1423,17 @@ func monitorKeepTerminatedEvent(
 	}
 
 	defer subscriptionOnKeepTerminated.Unsubscribe()
-	defer subscriptionOnSignatureRequested.Unsubscribe()
-
-	<-keepTerminated
+	for _, keepSubscription := range keepSubscriptions {
+		defer keepSubscription.Unsubscribe()
+	}
 
-	logger.Info("unsubscribing from events on keep terminated")
+	select {
+	case <-keepTerminated:
+		logger.Info("unsubscribing from events on keep terminated")
+	case <-ctx.Done():
+		logger.Infof(
+			"unsubscribing from events of keep [%s] on client stop",
+			keepAddress.String(),
+		)
+	}
 }
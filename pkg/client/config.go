@@ -1,6 +1,7 @@
 package client
 
 import (
+	"math/big"
 	"time"
 
 	configtime "github.com/keep-network/keep-ecdsa/internal/config/time"
@@ -20,6 +21,26 @@ const (
 
 	// The default value of a timeout for a signature calculation.
 	defaultSigningTimeout = 2 * time.Hour
+
+	// The default value of a time the client waits for in-flight key
+	// generation and signature calculation to complete when it is stopping.
+	defaultShutdownTimeout = 20 * time.Second
+
+	// The default number of blocks which should be mined on top of the block
+	// with a keep event or a chain state change before the client acts on it.
+	defaultBlockConfirmations = 12
+
+	// The default minimum member ETH balance in wei which is withdrawn from
+	// a closed or terminated keep, 0.1 ETH.
+	defaultETHRewardsWithdrawalThreshold = 100000000000000000
+
+	// The default interval of withdrawing member ETH balances from closed and
+	// terminated keeps.
+	defaultETHRewardsSweepInterval = 24 * time.Hour
+
+	// The default interval of checking bitcoin transactions spending funds
+	// held by keeps for a signature fraud.
+	defaultBitcoinPollInterval = 10 * time.Minute
 )
 
 // Config contains configuration for tss protocol execution.
@@ -32,6 +53,51 @@ type Config struct {
 	// Timeout for key generation and signature calculation.
 	KeyGenerationTimeout configtime.Duration
 	SigningTimeout       configtime.Duration
+
+	// Defines how long the client waits for in-flight key generation and
+	// signature calculation to complete when it is stopping.
+	ShutdownTimeout configtime.Duration
+
+	// Number of blocks which should be mined on top of the block with a keep
+	// event or a chain state change before the client acts on it. Events are
+	// delivered to the client only after they are confirmed.
+	BlockConfirmations uint64
+
+	// Rules evaluated before the client starts calculating a signature.
+	SigningPolicy SigningPolicyConfig
+
+	// Minimum member ETH balance in wei withdrawn to the beneficiary from
+	// a closed or terminated keep and the interval of checking balances of
+	// all closed and terminated keeps.
+	ETHRewardsWithdrawalThreshold uint64
+	ETHRewardsSweepInterval       configtime.Duration
+
+	// Bitcoin chain observed for signatures calculated with keys of keeps
+	// over digests the keeps have never been requested to sign.
+	Bitcoin BitcoinConfig
+}
+
+// BitcoinConfig contains configuration of the Bitcoin chain observed for
+// signature frauds. The chain is not observed if the Esplora API URL is not
+// set.
+type BitcoinConfig struct {
+	// URL of the Esplora REST API, e.g. exposed by an Electrs server.
+	ElectrsURL string
+	// Bitcoin network of keeps' addresses: mainnet, testnet or regtest.
+	Network string
+	// Interval of checking transactions spending funds held by keeps.
+	PollInterval configtime.Duration
+}
+
+// GetPollInterval returns the interval of checking transactions spending
+// funds held by keeps. If a value is not set it returns a default value.
+func (c *BitcoinConfig) GetPollInterval() time.Duration {
+	interval := c.PollInterval.ToDuration()
+	if interval == 0 {
+		interval = defaultBitcoinPollInterval
+	}
+
+	return interval
 }
 
 // GetAwaitingKeyGenerationLookback returns a look-back period to check if
@@ -67,3 +133,49 @@ func (c *Config) GetSigningTimeout() time.Duration {
 
 	return timeout
 }
+
+// GetShutdownTimeout returns a time the client waits for in-flight key
+// generation and signature calculation to complete when it is stopping. If a
+// value is not set it returns a default value.
+func (c *Config) GetShutdownTimeout() time.Duration {
+	timeout := c.ShutdownTimeout.ToDuration()
+	if timeout == 0 {
+		timeout = defaultShutdownTimeout
+	}
+
+	return timeout
+}
+
+// GetBlockConfirmations returns the number of blocks which should be mined on
+// top of the block with a keep event or a chain state change before the client
+// acts on it. If a value is not set it returns a default value.
+func (c *Config) GetBlockConfirmations() uint64 {
+	if c.BlockConfirmations == 0 {
+		return defaultBlockConfirmations
+	}
+
+	return c.BlockConfirmations
+}
+
+// GetETHRewardsWithdrawalThreshold returns the minimum member ETH balance in
+// wei which is withdrawn from a closed or terminated keep. If a value is not
+// set it returns a default value.
+func (c *Config) GetETHRewardsWithdrawalThreshold() *big.Int {
+	if c.ETHRewardsWithdrawalThreshold == 0 {
+		return big.NewInt(defaultETHRewardsWithdrawalThreshold)
+	}
+
+	return new(big.Int).SetUint64(c.ETHRewardsWithdrawalThreshold)
+}
+
+// GetETHRewardsSweepInterval returns the interval of withdrawing member ETH
+// balances from closed and terminated keeps. If a value is not set it returns
+// a default value.
+func (c *Config) GetETHRewardsSweepInterval() time.Duration {
+	interval := c.ETHRewardsSweepInterval.ToDuration()
+	if interval == 0 {
+		interval = defaultETHRewardsSweepInterval
+	}
+
+	return interval
+}
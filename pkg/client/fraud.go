@@ -0,0 +1,400 @@
+package client
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/bitcoin"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/utils"
+	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
+)
+
+// signatureFraudDetector looks for signatures calculated with keys of keeps
+// the client is a member of over digests the keeps have never been requested
+// to sign. Such signatures can be produced only by a colluding majority of
+// keep members. Every detected fraud is proved on-chain which results in
+// punishing the keep members.
+type signatureFraudDetector struct {
+	ethereumChain eth.Handle
+	keepsRegistry *registry.Keeps
+	signedDigests *signedDigestsTrack
+}
+
+func newSignatureFraudDetector(
+	ethereumChain eth.Handle,
+	keepsRegistry *registry.Keeps,
+) *signatureFraudDetector {
+	return &signatureFraudDetector{
+		ethereumChain: ethereumChain,
+		keepsRegistry: keepsRegistry,
+		signedDigests: &signedDigestsTrack{
+			data:  make(map[string]map[string]bool),
+			mutex: &sync.Mutex{},
+		},
+	}
+}
+
+// monitorSignatureSubmissions registers for signature submitted events emitted
+// by the given keep. The keep accepts signatures only over the digest it has
+// been requested to sign, so digests of submitted signatures are noted as
+// legitimately signed and they don't have to be checked for a fraud when seen
+// again, e.g. on the Bitcoin chain. Noted digests are forgotten once the
+// returned subscription is cancelled.
+func (sfd *signatureFraudDetector) monitorSignatureSubmissions(
+	keepAddress common.Address,
+) (subscription.EventSubscription, error) {
+	subscriptionOnSignatureSubmitted, err := sfd.ethereumChain.OnSignatureSubmitted(
+		keepAddress,
+		func(event *eth.SignatureSubmittedEvent) {
+			logger.Infof(
+				"signature for digest [%+x] submitted to keep [%s] at block [%d]",
+				event.Digest,
+				keepAddress.String(),
+				event.BlockNumber,
+			)
+
+			sfd.signedDigests.add(keepAddress, event.Digest)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription.NewEventSubscription(func() {
+		subscriptionOnSignatureSubmitted.Unsubscribe()
+		sfd.signedDigests.removeKeep(keepAddress)
+	}), nil
+}
+
+// monitorBitcoinTransactions periodically looks up Bitcoin transactions
+// spending funds locked to P2WPKH addresses of keeps the client is a member
+// of and checks their signatures for a fraud. Every transaction is checked
+// once; transactions which could not be checked are checked again in the
+// next round. It runs until the context is done.
+func (sfd *signatureFraudDetector) monitorBitcoinTransactions(
+	ctx context.Context,
+	bitcoinHandle bitcoin.Handle,
+	network string,
+	interval time.Duration,
+) {
+	checkedTransactions := make(map[common.Address]map[string]bool)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		keepsAddresses := sfd.keepsRegistry.GetKeepsAddresses()
+
+		keeps := make(map[common.Address]bool)
+		for _, keepAddress := range keepsAddresses {
+			keeps[keepAddress] = true
+
+			if _, ok := checkedTransactions[keepAddress]; !ok {
+				checkedTransactions[keepAddress] = make(map[string]bool)
+			}
+
+			sfd.checkBitcoinAddress(
+				keepAddress,
+				bitcoinHandle,
+				network,
+				checkedTransactions[keepAddress],
+			)
+		}
+
+		// Forget transactions of keeps the client is no longer a member of.
+		for keepAddress := range checkedTransactions {
+			if !keeps[keepAddress] {
+				delete(checkedTransactions, keepAddress)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkBitcoinAddress checks transactions spending funds locked to the P2WPKH
+// address of the given keep for a fraud. Transactions already checked are
+// skipped and transactions checked successfully are noted in the given map.
+func (sfd *signatureFraudDetector) checkBitcoinAddress(
+	keepAddress common.Address,
+	bitcoinHandle bitcoin.Handle,
+	network string,
+	checkedTransactions map[string]bool,
+) {
+	signer, err := sfd.keepsRegistry.GetSigner(keepAddress)
+	if err != nil {
+		logger.Errorf(
+			"failed to get signer of keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	address, err := bitcoin.WitnessPublicKeyHashAddress(
+		(*cecdsa.PublicKey)(signer.PublicKey()),
+		network,
+	)
+	if err != nil {
+		logger.Errorf(
+			"failed to determine bitcoin address of keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	transactions, err := bitcoinHandle.SpendingTransactions(address)
+	if err != nil {
+		logger.Warningf(
+			"failed to get bitcoin transactions of keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return
+	}
+
+	for _, transaction := range transactions {
+		if checkedTransactions[transaction.ID] {
+			continue
+		}
+
+		if err := sfd.checkBitcoinTransaction(
+			transaction.Raw,
+			transaction.InputValues,
+		); err != nil {
+			logger.Errorf(
+				"failed to check bitcoin transaction [%s] of keep [%s] "+
+					"for a fraud: [%v]",
+				transaction.ID,
+				keepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		checkedTransactions[transaction.ID] = true
+	}
+}
+
+// checkBitcoinTransaction checks signatures of the given bitcoin transaction
+// inputs for a fraud. Values of the outputs spent by the transaction inputs
+// have to be provided in the order of the inputs.
+func (sfd *signatureFraudDetector) checkBitcoinTransaction(
+	rawTransaction []byte,
+	inputValues []int64,
+) error {
+	msgTx, err := utils.DeserializeTransaction(rawTransaction)
+	if err != nil {
+		return err
+	}
+
+	witnessSignatures, err := utils.ExtractWitnessSignatures(msgTx, inputValues)
+	if err != nil {
+		return fmt.Errorf("failed to extract signatures: [%v]", err)
+	}
+
+	for _, witnessSignature := range witnessSignatures {
+		keepAddress, ok := sfd.findKeep(witnessSignature.PublicKey)
+		if !ok {
+			continue
+		}
+
+		signature, err := withRecoveryID(
+			witnessSignature.PublicKey,
+			witnessSignature.Digest,
+			witnessSignature.R,
+			witnessSignature.S,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to determine recovery ID of signature "+
+					"of input [%d]: [%v]",
+				witnessSignature.InputIndex,
+				err,
+			)
+		}
+
+		if err := sfd.checkSignature(
+			keepAddress,
+			signature,
+			witnessSignature.Digest,
+			witnessSignature.Preimage,
+		); err != nil {
+			return fmt.Errorf(
+				"failed to check signature of input [%d] for a fraud: [%v]",
+				witnessSignature.InputIndex,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// checkSignature checks if the signature calculated with the key of the given
+// keep is a fraud and if so, submits a fraud proof to the keep.
+func (sfd *signatureFraudDetector) checkSignature(
+	keepAddress common.Address,
+	signature *ecdsa.Signature,
+	signedDigest [32]byte,
+	preimage []byte,
+) error {
+	if sfd.signedDigests.has(keepAddress, signedDigest) {
+		logger.Debugf(
+			"digest [%+x] has been signed by keep [%s] on request",
+			signedDigest,
+			keepAddress.String(),
+		)
+		return nil
+	}
+
+	isFraud, err := sfd.ethereumChain.CheckSignatureFraud(
+		keepAddress,
+		signature,
+		signedDigest,
+		preimage,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check signature fraud: [%v]", err)
+	}
+
+	if !isFraud {
+		return nil
+	}
+
+	logger.Warningf(
+		"detected signature fraud for keep [%s]; digest [%+x] "+
+			"has never been requested to sign; submitting fraud proof",
+		keepAddress.String(),
+		signedDigest,
+	)
+
+	if err := sfd.ethereumChain.SubmitSignatureFraud(
+		keepAddress,
+		signature,
+		signedDigest,
+		preimage,
+	); err != nil {
+		return fmt.Errorf("failed to submit signature fraud: [%v]", err)
+	}
+
+	return nil
+}
+
+// findKeep looks for a registered keep with the given public key.
+func (sfd *signatureFraudDetector) findKeep(
+	publicKey *cecdsa.PublicKey,
+) (common.Address, bool) {
+	for _, keepAddress := range sfd.keepsRegistry.GetKeepsAddresses() {
+		signer, err := sfd.keepsRegistry.GetSigner(keepAddress)
+		if err != nil {
+			continue
+		}
+
+		keepPublicKey := signer.PublicKey()
+		if keepPublicKey.X.Cmp(publicKey.X) == 0 &&
+			keepPublicKey.Y.Cmp(publicKey.Y) == 0 {
+			return keepAddress, true
+		}
+	}
+
+	return common.Address{}, false
+}
+
+// withRecoveryID determines the recovery ID of the signature calculated with
+// the given public key over the given digest. Value of `s` is normalized to
+// the lower half of the curve order as required for ethereum signatures.
+func withRecoveryID(
+	publicKey *cecdsa.PublicKey,
+	digest [32]byte,
+	r *big.Int,
+	s *big.Int,
+) (*ecdsa.Signature, error) {
+	curveOrder := crypto.S256().Params().N
+	if s.Cmp(new(big.Int).Rsh(curveOrder, 1)) > 0 {
+		s = new(big.Int).Sub(curveOrder, s)
+	}
+
+	rBytes, err := byteutils.LeftPadTo32Bytes(r.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	sBytes, err := byteutils.LeftPadTo32Bytes(s.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	for recoveryID := 0; recoveryID < 2; recoveryID++ {
+		serialized := make([]byte, 0, 65)
+		serialized = append(serialized, rBytes...)
+		serialized = append(serialized, sBytes...)
+		serialized = append(serialized, byte(recoveryID))
+
+		recoveredPublicKey, err := crypto.SigToPub(digest[:], serialized)
+		if err != nil {
+			continue
+		}
+
+		if recoveredPublicKey.X.Cmp(publicKey.X) == 0 &&
+			recoveredPublicKey.Y.Cmp(publicKey.Y) == 0 {
+			return &ecdsa.Signature{
+				R:          r,
+				S:          s,
+				RecoveryID: recoveryID,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signature does not match the public key")
+}
+
+// signedDigestsTrack is used to track digests which were signed by keeps
+// on request and published on-chain.
+type signedDigestsTrack struct {
+	data  map[string]map[string]bool // <keep, <digest, bool>>
+	mutex *sync.Mutex
+}
+
+func (sdt *signedDigestsTrack) add(keepAddress common.Address, digest [32]byte) {
+	sdt.mutex.Lock()
+	defer sdt.mutex.Unlock()
+
+	keepDigests, ok := sdt.data[keepAddress.String()]
+	if !ok {
+		keepDigests = make(map[string]bool)
+		sdt.data[keepAddress.String()] = keepDigests
+	}
+
+	keepDigests[hex.EncodeToString(digest[:])] = true
+}
+
+func (sdt *signedDigestsTrack) has(keepAddress common.Address, digest [32]byte) bool {
+	sdt.mutex.Lock()
+	defer sdt.mutex.Unlock()
+
+	return sdt.data[keepAddress.String()][hex.EncodeToString(digest[:])]
+}
+
+func (sdt *signedDigestsTrack) removeKeep(keepAddress common.Address) {
+	sdt.mutex.Lock()
+	defer sdt.mutex.Unlock()
+
+	delete(sdt.data, keepAddress.String())
+}
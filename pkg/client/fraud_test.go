@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"crypto/sha256"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+)
+
+func TestWithRecoveryID(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("fraudulent digest"))
+
+	serialized, err := crypto.Sign(digest[:], privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signature, err := withRecoveryID(
+		&privateKey.PublicKey,
+		digest,
+		new(big.Int).SetBytes(serialized[:32]),
+		new(big.Int).SetBytes(serialized[32:64]),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if signature.RecoveryID != int(serialized[64]) {
+		t.Errorf(
+			"unexpected recovery ID\nexpected: [%d]\nactual:   [%d]",
+			serialized[64],
+			signature.RecoveryID,
+		)
+	}
+}
+
+func TestWithRecoveryID_HighS(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("fraudulent digest"))
+
+	serialized, err := crypto.Sign(digest[:], privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lowS := new(big.Int).SetBytes(serialized[32:64])
+	highS := new(big.Int).Sub(crypto.S256().Params().N, lowS)
+
+	signature, err := withRecoveryID(
+		&privateKey.PublicKey,
+		digest,
+		new(big.Int).SetBytes(serialized[:32]),
+		highS,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if signature.S.Cmp(lowS) != 0 {
+		t.Errorf(
+			"unexpected s\nexpected: [%x]\nactual:   [%x]",
+			lowS,
+			signature.S,
+		)
+	}
+}
+
+func TestWithRecoveryID_OtherKey(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherPrivateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("fraudulent digest"))
+
+	serialized, err := crypto.Sign(digest[:], privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = withRecoveryID(
+		&otherPrivateKey.PublicKey,
+		digest,
+		new(big.Int).SetBytes(serialized[:32]),
+		new(big.Int).SetBytes(serialized[32:64]),
+	)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestSignedDigestsTrack(t *testing.T) {
+	keepAddress1 := common.BytesToAddress([]byte{1})
+	keepAddress2 := common.BytesToAddress([]byte{2})
+	digest := [32]byte{1}
+
+	sdt := &signedDigestsTrack{
+		data:  make(map[string]map[string]bool),
+		mutex: &sync.Mutex{},
+	}
+
+	sdt.add(keepAddress1, digest)
+
+	if !sdt.has(keepAddress1, digest) {
+		t.Error("digest should be tracked for the first keep")
+	}
+	if sdt.has(keepAddress2, digest) {
+		t.Error("digest should not be tracked for the second keep")
+	}
+
+	sdt.removeKeep(keepAddress1)
+
+	if sdt.has(keepAddress1, digest) {
+		t.Error("digest should not be tracked after keep removal")
+	}
+}
+
+func TestCheckSignature_SubmitsFraud(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain, keepAddress, privateKey := openKeepWithPublicKey(t, ctx)
+
+	preimage := []byte("unauthorized preimage")
+	digest, signature := signDigest(t, privateKey, preimage)
+
+	fraudDetector := newSignatureFraudDetector(chain, nil)
+
+	if err := fraudDetector.checkSignature(
+		keepAddress,
+		signature,
+		digest,
+		preimage,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	isActive, err := chain.IsActive(keepAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isActive {
+		t.Error("keep should be terminated after fraud submission")
+	}
+}
+
+func TestCheckSignature_SkipsSignedDigest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain, keepAddress, privateKey := openKeepWithPublicKey(t, ctx)
+
+	preimage := []byte("signed preimage")
+	digest, signature := signDigest(t, privateKey, preimage)
+
+	fraudDetector := newSignatureFraudDetector(chain, nil)
+	fraudDetector.signedDigests.add(keepAddress, digest)
+
+	if err := fraudDetector.checkSignature(
+		keepAddress,
+		signature,
+		digest,
+		preimage,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	isActive, err := chain.IsActive(keepAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isActive {
+		t.Error("keep should stay active")
+	}
+}
+
+func openKeepWithPublicKey(
+	t *testing.T,
+	ctx context.Context,
+) (local.Chain, common.Address, *cecdsa.PrivateKey) {
+	chain := local.Connect(ctx)
+	keepAddress := common.HexToAddress("0x41048F9B90290A2e96D07f537F3A7E97620E9e47")
+
+	chain.OpenKeep(keepAddress, []common.Address{chain.Address()})
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keepPublicKey [64]byte
+	copy(keepPublicKey[:], crypto.FromECDSAPub(&privateKey.PublicKey)[1:])
+
+	if _, err := chain.SubmitKeepPublicKey(keepAddress, keepPublicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	return chain, keepAddress, privateKey
+}
+
+func signDigest(
+	t *testing.T,
+	privateKey *cecdsa.PrivateKey,
+	preimage []byte,
+) ([32]byte, *ecdsa.Signature) {
+	digest := sha256.Sum256(preimage)
+
+	serialized, err := crypto.Sign(digest[:], privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return digest, &ecdsa.Signature{
+		R:          new(big.Int).SetBytes(serialized[:32]),
+		S:          new(big.Int).SetBytes(serialized[32:64]),
+		RecoveryID: int(serialized[64]),
+	}
+}
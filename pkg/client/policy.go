@@ -0,0 +1,338 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	configtime "github.com/keep-network/keep-ecdsa/internal/config/time"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// The default period of the per-keep signature rate limit.
+const defaultRateLimitPeriod = 24 * time.Hour
+
+// Determines how long the signing request is deferred when the keep owner
+// could not be determined.
+const keepOwnerRetryDelay = 1 * time.Minute
+
+// SigningPolicyConfig contains rules evaluated before the client starts
+// calculating a signature requested by a keep. Requests which do not satisfy
+// the rules are refused. Requests refused only temporarily, e.g. during
+// a maintenance window, are deferred and evaluated again once the deferral
+// ends. Refusing signing requests may result in the keep being terminated
+// and bonds seized if not enough other members sign.
+type SigningPolicyConfig struct {
+	// Refuses all signing requests when set.
+	SigningDisabled bool
+
+	// Maximum number of signing requests accepted for a single keep within
+	// the rate limit period. Each requested digest is counted once. If not
+	// set, the number is not limited.
+	MaxSignaturesPerKeep int
+	RateLimitPeriod      configtime.Duration
+
+	// Addresses of keep owners whose signing requests are accepted. If not
+	// set, requests from all owners are accepted unless they are denied.
+	AllowedOwners []string
+	// Addresses of keep owners whose signing requests are refused.
+	DeniedOwners []string
+
+	// Periods during which all signing requests are refused.
+	MaintenanceWindows []MaintenanceWindow
+}
+
+// MaintenanceWindow is a period during which the client refuses to sign.
+// If the repeat interval is set, the window recurs with the interval since
+// its start, e.g. every 24 hours. Otherwise, the window happens once.
+type MaintenanceWindow struct {
+	Start  time.Time
+	End    time.Time
+	Repeat configtime.Duration
+}
+
+// activeUntil checks if the maintenance window or its recurrence lasts at
+// the given time and returns the end of it.
+func (mw *MaintenanceWindow) activeUntil(now time.Time) (time.Time, bool) {
+	if now.Before(mw.Start) {
+		return time.Time{}, false
+	}
+
+	start := mw.Start
+	if repeat := mw.Repeat.ToDuration(); repeat > 0 {
+		start = start.Add(now.Sub(start) / repeat * repeat)
+	}
+
+	end := start.Add(mw.End.Sub(mw.Start))
+	if !now.Before(end) {
+		return time.Time{}, false
+	}
+
+	return end, true
+}
+
+// Validate checks if the signing policy configuration is correct.
+func (c *SigningPolicyConfig) Validate() error {
+	if c.MaxSignaturesPerKeep < 0 {
+		return fmt.Errorf(
+			"maximum number of signatures per keep [%v] must not be negative",
+			c.MaxSignaturesPerKeep,
+		)
+	}
+
+	for _, owners := range [][]string{c.AllowedOwners, c.DeniedOwners} {
+		for _, owner := range owners {
+			if !common.IsHexAddress(owner) {
+				return fmt.Errorf(
+					"keep owner address [%v] is not valid hex address",
+					owner,
+				)
+			}
+		}
+	}
+
+	for _, window := range c.MaintenanceWindows {
+		if !window.End.After(window.Start) {
+			return fmt.Errorf(
+				"maintenance window end [%v] is not after its start [%v]",
+				window.End,
+				window.Start,
+			)
+		}
+
+		repeat := window.Repeat.ToDuration()
+		if repeat < 0 {
+			return fmt.Errorf(
+				"maintenance window repeat interval [%v] must not be negative",
+				repeat,
+			)
+		}
+		if repeat > 0 && window.End.Sub(window.Start) >= repeat {
+			return fmt.Errorf(
+				"maintenance window starting at [%v] is not shorter than "+
+					"its repeat interval [%v]",
+				window.Start,
+				repeat,
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetRateLimitPeriod returns the period of the per-keep signature rate limit.
+// If a value is not set it returns a default value.
+func (c *SigningPolicyConfig) GetRateLimitPeriod() time.Duration {
+	period := c.RateLimitPeriod.ToDuration()
+	if period == 0 {
+		period = defaultRateLimitPeriod
+	}
+
+	return period
+}
+
+// SigningPolicyMetrics contains counters of signing requests refused by the
+// signing policy. Counters only grow for the lifetime of the client.
+type SigningPolicyMetrics struct {
+	// Number of requests refused because signing is disabled.
+	SigningDisabledRefusals int64
+	// Number of requests refused during maintenance windows.
+	MaintenanceWindowRefusals int64
+	// Number of requests refused because of the keep owner.
+	OwnerRefusals int64
+	// Number of requests refused because of the per-keep rate limit.
+	RateLimitRefusals int64
+}
+
+// signingDeferral is returned by the signing policy when the signing request
+// is refused only temporarily and should be evaluated again at the given time.
+type signingDeferral struct {
+	reason string
+	until  time.Time
+}
+
+func (sd *signingDeferral) Error() string {
+	return fmt.Sprintf("%s; deferred until [%v]", sd.reason, sd.until)
+}
+
+// signingPolicy evaluates signing requests against the configured rules.
+// Signing requests accepted for each keep are tracked in memory, so the rate
+// limit starts from scratch when the client is restarted.
+type signingPolicy struct {
+	// Accessed atomically, kept as the first field to guarantee 64-bit
+	// alignment.
+	refusalsCounters SigningPolicyMetrics
+
+	config        *SigningPolicyConfig
+	ethereumChain eth.Handle
+
+	allowedOwners map[common.Address]bool
+	deniedOwners  map[common.Address]bool
+
+	mutex            sync.Mutex
+	keepOwners       map[common.Address]common.Address
+	acceptedSignings map[common.Address]map[[32]byte]time.Time
+	now              func() time.Time
+}
+
+// newSigningPolicy creates a signing policy for the given configuration.
+// The configuration is expected to be validated.
+func newSigningPolicy(
+	config *SigningPolicyConfig,
+	ethereumChain eth.Handle,
+) *signingPolicy {
+	toAddresses := func(addresses []string) map[common.Address]bool {
+		result := make(map[common.Address]bool)
+		for _, address := range addresses {
+			result[common.HexToAddress(address)] = true
+		}
+		return result
+	}
+
+	return &signingPolicy{
+		config:           config,
+		ethereumChain:    ethereumChain,
+		allowedOwners:    toAddresses(config.AllowedOwners),
+		deniedOwners:     toAddresses(config.DeniedOwners),
+		keepOwners:       make(map[common.Address]common.Address),
+		acceptedSignings: make(map[common.Address]map[[32]byte]time.Time),
+		now:              time.Now,
+	}
+}
+
+// evaluate checks if the signing request for the given keep and digest
+// satisfies the policy. It returns an error describing the reason of the
+// refusal if the request should be refused. If the request is refused only
+// temporarily, the error is a *signingDeferral. Accepted requests are counted
+// towards the keep's rate limit, if the limit is configured. Requests for the
+// same digest are counted once.
+func (sp *signingPolicy) evaluate(
+	keepAddress common.Address,
+	digest [32]byte,
+) error {
+	if sp.config.SigningDisabled {
+		increment(&sp.refusalsCounters.SigningDisabledRefusals)
+		return fmt.Errorf("signing is disabled")
+	}
+
+	now := sp.now()
+
+	for _, window := range sp.config.MaintenanceWindows {
+		if end, ok := window.activeUntil(now); ok {
+			increment(&sp.refusalsCounters.MaintenanceWindowRefusals)
+			return &signingDeferral{
+				reason: fmt.Sprintf("maintenance window lasts until [%v]", end),
+				until:  end,
+			}
+		}
+	}
+
+	if len(sp.allowedOwners) > 0 || len(sp.deniedOwners) > 0 {
+		owner, err := sp.getKeepOwner(keepAddress)
+		if err != nil {
+			// The owner is not known, so the request is neither accepted
+			// nor refused because of the owner.
+			return &signingDeferral{
+				reason: fmt.Sprintf("could not determine keep owner: [%v]", err),
+				until:  now.Add(keepOwnerRetryDelay),
+			}
+		}
+
+		if sp.deniedOwners[owner] {
+			increment(&sp.refusalsCounters.OwnerRefusals)
+			return fmt.Errorf("keep owner [%s] is denied", owner.String())
+		}
+
+		if len(sp.allowedOwners) > 0 && !sp.allowedOwners[owner] {
+			increment(&sp.refusalsCounters.OwnerRefusals)
+			return fmt.Errorf("keep owner [%s] is not allowed", owner.String())
+		}
+	}
+
+	if sp.config.MaxSignaturesPerKeep > 0 {
+		sp.mutex.Lock()
+		defer sp.mutex.Unlock()
+
+		period := sp.config.GetRateLimitPeriod()
+		periodStart := now.Add(-period)
+
+		recentSignings := make(map[[32]byte]time.Time)
+		var oldestSigning time.Time
+		for acceptedDigest, acceptedAt := range sp.acceptedSignings[keepAddress] {
+			if !acceptedAt.After(periodStart) {
+				continue
+			}
+
+			recentSignings[acceptedDigest] = acceptedAt
+			if oldestSigning.IsZero() || acceptedAt.Before(oldestSigning) {
+				oldestSigning = acceptedAt
+			}
+		}
+		sp.acceptedSignings[keepAddress] = recentSignings
+
+		// The request for this digest has already been accepted, e.g. it is
+		// evaluated again after the signing request event has been replayed.
+		if _, ok := recentSignings[digest]; ok {
+			return nil
+		}
+
+		if len(recentSignings) >= sp.config.MaxSignaturesPerKeep {
+			increment(&sp.refusalsCounters.RateLimitRefusals)
+			return &signingDeferral{
+				reason: fmt.Sprintf(
+					"rate limit of [%v] signatures per [%v] exceeded",
+					sp.config.MaxSignaturesPerKeep,
+					period,
+				),
+				until: oldestSigning.Add(period),
+			}
+		}
+
+		recentSignings[digest] = now
+	}
+
+	return nil
+}
+
+// getKeepOwner returns the owner of the keep. Owners are cached as they never
+// change for the given keep.
+func (sp *signingPolicy) getKeepOwner(
+	keepAddress common.Address,
+) (common.Address, error) {
+	sp.mutex.Lock()
+	owner, ok := sp.keepOwners[keepAddress]
+	sp.mutex.Unlock()
+
+	if ok {
+		return owner, nil
+	}
+
+	owner, err := sp.ethereumChain.GetOwner(keepAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	sp.mutex.Lock()
+	sp.keepOwners[keepAddress] = owner
+	sp.mutex.Unlock()
+
+	return owner, nil
+}
+
+// metrics returns a snapshot of the signing policy refusals counters.
+func (sp *signingPolicy) metrics() SigningPolicyMetrics {
+	m := &sp.refusalsCounters
+
+	return SigningPolicyMetrics{
+		SigningDisabledRefusals:   atomic.LoadInt64(&m.SigningDisabledRefusals),
+		MaintenanceWindowRefusals: atomic.LoadInt64(&m.MaintenanceWindowRefusals),
+		OwnerRefusals:             atomic.LoadInt64(&m.OwnerRefusals),
+		RateLimitRefusals:         atomic.LoadInt64(&m.RateLimitRefusals),
+	}
+}
+
+func increment(counter *int64) {
+	atomic.AddInt64(counter, 1)
+}
@@ -0,0 +1,437 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	configtime "github.com/keep-network/keep-ecdsa/internal/config/time"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
+)
+
+var (
+	policyKeep        = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	allowedOwner      = common.HexToAddress("0x8B3BccB3A3994681A1C1584DE4b4E8b23ed1Ed6d")
+	deniedOwner       = common.HexToAddress("0x4Af6E4d6D1B1B3bA7f6C8f3B6E1c5D9e6D8C2a10")
+	policyCurrentTime = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	policyDigest      = sha256.Sum256([]byte("policy digest"))
+)
+
+func TestSigningPolicy_Evaluate(t *testing.T) {
+	var tests = map[string]struct {
+		config         *SigningPolicyConfig
+		owner          common.Address
+		expectedResult bool
+		expectedMetric func(m SigningPolicyMetrics) int64
+	}{
+		"empty policy": {
+			config:         &SigningPolicyConfig{},
+			owner:          deniedOwner,
+			expectedResult: true,
+		},
+		"signing disabled": {
+			config:         &SigningPolicyConfig{SigningDisabled: true},
+			owner:          allowedOwner,
+			expectedResult: false,
+			expectedMetric: func(m SigningPolicyMetrics) int64 {
+				return m.SigningDisabledRefusals
+			},
+		},
+		"within maintenance window": {
+			config: &SigningPolicyConfig{
+				MaintenanceWindows: []MaintenanceWindow{{
+					Start: policyCurrentTime.Add(-time.Hour),
+					End:   policyCurrentTime.Add(time.Hour),
+				}},
+			},
+			owner:          allowedOwner,
+			expectedResult: false,
+			expectedMetric: func(m SigningPolicyMetrics) int64 {
+				return m.MaintenanceWindowRefusals
+			},
+		},
+		"after maintenance window": {
+			config: &SigningPolicyConfig{
+				MaintenanceWindows: []MaintenanceWindow{{
+					Start: policyCurrentTime.Add(-2 * time.Hour),
+					End:   policyCurrentTime,
+				}},
+			},
+			owner:          allowedOwner,
+			expectedResult: true,
+		},
+		"within recurring maintenance window": {
+			config: &SigningPolicyConfig{
+				MaintenanceWindows: []MaintenanceWindow{{
+					Start:  policyCurrentTime.Add(-72*time.Hour - time.Hour),
+					End:    policyCurrentTime.Add(-72*time.Hour + time.Hour),
+					Repeat: configtime.Duration{Duration: 24 * time.Hour},
+				}},
+			},
+			owner:          allowedOwner,
+			expectedResult: false,
+			expectedMetric: func(m SigningPolicyMetrics) int64 {
+				return m.MaintenanceWindowRefusals
+			},
+		},
+		"between recurring maintenance windows": {
+			config: &SigningPolicyConfig{
+				MaintenanceWindows: []MaintenanceWindow{{
+					Start:  policyCurrentTime.Add(-72*time.Hour + time.Hour),
+					End:    policyCurrentTime.Add(-72*time.Hour + 2*time.Hour),
+					Repeat: configtime.Duration{Duration: 24 * time.Hour},
+				}},
+			},
+			owner:          allowedOwner,
+			expectedResult: true,
+		},
+		"allowed owner": {
+			config: &SigningPolicyConfig{
+				AllowedOwners: []string{allowedOwner.Hex()},
+			},
+			owner:          allowedOwner,
+			expectedResult: true,
+		},
+		"owner not allowed": {
+			config: &SigningPolicyConfig{
+				AllowedOwners: []string{allowedOwner.Hex()},
+			},
+			owner:          deniedOwner,
+			expectedResult: false,
+			expectedMetric: func(m SigningPolicyMetrics) int64 {
+				return m.OwnerRefusals
+			},
+		},
+		"denied owner": {
+			config: &SigningPolicyConfig{
+				DeniedOwners: []string{deniedOwner.Hex()},
+			},
+			owner:          deniedOwner,
+			expectedResult: false,
+			expectedMetric: func(m SigningPolicyMetrics) int64 {
+				return m.OwnerRefusals
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			policy := newTestSigningPolicy(t, test.config, test.owner)
+
+			err := policy.evaluate(policyKeep, policyDigest)
+			if test.expectedResult != (err == nil) {
+				t.Errorf(
+					"unexpected evaluation result\nexpected: [%v]\nactual:   [%v]",
+					test.expectedResult,
+					err,
+				)
+			}
+
+			if test.expectedMetric != nil {
+				if refusals := test.expectedMetric(policy.metrics()); refusals != 1 {
+					t.Errorf("unexpected number of refusals: [%v]", refusals)
+				}
+			}
+		})
+	}
+}
+
+func TestSigningPolicy_MaintenanceWindowDeferral(t *testing.T) {
+	windowEnd := policyCurrentTime.Add(time.Hour)
+
+	policy := newTestSigningPolicy(
+		t,
+		&SigningPolicyConfig{
+			MaintenanceWindows: []MaintenanceWindow{{
+				Start:  policyCurrentTime.Add(-24*time.Hour - time.Hour),
+				End:    windowEnd.Add(-24 * time.Hour),
+				Repeat: configtime.Duration{Duration: 24 * time.Hour},
+			}},
+		},
+		allowedOwner,
+	)
+
+	err := policy.evaluate(policyKeep, policyDigest)
+
+	deferral, ok := err.(*signingDeferral)
+	if !ok {
+		t.Fatalf("expected deferral, got: [%v]", err)
+	}
+	if !deferral.until.Equal(windowEnd) {
+		t.Errorf(
+			"unexpected deferral end\nexpected: [%v]\nactual:   [%v]",
+			windowEnd,
+			deferral.until,
+		)
+	}
+}
+
+func TestSigningPolicy_KeepOwnerLookupFailure(t *testing.T) {
+	policy := newTestSigningPolicy(
+		t,
+		&SigningPolicyConfig{
+			AllowedOwners: []string{allowedOwner.Hex()},
+		},
+		allowedOwner,
+	)
+
+	unknownKeep := common.HexToAddress("0x1b6eC8F6D9E5c3d6A5aD8eC0F2F7e6F9b0A3C4D5")
+
+	err := policy.evaluate(unknownKeep, policyDigest)
+	if _, ok := err.(*signingDeferral); !ok {
+		t.Errorf("expected deferral, got: [%v]", err)
+	}
+
+	if refusals := policy.metrics().OwnerRefusals; refusals != 0 {
+		t.Errorf("unexpected number of owner refusals: [%v]", refusals)
+	}
+}
+
+func TestSigningPolicy_RateLimit(t *testing.T) {
+	policy := newTestSigningPolicy(
+		t,
+		&SigningPolicyConfig{
+			MaxSignaturesPerKeep: 2,
+			RateLimitPeriod:      configtime.Duration{Duration: time.Hour},
+		},
+		allowedOwner,
+	)
+
+	now := policyCurrentTime
+	policy.now = func() time.Time { return now }
+
+	digests := [][32]byte{
+		sha256.Sum256([]byte("digest 1")),
+		sha256.Sum256([]byte("digest 2")),
+		sha256.Sum256([]byte("digest 3")),
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := policy.evaluate(policyKeep, digests[i]); err != nil {
+			t.Fatalf("unexpected refusal of request [%v]: [%v]", i, err)
+		}
+		now = now.Add(10 * time.Minute)
+	}
+
+	// The request for the same digest is counted once.
+	if err := policy.evaluate(policyKeep, digests[0]); err != nil {
+		t.Errorf("unexpected refusal of already accepted request: [%v]", err)
+	}
+
+	err := policy.evaluate(policyKeep, digests[2])
+	deferral, ok := err.(*signingDeferral)
+	if !ok {
+		t.Fatalf("expected deferral after the rate limit has been reached")
+	}
+	if expectedEnd := policyCurrentTime.Add(time.Hour); !deferral.until.Equal(expectedEnd) {
+		t.Errorf(
+			"unexpected deferral end\nexpected: [%v]\nactual:   [%v]",
+			expectedEnd,
+			deferral.until,
+		)
+	}
+
+	otherKeep := common.HexToAddress("0x1b6eC8F6D9E5c3d6A5aD8eC0F2F7e6F9b0A3C4D5")
+	if err := policy.evaluate(otherKeep, digests[2]); err != nil {
+		t.Errorf("unexpected refusal for another keep: [%v]", err)
+	}
+
+	// The first accepted request is out of the rate limit period.
+	now = policyCurrentTime.Add(time.Hour)
+	if err := policy.evaluate(policyKeep, digests[2]); err != nil {
+		t.Errorf("unexpected refusal after the rate limit period: [%v]", err)
+	}
+
+	if refusals := policy.metrics().RateLimitRefusals; refusals != 1 {
+		t.Errorf("unexpected number of refusals: [%v]", refusals)
+	}
+}
+
+func TestAwaitSigningApproval_Deferred(t *testing.T) {
+	chain, signingJournal, cleanup := newSigningApprovalTest(t)
+	defer cleanup()
+
+	policy := newSigningPolicy(
+		&SigningPolicyConfig{
+			MaintenanceWindows: []MaintenanceWindow{{
+				Start: time.Now().Add(-time.Hour),
+				End:   time.Now().Add(300 * time.Millisecond),
+			}},
+		},
+		chain,
+	)
+
+	approved := make(chan bool)
+	go func() {
+		approved <- awaitSigningApproval(
+			chain,
+			policy,
+			&node.Node{},
+			signingJournal,
+			policyKeep,
+			policyDigest,
+		)
+	}()
+
+	select {
+	case isApproved := <-approved:
+		if !isApproved {
+			t.Errorf("expected approval after the maintenance window")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("deferred request has not been evaluated again")
+	}
+
+	if refusals := policy.metrics().MaintenanceWindowRefusals; refusals != 1 {
+		t.Errorf("unexpected number of refusals: [%v]", refusals)
+	}
+
+	assertPendingRequest(t, signingJournal)
+}
+
+func TestAwaitSigningApproval_Refused(t *testing.T) {
+	chain, signingJournal, cleanup := newSigningApprovalTest(t)
+	defer cleanup()
+
+	policy := newSigningPolicy(&SigningPolicyConfig{SigningDisabled: true}, chain)
+
+	if awaitSigningApproval(
+		chain,
+		policy,
+		&node.Node{},
+		signingJournal,
+		policyKeep,
+		policyDigest,
+	) {
+		t.Errorf("expected refusal when signing is disabled")
+	}
+
+	// Refused request stays in the journal, so it is evaluated again when
+	// the client is restarted.
+	assertPendingRequest(t, signingJournal)
+}
+
+func TestSigningPolicyConfig_Validate(t *testing.T) {
+	var tests = map[string]struct {
+		config        *SigningPolicyConfig
+		expectedValid bool
+	}{
+		"empty policy": {
+			config:        &SigningPolicyConfig{},
+			expectedValid: true,
+		},
+		"invalid owner address": {
+			config: &SigningPolicyConfig{
+				DeniedOwners: []string{"0x123"},
+			},
+			expectedValid: false,
+		},
+		"negative rate limit": {
+			config: &SigningPolicyConfig{
+				MaxSignaturesPerKeep: -1,
+			},
+			expectedValid: false,
+		},
+		"maintenance window not shorter than repeat interval": {
+			config: &SigningPolicyConfig{
+				MaintenanceWindows: []MaintenanceWindow{{
+					Start:  policyCurrentTime,
+					End:    policyCurrentTime.Add(time.Hour),
+					Repeat: configtime.Duration{Duration: time.Hour},
+				}},
+			},
+			expectedValid: false,
+		},
+		"maintenance window ending before start": {
+			config: &SigningPolicyConfig{
+				MaintenanceWindows: []MaintenanceWindow{{
+					Start: policyCurrentTime,
+					End:   policyCurrentTime.Add(-time.Hour),
+				}},
+			},
+			expectedValid: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.expectedValid != (err == nil) {
+				t.Errorf(
+					"unexpected validation result\nexpected valid: [%v]\nactual error:   [%v]",
+					test.expectedValid,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func newTestSigningPolicy(
+	t *testing.T,
+	config *SigningPolicyConfig,
+	owner common.Address,
+) *signingPolicy {
+	chain := local.Connect(context.Background())
+	chain.OpenKeep(policyKeep, []common.Address{chain.Address()})
+	if err := chain.SetKeepOwner(policyKeep, owner); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := newSigningPolicy(config, chain)
+	policy.now = func() time.Time { return policyCurrentTime }
+
+	return policy
+}
+
+// awaitingSignatureChain is a local chain whose keeps await signatures for
+// all digests.
+type awaitingSignatureChain struct {
+	local.Chain
+}
+
+func (asc *awaitingSignatureChain) IsAwaitingSignature(
+	keepAddress common.Address,
+	digest [32]byte,
+) (bool, error) {
+	return true, nil
+}
+
+func newSigningApprovalTest(
+	t *testing.T,
+) (*awaitingSignatureChain, *signing.Journal, func()) {
+	dir, err := ioutil.TempDir("", "signing-approval-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	handle, err := persistence.NewDiskHandle(dir)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	chain := &awaitingSignatureChain{local.Connect(context.Background())}
+	chain.OpenKeep(policyKeep, []common.Address{chain.Address()})
+
+	return chain, signing.NewJournal(handle), cleanup
+}
+
+func assertPendingRequest(t *testing.T, signingJournal *signing.Journal) {
+	requests := signingJournal.UnfinishedForKeep(policyKeep)
+	if len(requests) != 1 {
+		t.Fatalf("unexpected number of unfinished requests: [%v]", len(requests))
+	}
+
+	if requests[0].Digest != policyDigest || requests[0].State != signing.Pending {
+		t.Errorf("unexpected unfinished request: [%+v]", requests[0])
+	}
+}
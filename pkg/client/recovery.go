@@ -0,0 +1,56 @@
+package client
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+// recoverSignersFromSnapshots registers signers from snapshots which have not
+// been registered because the client failed after the snapshot was made. Only
+// signers with the public key matching the public key published by the keep
+// are registered. Other snapshots are left untouched for manual inspection.
+// It returns addresses of keeps for which signers have been recovered.
+func recoverSignersFromSnapshots(
+	ethereumChain eth.Handle,
+	keepsRegistry *registry.Keeps,
+) []common.Address {
+	recovered := make([]common.Address, 0)
+
+	for keepAddress, signer := range keepsRegistry.UnregisteredSnapshots() {
+		logger.Infof(
+			"found unregistered snapshot of signer for keep [%s]; verifying",
+			keepAddress.String(),
+		)
+
+		if issue := verifyKeyShare(
+			ethereumChain,
+			keepAddress,
+			signer.PublicKey(),
+		); issue != nil {
+			logger.Warningf(
+				"could not recover signer from snapshot for %v",
+				issue,
+			)
+			continue
+		}
+
+		if err := keepsRegistry.RegisterSigner(keepAddress, signer); err != nil {
+			logger.Errorf(
+				"failed to register signer recovered from snapshot for keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		logger.Infof(
+			"recovered signer from snapshot for keep [%s]",
+			keepAddress.String(),
+		)
+
+		recovered = append(recovered, keepAddress)
+	}
+
+	return recovered
+}
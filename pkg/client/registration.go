@@ -8,16 +8,12 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/chainutil"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/backoff"
 	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
 )
 
 const statusCheckIntervalBlocks = 100
 
-// retryDelay defines the delay between retries related to the registration logic
-// that do not have their own specific values (like for example `eligibilityRetryDelay`
-// for sortition pool join eligibility checks).
-const retryDelay = 1 * time.Second
-
 // eligibilityRetryDelay defines the delay between checks whether the operator
 // is eligible to join the sortition pool.
 const eligibilityRetryDelay = 20 * time.Minute
@@ -31,17 +27,28 @@ const eligibilityRetryDelay = 20 * time.Minute
 // process to keep the operator's status up to date in the pool.
 // If operator status in the pool cannot be monitored, e.g. when operator is
 // removed from the pool it triggers the registration process from the begining.
+// Failed chain calls are retried with delays determined by the given policy
+// for the number of consecutive failures, so delays start from the beginning
+// once a call succeeds. Operator status updates are confirmed after the given
+// number of blocks.
+// The current registration status is noted in the provided status track.
 func checkStatusAndRegisterForApplication(
 	ctx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy backoff.Policy,
+	blockConfirmations uint64,
+	registrationStatus *registrationStatusTrack,
 ) {
+	failedAttempts := 0
 RegistrationLoop:
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
+			registrationStatus.set(application, RegistrationChecking, nil)
+
 			isRegistered, err := ethereumChain.IsRegisteredForApplication(application)
 			if err != nil {
 				logger.Errorf(
@@ -49,26 +56,43 @@ RegistrationLoop:
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				registrationStatus.set(application, RegistrationFailed, err)
+				failedAttempts++
+				time.Sleep(retryPolicy.Delay(failedAttempts))
 				continue RegistrationLoop
 			}
+			failedAttempts = 0
 
 			if !isRegistered {
 				// if the operator is not registered, we need to register it and
 				// wait until registration is confirmed
-				registerAsMemberCandidate(ctx, ethereumChain, application)
-				waitUntilRegistered(ctx, ethereumChain, application)
+				registrationStatus.set(application, Registering, nil)
+				registerAsMemberCandidate(
+					ctx,
+					ethereumChain,
+					application,
+					retryPolicy,
+				)
+				waitUntilRegistered(ctx, ethereumChain, application, retryPolicy)
 			}
 
 			// once the registration is confirmed or if the client is already
 			// registered, we can start to monitor the status
-			if err := monitorSignerPoolStatus(ctx, ethereumChain, application); err != nil {
+			registrationStatus.set(application, Registered, nil)
+			if err := monitorSignerPoolStatus(
+				ctx,
+				ethereumChain,
+				application,
+				blockConfirmations,
+			); err != nil {
 				logger.Errorf(
 					"failed on signer pool status monitoring; please inspect "+
 						"signer's unbonded value and stake: [%v]",
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				registrationStatus.set(application, RegistrationFailed, err)
+				failedAttempts++
+				time.Sleep(retryPolicy.Delay(failedAttempts))
 				continue RegistrationLoop
 			}
 		}
@@ -84,6 +108,7 @@ func registerAsMemberCandidate(
 	parentCtx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy backoff.Policy,
 ) {
 	// If the operator is eligible right now for registering as a member
 	// candidate for the application, we register the operator.
@@ -117,7 +142,12 @@ func registerAsMemberCandidate(
 	// We do the same in case the registration of eligible operator failed for
 	// some reason. As soon as the operator is eligible, we will proceed with
 	// the registration.
-	registerAsMemberCandidateWhenEligible(parentCtx, ethereumChain, application)
+	registerAsMemberCandidateWhenEligible(
+		parentCtx,
+		ethereumChain,
+		application,
+		retryPolicy,
+	)
 }
 
 // registerAsMemberCandidateWhenEligible for each new block checks the operator's
@@ -127,10 +157,12 @@ func registerAsMemberCandidateWhenEligible(
 	parentCtx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy backoff.Policy,
 ) {
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
 
+	failedAttempts := 0
 	newBlockChan := ethereumChain.BlockCounter().WatchBlocks(ctx)
 	for {
 		select {
@@ -142,9 +174,11 @@ func registerAsMemberCandidateWhenEligible(
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				failedAttempts++
+				time.Sleep(retryPolicy.Delay(failedAttempts))
 				continue
 			}
+			failedAttempts = 0
 
 			if !isEligible {
 				// if the operator is not yet eligible wait for the next
@@ -169,7 +203,8 @@ func registerAsMemberCandidateWhenEligible(
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				failedAttempts++
+				time.Sleep(retryPolicy.Delay(failedAttempts))
 				continue
 			}
 
@@ -188,7 +223,9 @@ func waitUntilRegistered(
 	ctx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	retryPolicy backoff.Policy,
 ) {
+	failedAttempts := 0
 	newBlockChan := ethereumChain.BlockCounter().WatchBlocks(ctx)
 
 	for {
@@ -201,9 +238,11 @@ func waitUntilRegistered(
 					application.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				failedAttempts++
+				time.Sleep(retryPolicy.Delay(failedAttempts))
 				continue
 			}
+			failedAttempts = 0
 
 			if isRegistered {
 				logger.Infof(
@@ -229,6 +268,7 @@ func monitorSignerPoolStatus(
 	ctx context.Context,
 	ethereumChain eth.Handle,
 	application common.Address,
+	blockConfirmations uint64,
 ) error {
 	logger.Debugf(
 		"starting monitoring operatator status for application [%s]",
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-core/pkg/operator"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
+)
+
+// replayKeepCreatedEvents looks up keeps created since the given block, e.g.
+// while the client was offline, and generates signers for keeps the operator
+// is a member of and which are still awaiting key generation.
+func replayKeepCreatedEvents(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	confirmedEvents *eth.ConfirmedEvents,
+	clientConfig *Config,
+	tssNode *node.Node,
+	operatorPublicKey *operator.PublicKey,
+	keepsRegistry *registry.Keeps,
+	requestedSignatures *requestedSignaturesTrack,
+	signingJournal *signing.Journal,
+	signingPolicy *signingPolicy,
+	fraudDetector *signatureFraudDetector,
+	rewardsWithdrawal *ethRewardsWithdrawal,
+	startBlock uint64,
+) {
+	events, err := ethereumChain.PastBondedECDSAKeepCreatedEvents(startBlock)
+	if err != nil {
+		logger.Errorf(
+			"could not replay keep created events since block [%d]: [%v]",
+			startBlock,
+			err,
+		)
+		return
+	}
+
+	logger.Infof(
+		"replaying [%d] keep created events since block [%d]",
+		len(events),
+		startBlock,
+	)
+
+	for _, event := range events {
+		if !event.IsMember(ethereumChain.Address()) {
+			continue
+		}
+
+		err := checkAwaitingKeyGenerationForKeep(
+			ctx,
+			ethereumChain,
+			confirmedEvents,
+			clientConfig,
+			tssNode,
+			operatorPublicKey,
+			keepsRegistry,
+			requestedSignatures,
+			signingJournal,
+			signingPolicy,
+			fraudDetector,
+			rewardsWithdrawal,
+			event.KeepAddress,
+		)
+		if err != nil {
+			logger.Warningf(
+				"could not check awaiting key generation for keep [%s]: [%v]",
+				event.KeepAddress.String(),
+				err,
+			)
+		}
+	}
+}
+
+// pastSignatureRequests returns digests of signing requests emitted by the
+// keep since the given block. Failures are logged and result in no digests.
+func pastSignatureRequests(
+	ethereumChain eth.Handle,
+	keepAddress common.Address,
+	startBlock uint64,
+) [][32]byte {
+	events, err := ethereumChain.PastSignatureRequestedEvents(
+		keepAddress.Hex(),
+		startBlock,
+	)
+	if err != nil {
+		logger.Errorf(
+			"could not replay signature requested events "+
+				"for keep [%s] since block [%d]: [%v]",
+			keepAddress.String(),
+			startBlock,
+			err,
+		)
+		return nil
+	}
+
+	digests := make([][32]byte, 0)
+	for _, event := range events {
+		logger.Infof(
+			"replaying signature requested from keep [%s] "+
+				"for digest [%+x] at block [%d]",
+			keepAddress.String(),
+			event.Digest,
+			event.BlockNumber,
+		)
+
+		if !containsDigest(digests, event.Digest) {
+			digests = append(digests, event.Digest)
+		}
+	}
+
+	return digests
+}
+
+// wasKeepClosedSince checks if the keep has been closed or terminated since
+// the given block. Failures are logged and result in false.
+func wasKeepClosedSince(
+	ethereumChain eth.Handle,
+	keepAddress common.Address,
+	startBlock uint64,
+) bool {
+	closedEvents, err := ethereumChain.PastKeepClosedEvents(
+		keepAddress.Hex(),
+		startBlock,
+	)
+	if err != nil {
+		logger.Errorf(
+			"could not replay keep closed events for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	if len(closedEvents) > 0 {
+		return true
+	}
+
+	terminatedEvents, err := ethereumChain.PastKeepTerminatedEvents(
+		keepAddress.Hex(),
+		startBlock,
+	)
+	if err != nil {
+		logger.Errorf(
+			"could not replay keep terminated events for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	return len(terminatedEvents) > 0
+}
+
+// trackProcessedBlocks saves the last processed block in the block checkpoint
+// every time a new block is mined, until the context is done. Events are
+// delivered to the client once they are confirmed so the last processed block
+// lags behind the chain head by the number of block confirmations.
+func trackProcessedBlocks(
+	ctx context.Context,
+	ethereumChain eth.Handle,
+	blockCheckpoint *BlockCheckpoint,
+	blockConfirmations uint64,
+) {
+	blocks := ethereumChain.BlockCounter().WatchBlocks(ctx)
+
+	for {
+		select {
+		case block, ok := <-blocks:
+			if !ok {
+				return
+			}
+
+			if block < blockConfirmations {
+				continue
+			}
+
+			if err := blockCheckpoint.Save(block - blockConfirmations); err != nil {
+				logger.Errorf("could not save block checkpoint: [%v]", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
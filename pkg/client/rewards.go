@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// ethRewardsWithdrawal withdraws ETH rewards of the operator from keeps which
+// are no longer active. Rewards are distributed to keep members but they stay
+// in the keep until they are withdrawn to the member's beneficiary. Closed
+// and terminated keeps are checked when they are archived and then
+// periodically, as rewards may still be distributed to them later.
+type ethRewardsWithdrawal struct {
+	ethereumChain eth.Handle
+	threshold     *big.Int
+
+	mutex sync.Mutex
+	keeps map[common.Address]bool
+}
+
+func newETHRewardsWithdrawal(
+	ethereumChain eth.Handle,
+	threshold *big.Int,
+) *ethRewardsWithdrawal {
+	return &ethRewardsWithdrawal{
+		ethereumChain: ethereumChain,
+		threshold:     threshold,
+		keeps:         make(map[common.Address]bool),
+	}
+}
+
+// keepArchived adds the keep to the keeps covered by the sweep and withdraws
+// the member's balance from the keep if it reaches the threshold.
+func (erw *ethRewardsWithdrawal) keepArchived(keepAddress common.Address) {
+	erw.add(keepAddress)
+	erw.withdraw(keepAddress)
+}
+
+func (erw *ethRewardsWithdrawal) add(keepAddress common.Address) {
+	erw.mutex.Lock()
+	defer erw.mutex.Unlock()
+
+	erw.keeps[keepAddress] = true
+}
+
+func (erw *ethRewardsWithdrawal) inactiveKeeps() []common.Address {
+	erw.mutex.Lock()
+	defer erw.mutex.Unlock()
+
+	keeps := make([]common.Address, 0, len(erw.keeps))
+	for keepAddress := range erw.keeps {
+		keeps = append(keeps, keepAddress)
+	}
+
+	return keeps
+}
+
+// withdraw withdraws the member's ETH balance from the keep to the member's
+// beneficiary if the balance is not lower than the threshold. It returns true
+// if the withdrawal transaction has been submitted.
+func (erw *ethRewardsWithdrawal) withdraw(keepAddress common.Address) bool {
+	member := erw.ethereumChain.Address()
+
+	balance, err := erw.ethereumChain.GetMemberETHBalance(keepAddress, member)
+	if err != nil {
+		logger.Errorf(
+			"could not get member ETH balance in keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	if balance.Sign() == 0 || balance.Cmp(erw.threshold) < 0 {
+		logger.Debugf(
+			"member ETH balance [%v] wei in keep [%s] is below "+
+				"the withdrawal threshold [%v] wei",
+			balance,
+			keepAddress.String(),
+			erw.threshold,
+		)
+		return false
+	}
+
+	transactionHash, err := erw.ethereumChain.WithdrawMemberETHBalance(
+		keepAddress,
+		member,
+	)
+	if err != nil {
+		logger.Errorf(
+			"could not withdraw member ETH balance [%v] wei from keep [%s]: [%v]",
+			balance,
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	logger.Infof(
+		"submitted withdrawal of member ETH balance [%v] wei from keep [%s] "+
+			"to the beneficiary in transaction [%s]",
+		balance,
+		keepAddress.String(),
+		transactionHash.String(),
+	)
+
+	return true
+}
+
+// sweep withdraws the member's ETH balances reaching the threshold from all
+// inactive keeps. It returns the number of submitted withdrawals.
+func (erw *ethRewardsWithdrawal) sweep() int {
+	withdrawals := 0
+	for _, keepAddress := range erw.inactiveKeeps() {
+		if erw.withdraw(keepAddress) {
+			withdrawals++
+		}
+	}
+
+	return withdrawals
+}
+
+// run looks up inactive keeps the operator has been a member of, including
+// keeps archived before the client started, and sweeps them with the given
+// interval until the context is done.
+func (erw *ethRewardsWithdrawal) run(ctx context.Context, interval time.Duration) {
+	erw.lookupInactiveKeeps()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if withdrawals := erw.sweep(); withdrawals > 0 {
+			logger.Infof(
+				"submitted [%d] withdrawals of member ETH balances",
+				withdrawals,
+			)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// lookupInactiveKeeps adds all keeps the operator has been a member of which
+// are no longer active to the keeps covered by the sweep.
+func (erw *ethRewardsWithdrawal) lookupInactiveKeeps() {
+	events, err := erw.ethereumChain.PastBondedECDSAKeepCreatedEvents(0)
+	if err != nil {
+		logger.Errorf(
+			"could not look up keeps for ETH rewards withdrawal: [%v]",
+			err,
+		)
+		return
+	}
+
+	for _, event := range events {
+		if !event.IsMember(erw.ethereumChain.Address()) {
+			continue
+		}
+
+		isActive, err := erw.ethereumChain.IsActive(event.KeepAddress)
+		if err != nil {
+			logger.Warningf(
+				"could not check if keep [%s] is active: [%v]",
+				event.KeepAddress.String(),
+				err,
+			)
+			continue
+		}
+
+		if !isActive {
+			erw.add(event.KeepAddress)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+func TestETHRewardsWithdrawal_KeepArchived(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := local.Connect(ctx)
+
+	keepAddress := common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c")
+	chain.OpenKeep(keepAddress, []common.Address{chain.Address()})
+
+	if err := chain.DistributeETHReward(keepAddress, big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.CloseKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	rewardsWithdrawal := newETHRewardsWithdrawal(chain, big.NewInt(1000))
+	rewardsWithdrawal.keepArchived(keepAddress)
+
+	balance, err := chain.GetMemberETHBalance(keepAddress, chain.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if balance.Sign() != 0 {
+		t.Errorf(
+			"unexpected member balance\nexpected: [0]\nactual:   [%v]",
+			balance,
+		)
+	}
+
+	if withdrawals := rewardsWithdrawal.sweep(); withdrawals != 0 {
+		t.Errorf(
+			"unexpected number of withdrawals\nexpected: [0]\nactual:   [%d]",
+			withdrawals,
+		)
+	}
+}
+
+func TestETHRewardsWithdrawal_BelowThreshold(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := local.Connect(ctx)
+
+	keepAddress := common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c")
+	chain.OpenKeep(keepAddress, []common.Address{chain.Address()})
+
+	if err := chain.DistributeETHReward(keepAddress, big.NewInt(999)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.CloseKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	rewardsWithdrawal := newETHRewardsWithdrawal(chain, big.NewInt(1000))
+	rewardsWithdrawal.keepArchived(keepAddress)
+
+	balance, err := chain.GetMemberETHBalance(keepAddress, chain.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if balance.Cmp(big.NewInt(999)) != 0 {
+		t.Errorf(
+			"unexpected member balance\nexpected: [999]\nactual:   [%v]",
+			balance,
+		)
+	}
+
+	// Rewards distributed to the keep after it has been archived are
+	// withdrawn by the sweep once the balance reaches the threshold.
+	if err := chain.DistributeETHReward(keepAddress, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	if withdrawals := rewardsWithdrawal.sweep(); withdrawals != 1 {
+		t.Errorf(
+			"unexpected number of withdrawals\nexpected: [1]\nactual:   [%d]",
+			withdrawals,
+		)
+	}
+}
+
+func TestETHRewardsWithdrawal_LookupInactiveKeeps(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chain := local.Connect(ctx)
+
+	activeKeep := common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c")
+	closedKeep := common.HexToAddress("0x9e5e02b6a5c3f3a2b1ce0a1f2dcb8e2bba0a2a7d")
+	otherKeep := common.HexToAddress("0x2fa5d9f8d0a1e6e6c2f2de4b4d1bd1e0c7d1f8a3")
+
+	chain.OpenKeep(activeKeep, []common.Address{chain.Address()})
+	chain.OpenKeep(closedKeep, []common.Address{chain.Address()})
+	chain.OpenKeep(otherKeep, local.RandomSigningGroup(3))
+
+	for _, keepAddress := range []common.Address{closedKeep, otherKeep} {
+		if err := chain.CloseKeep(keepAddress); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rewardsWithdrawal := newETHRewardsWithdrawal(chain, big.NewInt(1000))
+	rewardsWithdrawal.lookupInactiveKeeps()
+
+	inactiveKeeps := rewardsWithdrawal.inactiveKeeps()
+	if len(inactiveKeeps) != 1 || inactiveKeeps[0] != closedKeep {
+		t.Errorf(
+			"unexpected inactive keeps\nexpected: [%v]\nactual:   [%v]",
+			[]common.Address{closedKeep},
+			inactiveKeeps,
+		)
+	}
+}
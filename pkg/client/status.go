@@ -0,0 +1,217 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
+)
+
+// RegistrationState represents the state of the operator registration as
+// a keep member candidate for the sanctioned application.
+type RegistrationState string
+
+const (
+	// RegistrationChecking means the registration status is being checked.
+	RegistrationChecking RegistrationState = "checking"
+	// Registering means the operator is not registered yet and it is waiting
+	// for the eligibility or the registration confirmation.
+	Registering RegistrationState = "registering"
+	// Registered means the operator is registered and its status in the
+	// signer pool is monitored.
+	Registered RegistrationState = "registered"
+	// RegistrationFailed means the last registration check or status update
+	// failed. The registration is retried.
+	RegistrationFailed RegistrationState = "failed"
+)
+
+// RegistrationStatus is the status of the operator registration for the
+// sanctioned application.
+type RegistrationStatus struct {
+	Application common.Address
+	State       RegistrationState
+	LastError   string
+	UpdatedAt   time.Time
+}
+
+// KeepStatus is the status of a keep the client is a member of.
+type KeepStatus struct {
+	Address   common.Address
+	MemberID  string
+	PublicKey *ecdsa.PublicKey
+	// IsActive is valid only if StatusError is nil.
+	IsActive    bool
+	StatusError error
+}
+
+// keepActivityCacheTTL is the time for which the on-chain activity of a keep
+// reported by the admin endpoint is cached. It limits the number of chain
+// calls when keeps are queried frequently.
+const keepActivityCacheTTL = 1 * time.Minute
+
+// keepActivityCache caches the on-chain activity of keeps, so querying keeps
+// statuses does not execute a chain call for each keep every time.
+type keepActivityCache struct {
+	data  map[string]*keepActivity // <keep, activity>
+	mutex *sync.Mutex
+}
+
+type keepActivity struct {
+	isActive  bool
+	checkedAt time.Time
+}
+
+func newKeepActivityCache() *keepActivityCache {
+	return &keepActivityCache{
+		data:  make(map[string]*keepActivity),
+		mutex: &sync.Mutex{},
+	}
+}
+
+// get returns the cached activity of the keep. If the activity has not been
+// cached yet or the cached value has expired, it is checked using the given
+// function and cached. Errors are not cached.
+func (kac *keepActivityCache) get(
+	keepAddress common.Address,
+	check func(keepAddress common.Address) (bool, error),
+) (bool, error) {
+	kac.mutex.Lock()
+	activity, ok := kac.data[keepAddress.String()]
+	kac.mutex.Unlock()
+
+	if ok && time.Since(activity.checkedAt) < keepActivityCacheTTL {
+		return activity.isActive, nil
+	}
+
+	isActive, err := check(keepAddress)
+	if err != nil {
+		return false, err
+	}
+
+	kac.mutex.Lock()
+	kac.data[keepAddress.String()] = &keepActivity{
+		isActive:  isActive,
+		checkedAt: time.Now(),
+	}
+	kac.mutex.Unlock()
+
+	return isActive, nil
+}
+
+// retain removes cached activity of all keeps other than the given ones.
+func (kac *keepActivityCache) retain(keepAddresses []common.Address) {
+	kac.mutex.Lock()
+	defer kac.mutex.Unlock()
+
+	retained := make(map[string]bool, len(keepAddresses))
+	for _, keepAddress := range keepAddresses {
+		retained[keepAddress.String()] = true
+	}
+
+	for keepAddress := range kac.data {
+		if !retained[keepAddress] {
+			delete(kac.data, keepAddress)
+		}
+	}
+}
+
+// registrationStatusTrack is used to track the registration status for each
+// sanctioned application.
+type registrationStatusTrack struct {
+	data  map[string]*RegistrationStatus // <application, status>
+	mutex *sync.Mutex
+}
+
+func newRegistrationStatusTrack() *registrationStatusTrack {
+	return &registrationStatusTrack{
+		data:  make(map[string]*RegistrationStatus),
+		mutex: &sync.Mutex{},
+	}
+}
+
+func (rst *registrationStatusTrack) set(
+	application common.Address,
+	state RegistrationState,
+	err error,
+) {
+	rst.mutex.Lock()
+	defer rst.mutex.Unlock()
+
+	status := &RegistrationStatus{
+		Application: application,
+		State:       state,
+		UpdatedAt:   time.Now(),
+	}
+
+	if err != nil {
+		status.LastError = err.Error()
+	} else if previous, ok := rst.data[application.String()]; ok {
+		status.LastError = previous.LastError
+	}
+
+	rst.data[application.String()] = status
+}
+
+func (rst *registrationStatusTrack) list() []*RegistrationStatus {
+	rst.mutex.Lock()
+	defer rst.mutex.Unlock()
+
+	statuses := make([]*RegistrationStatus, 0, len(rst.data))
+	for _, status := range rst.data {
+		statusCopy := *status
+		statuses = append(statuses, &statusCopy)
+	}
+
+	return statuses
+}
+
+// Keeps returns statuses of all keeps registered in the client. Activity of
+// each keep is checked on-chain and cached for a minute.
+func (h *Handle) Keeps() []*KeepStatus {
+	keepAddresses := h.keepsRegistry.GetKeepsAddresses()
+	h.keepActivity.retain(keepAddresses)
+
+	statuses := make([]*KeepStatus, 0, len(keepAddresses))
+	for _, keepAddress := range keepAddresses {
+		signer, err := h.keepsRegistry.GetSigner(keepAddress)
+		if err != nil {
+			// keep has been unregistered in the meantime
+			continue
+		}
+
+		status := &KeepStatus{
+			Address:   keepAddress,
+			MemberID:  signer.MemberID().String(),
+			PublicKey: (*ecdsa.PublicKey)(signer.PublicKey()),
+		}
+
+		status.IsActive, status.StatusError = h.keepActivity.get(
+			keepAddress,
+			h.ethereumChain.IsActive,
+		)
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// SigningRequests returns signing requests which are in progress.
+func (h *Handle) SigningRequests() []*signing.Request {
+	return h.signingJournal.Unfinished()
+}
+
+// RegistrationStatuses returns the registration status for each sanctioned
+// application.
+func (h *Handle) RegistrationStatuses() []*RegistrationStatus {
+	return h.registrationStatus.list()
+}
+
+// RecentErrors returns the most recent errors which occurred in the key
+// generation and signing process.
+func (h *Handle) RecentErrors() []*node.ErrorRecord {
+	return h.tssNode.RecentErrors()
+}
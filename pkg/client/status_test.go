@@ -0,0 +1,94 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var statusTestKeepAddress = common.HexToAddress(
+	"0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632",
+)
+
+func TestKeepActivityCache_CachesActivity(t *testing.T) {
+	cache := newKeepActivityCache()
+
+	checks := 0
+	check := func(keepAddress common.Address) (bool, error) {
+		checks++
+		return true, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		isActive, err := cache.get(statusTestKeepAddress, check)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isActive {
+			t.Errorf("keep should be active")
+		}
+	}
+
+	if checks != 1 {
+		t.Errorf(
+			"unexpected number of activity checks\nexpected: [1]\nactual:   [%d]",
+			checks,
+		)
+	}
+}
+
+func TestKeepActivityCache_ChecksExpiredActivity(t *testing.T) {
+	cache := newKeepActivityCache()
+	cache.data[statusTestKeepAddress.String()] = &keepActivity{
+		isActive:  true,
+		checkedAt: time.Now().Add(-keepActivityCacheTTL),
+	}
+
+	isActive, err := cache.get(
+		statusTestKeepAddress,
+		func(keepAddress common.Address) (bool, error) {
+			return false, nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if isActive {
+		t.Errorf("keep should not be active")
+	}
+}
+
+func TestKeepActivityCache_DoesNotCacheErrors(t *testing.T) {
+	cache := newKeepActivityCache()
+
+	_, err := cache.get(
+		statusTestKeepAddress,
+		func(keepAddress common.Address) (bool, error) {
+			return false, fmt.Errorf("boom")
+		},
+	)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if _, ok := cache.data[statusTestKeepAddress.String()]; ok {
+		t.Errorf("error should not be cached")
+	}
+}
+
+func TestKeepActivityCache_Retain(t *testing.T) {
+	cache := newKeepActivityCache()
+	cache.data[statusTestKeepAddress.String()] = &keepActivity{
+		isActive:  true,
+		checkedAt: time.Now(),
+	}
+
+	cache.retain([]common.Address{})
+
+	if len(cache.data) != 0 {
+		t.Errorf("activity of unregistered keep should be removed")
+	}
+}
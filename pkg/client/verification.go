@@ -0,0 +1,312 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+// KeyShareProblem describes an inconsistency between key shares stored by the
+// client and keeps on-chain.
+type KeyShareProblem string
+
+const (
+	// PublicKeyMismatch means the public key of the stored key share differs
+	// from the public key published by the keep.
+	PublicKeyMismatch KeyShareProblem = "public key mismatch"
+	// PublicKeyNotPublished means the key share is stored but the keep has
+	// no public key published.
+	PublicKeyNotPublished KeyShareProblem = "public key not published"
+	// NotKeepMember means the key share is stored for a keep the operator is
+	// not a member of.
+	NotKeepMember KeyShareProblem = "operator is not a keep member"
+	// MissingKeyShare means the operator is a member of an active keep with
+	// a published public key but there is no key share stored for it.
+	MissingKeyShare KeyShareProblem = "missing key share"
+	// VerificationFailed means the keep could not be verified, e.g. because
+	// of a chain call failure.
+	VerificationFailed KeyShareProblem = "verification failed"
+)
+
+// KeyShareIssue is an inconsistency found for the given keep.
+type KeyShareIssue struct {
+	KeepAddress common.Address
+	Problem     KeyShareProblem
+	Details     string
+}
+
+func (ksi *KeyShareIssue) String() string {
+	if ksi.Details == "" {
+		return fmt.Sprintf("keep [%s]: %s", ksi.KeepAddress.String(), ksi.Problem)
+	}
+
+	return fmt.Sprintf(
+		"keep [%s]: %s: %s",
+		ksi.KeepAddress.String(),
+		ksi.Problem,
+		ksi.Details,
+	)
+}
+
+// VerifyKeyShares compares key shares loaded into the keeps registry with
+// keeps on-chain. For each stored key share it checks whether its public key
+// matches the public key published by the keep and whether the operator is
+// a member of the keep. It also looks for active keeps with a published public
+// key the operator is a member of, but has no key share stored for. Only keeps
+// opened within the look-back period are checked for missing key shares; zero
+// look-back period means all keeps are checked.
+func VerifyKeyShares(
+	ethereumChain eth.Handle,
+	keepsRegistry *registry.Keeps,
+	lookbackPeriod time.Duration,
+) ([]*KeyShareIssue, error) {
+	publicKeys := make(map[common.Address]*ecdsa.PublicKey)
+	for _, keepAddress := range keepsRegistry.GetKeepsAddresses() {
+		signer, err := keepsRegistry.GetSigner(keepAddress)
+		if err != nil {
+			continue
+		}
+
+		publicKeys[keepAddress] = signer.PublicKey()
+	}
+
+	return verifyKeyShares(ethereumChain, publicKeys, lookbackPeriod)
+}
+
+func verifyKeyShares(
+	ethereumChain eth.Handle,
+	publicKeys map[common.Address]*ecdsa.PublicKey,
+	lookbackPeriod time.Duration,
+) ([]*KeyShareIssue, error) {
+	issues := make([]*KeyShareIssue, 0)
+
+	for keepAddress, publicKey := range publicKeys {
+		if issue := verifyKeyShare(
+			ethereumChain,
+			keepAddress,
+			publicKey,
+		); issue != nil {
+			issues = append(issues, issue)
+		}
+	}
+
+	missingKeyShareIssues, err := findMissingKeyShares(
+		ethereumChain,
+		publicKeys,
+		lookbackPeriod,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(issues, missingKeyShareIssues...), nil
+}
+
+func verifyKeyShare(
+	ethereumChain eth.Handle,
+	keepAddress common.Address,
+	publicKey *ecdsa.PublicKey,
+) *KeyShareIssue {
+	verificationFailed := func(format string, args ...interface{}) *KeyShareIssue {
+		return &KeyShareIssue{
+			KeepAddress: keepAddress,
+			Problem:     VerificationFailed,
+			Details:     fmt.Sprintf(format, args...),
+		}
+	}
+
+	members, err := ethereumChain.GetMembers(keepAddress)
+	if err != nil {
+		return verificationFailed("could not get members: [%v]", err)
+	}
+
+	if !containsAddress(members, ethereumChain.Address()) {
+		return &KeyShareIssue{
+			KeepAddress: keepAddress,
+			Problem:     NotKeepMember,
+			Details:     "key share is orphaned",
+		}
+	}
+
+	serializedPublicKey, err := eth.SerializePublicKey(publicKey)
+	if err != nil {
+		return verificationFailed("could not serialize public key: [%v]", err)
+	}
+
+	onChainPublicKey, err := ethereumChain.GetPublicKey(keepAddress)
+	if err != nil {
+		return verificationFailed("could not get public key: [%v]", err)
+	}
+
+	if len(onChainPublicKey) == 0 {
+		return &KeyShareIssue{
+			KeepAddress: keepAddress,
+			Problem:     PublicKeyNotPublished,
+		}
+	}
+
+	if !bytes.Equal(serializedPublicKey[:], onChainPublicKey) {
+		return &KeyShareIssue{
+			KeepAddress: keepAddress,
+			Problem:     PublicKeyMismatch,
+			Details: fmt.Sprintf(
+				"stored [%x], on-chain [%x]",
+				serializedPublicKey,
+				onChainPublicKey,
+			),
+		}
+	}
+
+	return nil
+}
+
+func findMissingKeyShares(
+	ethereumChain eth.Handle,
+	publicKeys map[common.Address]*ecdsa.PublicKey,
+	lookbackPeriod time.Duration,
+) ([]*KeyShareIssue, error) {
+	issues := make([]*KeyShareIssue, 0)
+
+	keepCount, err := ethereumChain.GetKeepCount()
+	if err != nil {
+		return nil, fmt.Errorf("could not get keep count: [%v]", err)
+	}
+
+	zero := big.NewInt(0)
+	one := big.NewInt(1)
+
+	lastIndex := new(big.Int).Sub(keepCount, one)
+
+	// Iterate through keeps starting from the end.
+	for keepIndex := new(big.Int).Set(lastIndex); keepIndex.Cmp(zero) != -1; keepIndex.Sub(keepIndex, one) {
+		keepAddress, err := ethereumChain.GetKeepAtIndex(keepIndex)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get keep at index [%v]: [%v]",
+				keepIndex,
+				err,
+			)
+		}
+
+		if lookbackPeriod > 0 {
+			openedTimestamp, err := ethereumChain.GetOpenedTimestamp(keepAddress)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not check opening timestamp for keep [%s]: [%v]",
+					keepAddress.String(),
+					err,
+				)
+			}
+
+			// If a keep was opened before the look-back period there is no
+			// sense to continue because the next keep was created earlier.
+			if openedTimestamp.Add(lookbackPeriod).Before(time.Now()) {
+				break
+			}
+		}
+
+		if _, ok := publicKeys[keepAddress]; ok {
+			continue
+		}
+
+		issue, err := checkMissingKeyShare(ethereumChain, keepAddress)
+		if err != nil {
+			issues = append(issues, &KeyShareIssue{
+				KeepAddress: keepAddress,
+				Problem:     VerificationFailed,
+				Details:     err.Error(),
+			})
+			continue
+		}
+
+		if issue != nil {
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues, nil
+}
+
+func checkMissingKeyShare(
+	ethereumChain eth.Handle,
+	keepAddress common.Address,
+) (*KeyShareIssue, error) {
+	members, err := ethereumChain.GetMembers(keepAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get members: [%v]", err)
+	}
+
+	if !containsAddress(members, ethereumChain.Address()) {
+		return nil, nil
+	}
+
+	isActive, err := ethereumChain.IsActive(keepAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not check if keep is active: [%v]", err)
+	}
+
+	// Key shares of closed and terminated keeps are archived on purpose.
+	if !isActive {
+		return nil, nil
+	}
+
+	publicKey, err := ethereumChain.GetPublicKey(keepAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get public key: [%v]", err)
+	}
+
+	// Key generation might still be in progress.
+	if len(publicKey) == 0 {
+		return nil, nil
+	}
+
+	return &KeyShareIssue{
+		KeepAddress: keepAddress,
+		Problem:     MissingKeyShare,
+		Details:     fmt.Sprintf("on-chain public key [%x]", publicKey),
+	}, nil
+}
+
+// checkKeyShares verifies stored key shares and reports all found issues.
+func checkKeyShares(
+	ethereumChain eth.Handle,
+	keepsRegistry *registry.Keeps,
+	lookbackPeriod time.Duration,
+) {
+	issues, err := VerifyKeyShares(ethereumChain, keepsRegistry, lookbackPeriod)
+	if err != nil {
+		logger.Errorf("could not verify key shares: [%v]", err)
+		return
+	}
+
+	if len(issues) == 0 {
+		logger.Infof("all stored key shares match keeps on-chain")
+		return
+	}
+
+	for _, issue := range issues {
+		logger.Errorf("key share verification failed for %v", issue)
+	}
+
+	logger.Errorf(
+		"found [%d] key share issues; please inspect the data directory "+
+			"before any signing requests arrive",
+		len(issues),
+	)
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+
+	return false
+}
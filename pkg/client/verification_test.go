@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+)
+
+func TestVerifyKeyShares(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	chain := local.Connect(ctx)
+
+	operator := chain.Address()
+	otherMember := common.HexToAddress("0x65EA55c1f10491038425725dC00dFFEAb2A1e28A")
+
+	validKeep := common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	mismatchedKeep := common.HexToAddress("0x8B3BccB3A3994681A1C1584DE4b4E8b23ed1Ed6d")
+	unpublishedKeep := common.HexToAddress("0x4Af6E4d6D1B1B3bA7f6C8f3B6E1c5D9e6D8C2a10")
+	orphanedKeep := common.HexToAddress("0x1b6eC8F6D9E5c3d6A5aD8eC0F2F7e6F9b0A3C4D5")
+	missingShareKeep := common.HexToAddress("0x9C2D5e1B3a4F6E7d8C9b0A1f2E3d4C5b6A7f8E9d")
+
+	validKey := generatePublicKey(t)
+	storedMismatchedKey := generatePublicKey(t)
+	onChainMismatchedKey := generatePublicKey(t)
+	unpublishedKey := generatePublicKey(t)
+	orphanedKey := generatePublicKey(t)
+	missingShareKey := generatePublicKey(t)
+
+	chain.OpenKeep(validKeep, []common.Address{operator, otherMember})
+	chain.OpenKeep(mismatchedKeep, []common.Address{operator, otherMember})
+	chain.OpenKeep(unpublishedKeep, []common.Address{operator, otherMember})
+	chain.OpenKeep(orphanedKeep, []common.Address{otherMember})
+	chain.OpenKeep(missingShareKeep, []common.Address{operator, otherMember})
+
+	submitPublicKey(t, chain, validKeep, validKey)
+	submitPublicKey(t, chain, mismatchedKeep, onChainMismatchedKey)
+	submitPublicKey(t, chain, orphanedKeep, orphanedKey)
+	submitPublicKey(t, chain, missingShareKeep, missingShareKey)
+
+	publicKeys := map[common.Address]*ecdsa.PublicKey{
+		validKeep:       validKey,
+		mismatchedKeep:  storedMismatchedKey,
+		unpublishedKeep: unpublishedKey,
+		orphanedKeep:    orphanedKey,
+	}
+
+	issues, err := verifyKeyShares(chain, publicKeys, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualProblems := make(map[common.Address]KeyShareProblem)
+	for _, issue := range issues {
+		actualProblems[issue.KeepAddress] = issue.Problem
+	}
+
+	expectedProblems := map[common.Address]KeyShareProblem{
+		mismatchedKeep:   PublicKeyMismatch,
+		unpublishedKeep:  PublicKeyNotPublished,
+		orphanedKeep:     NotKeepMember,
+		missingShareKeep: MissingKeyShare,
+	}
+
+	if !reflect.DeepEqual(expectedProblems, actualProblems) {
+		t.Errorf(
+			"unexpected issues\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedProblems,
+			actualProblems,
+		)
+	}
+}
+
+func TestVerifyKeyShares_MissingShareOfClosedKeep(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	chain := local.Connect(ctx)
+
+	keepAddress := common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+
+	chain.OpenKeep(keepAddress, []common.Address{chain.Address()})
+	submitPublicKey(t, chain, keepAddress, generatePublicKey(t))
+
+	if err := chain.CloseKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := verifyKeyShares(
+		chain,
+		map[common.Address]*ecdsa.PublicKey{},
+		0,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(issues) != 0 {
+		t.Errorf("unexpected issues: [%v]", issues)
+	}
+}
+
+func generatePublicKey(t *testing.T) *ecdsa.PublicKey {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return (*ecdsa.PublicKey)(&privateKey.PublicKey)
+}
+
+func submitPublicKey(
+	t *testing.T,
+	chain local.Chain,
+	keepAddress common.Address,
+	publicKey *ecdsa.PublicKey,
+) {
+	serializedPublicKey, err := eth.SerializePublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := chain.SubmitKeepPublicKey(keepAddress, serializedPublicKey); err != nil {
+		t.Fatal(err)
+	}
+}
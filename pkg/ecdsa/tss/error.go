@@ -34,3 +34,47 @@ func (t timeoutError) Error() string {
 		t.stage,
 	)
 }
+
+// ErrNotInSigningGroup is returned from the signature calculation when more
+// members than required by the threshold are present and the member has not
+// been selected to the group calculating the signature.
+var ErrNotInSigningGroup = fmt.Errorf(
+	"member has not been selected to the signing group",
+)
+
+// readinessError is returned when the readiness signaling protocol executed
+// before key generation or signing fails.
+type readinessError struct {
+	cause error
+}
+
+func (r readinessError) Error() string {
+	return fmt.Sprintf("readiness signaling protocol failed: [%v]", r.cause)
+}
+
+// IsReadinessError checks if the error has been caused by a failure of the
+// readiness signaling protocol, e.g. when not all members were ready to start
+// the protocol execution.
+func IsReadinessError(err error) bool {
+	_, ok := err.(readinessError)
+	return ok
+}
+
+// signingGroupSelectionError is returned when the signing group could not be
+// selected before signing, e.g. when not enough members announced their
+// presence.
+type signingGroupSelectionError struct {
+	cause error
+}
+
+func (s signingGroupSelectionError) Error() string {
+	return fmt.Sprintf("failed to select signing group: [%v]", s.cause)
+}
+
+// IsSigningGroupSelectionError checks if the error has been caused by a
+// failure of the signing group selection, e.g. when the announce protocol
+// did not gather enough members to sign.
+func IsSigningGroupSelectionError(err error) bool {
+	_, ok := err.(signingGroupSelectionError)
+	return ok
+}
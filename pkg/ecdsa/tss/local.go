@@ -0,0 +1,109 @@
+package tss
+
+import (
+	cecdsa "crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	tssLib "github.com/binance-chain/tss-lib/tss"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+)
+
+// GenerateLocalSigner generates a signer for a single-member group. There are
+// no other members to interact with so instead of executing the multi-party key
+// generation protocol a regular ECDSA key is generated locally. The key is kept
+// in the same form as a threshold key, so the signer can be persisted and used
+// to calculate signatures just like any other threshold signer.
+func GenerateLocalSigner(
+	groupID string,
+	memberID MemberID,
+) (*ThresholdSigner, error) {
+	privateKey, err := cecdsa.GenerateKey(tssLib.EC(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: [%v]", err)
+	}
+
+	publicKey, err := crypto.NewECPoint(tssLib.EC(), privateKey.X, privateKey.Y)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: [%v]", err)
+	}
+
+	shareID := memberID.bigInt()
+
+	// Local key doesn't use any of the pre-parameters nor data of other
+	// parties. They are set to empty values so the key can be marshaled.
+	thresholdKey := ThresholdKey{
+		LocalPreParams: keygen.LocalPreParams{
+			PaillierSK: &paillier.PrivateKey{
+				PublicKey: paillier.PublicKey{N: new(big.Int)},
+				LambdaN:   new(big.Int),
+				PhiN:      new(big.Int),
+			},
+			NTildei: new(big.Int),
+			H1i:     new(big.Int),
+			H2i:     new(big.Int),
+			Alpha:   new(big.Int),
+			Beta:    new(big.Int),
+			P:       new(big.Int),
+			Q:       new(big.Int),
+		},
+		LocalSecrets: keygen.LocalSecrets{
+			Xi:      privateKey.D,
+			ShareID: shareID,
+		},
+		BigXj:       []*crypto.ECPoint{publicKey},
+		PaillierPKs: []*paillier.PublicKey{},
+		NTildej:     []*big.Int{},
+		H1j:         []*big.Int{},
+		H2j:         []*big.Int{},
+		Ks:          []*big.Int{shareID},
+		ECDSAPub:    publicKey,
+	}
+
+	return &ThresholdSigner{
+		groupInfo: &groupInfo{
+			groupID:            groupID,
+			memberID:           memberID,
+			groupMemberIDs:     []MemberID{memberID},
+			dishonestThreshold: 0,
+		},
+		thresholdKey: thresholdKey,
+	}, nil
+}
+
+// isLocal returns true if the signer is the only member of the group and holds
+// the whole private key.
+func (s *ThresholdSigner) isLocal() bool {
+	return len(s.groupMemberIDs) == 1
+}
+
+// calculateLocalSignature calculates a signature for the given digest with the
+// private key held by a single-member group signer.
+func (s *ThresholdSigner) calculateLocalSignature(
+	digest []byte,
+) (*ecdsa.Signature, error) {
+	privateKey, err := ethcrypto.ToECDSA(
+		math.PaddedBigBytes(s.thresholdKey.LocalSecrets.Xi, 32),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: [%v]", err)
+	}
+
+	// Signature is returned in [R || S || V] format, where V is 0 or 1.
+	signature, err := ethcrypto.Sign(digest, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: [%v]", err)
+	}
+
+	return &ecdsa.Signature{
+		R:          new(big.Int).SetBytes(signature[:32]),
+		S:          new(big.Int).SetBytes(signature[32:64]),
+		RecoveryID: int(signature[64]),
+	}, nil
+}
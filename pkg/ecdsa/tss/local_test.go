@@ -0,0 +1,41 @@
+package tss
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils/pbutils"
+	"github.com/keep-network/keep-ecdsa/pkg/utils/testutils"
+)
+
+func TestGenerateLocalSignerAndSign(t *testing.T) {
+	memberIDs, err := generateMemberKeys(1)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	signer, err := GenerateLocalSigner("local-test-group", memberIDs[0])
+	if err != nil {
+		t.Fatalf("failed to generate signer: [%v]", err)
+	}
+
+	// Signer should be usable after being restored from the persistent storage.
+	unmarshaled := &ThresholdSigner{}
+	if err := pbutils.RoundTrip(signer, unmarshaled); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("single member keep"))
+
+	signature, err := unmarshaled.CalculateSignature(
+		context.Background(),
+		digest[:],
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to calculate signature: [%v]", err)
+	}
+
+	testutils.VerifyEthereumSignature(t, digest[:], signature, signer.PublicKey())
+}
@@ -50,3 +50,14 @@ type groupInfo struct {
 	// of `t + 1` players can jointly sign, but any smaller subset cannot.
 	dishonestThreshold int
 }
+
+// isMember checks if the given member belongs to the group.
+func (g *groupInfo) isMember(memberID MemberID) bool {
+	for _, groupMemberID := range g.groupMemberIDs {
+		if groupMemberID.Equal(memberID) {
+			return true
+		}
+	}
+
+	return false
+}
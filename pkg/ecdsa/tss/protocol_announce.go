@@ -3,6 +3,7 @@ package tss
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/keep-network/keep-core/pkg/net"
@@ -11,10 +12,17 @@ import (
 
 const protocolAnnounceTimeout = 2 * time.Minute
 
+// AnnounceProtocol announces the member's presence to peer members and
+// gathers announcements from them. The protocol completes as soon as
+// announcements from all `membersCount` members have been received. If the
+// timeout is reached before that, the protocol still completes successfully
+// if announcements from at least `quorum` members have been received;
+// otherwise an error is returned.
 func AnnounceProtocol(
 	parentCtx context.Context,
 	publicKey *operator.PublicKey,
 	membersCount int,
+	quorum int,
 	broadcastChannel net.BroadcastChannel,
 ) (
 	[]MemberID,
@@ -34,6 +42,7 @@ func AnnounceProtocol(
 	}
 	broadcastChannel.Recv(ctx, handleAnnounceMessage)
 
+	receivedMemberIDsMutex := &sync.Mutex{}
 	receivedMemberIDs := make(map[string]MemberID)
 
 	go func() {
@@ -42,6 +51,7 @@ func AnnounceProtocol(
 			case <-ctx.Done():
 				return
 			case msg := <-announceInChan:
+				receivedMemberIDsMutex.Lock()
 				// Since broadcast channel has an address filter, we can
 				// assume each message come from a valid group member.
 				receivedMemberIDs[msg.SenderID.String()] = msg.SenderID
@@ -49,6 +59,7 @@ func AnnounceProtocol(
 				if len(receivedMemberIDs) == membersCount {
 					cancel()
 				}
+				receivedMemberIDsMutex.Unlock()
 			}
 		}
 	}()
@@ -79,20 +90,43 @@ func AnnounceProtocol(
 
 	<-ctx.Done()
 
+	receivedMemberIDsMutex.Lock()
+	defer receivedMemberIDsMutex.Unlock()
+
+	memberIDs := make([]MemberID, 0)
+	for _, memberID := range receivedMemberIDs {
+		memberIDs = append(memberIDs, memberID)
+	}
+
 	switch ctx.Err() {
 	case context.DeadlineExceeded:
+		if parentCtx.Err() == nil && len(memberIDs) >= quorum {
+			logger.Infof(
+				"announce protocol completed with quorum; "+
+					"received announcements from [%d] out of [%d] members",
+				len(memberIDs),
+				membersCount,
+			)
+
+			return memberIDs, nil
+		}
+
 		return nil, fmt.Errorf(
-			"waiting for announcements timed out after: [%v]",
+			"waiting for announcements timed out after: [%v]; "+
+				"received announcements from [%d] out of [%d] members "+
+				"but required at least [%d]",
 			protocolAnnounceTimeout,
+			len(memberIDs),
+			membersCount,
+			quorum,
 		)
 	case context.Canceled:
-		logger.Infof("announce protocol completed successfully")
-
-		memberIDs := make([]MemberID, 0)
-		for _, memberID := range receivedMemberIDs {
-			memberIDs = append(memberIDs, memberID)
+		if len(memberIDs) != membersCount {
+			return nil, fmt.Errorf("announce protocol cancelled")
 		}
 
+		logger.Infof("announce protocol completed successfully")
+
 		return memberIDs, nil
 	default:
 		return nil, fmt.Errorf("unexpected context error: [%v]", ctx.Err())
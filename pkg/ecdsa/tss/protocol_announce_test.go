@@ -61,6 +61,7 @@ func TestAnnounceProtocol(t *testing.T) {
 				ctx,
 				memberPublicKey,
 				groupSize,
+				groupSize,
 				broadcastChannel,
 			)
 			if err != nil {
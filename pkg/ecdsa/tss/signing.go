@@ -14,10 +14,12 @@ import (
 )
 
 // initializeSigning initializes a member to run a threshold multi-party signature
-// calculation protocol. Signature will be calculated for provided digest.
+// calculation protocol. Signature will be calculated for provided digest by
+// members of the provided signing group.
 func (s *ThresholdSigner) initializeSigning(
 	ctx context.Context,
 	digest []byte,
+	signingGroup *groupInfo,
 	netBridge *networkBridge,
 ) (*signingSigner, error) {
 	digestInt := new(big.Int).SetBytes(digest)
@@ -25,6 +27,7 @@ func (s *ThresholdSigner) initializeSigning(
 	party, endChan, err := s.initializeSigningParty(
 		ctx,
 		digestInt,
+		signingGroup,
 		netBridge,
 	)
 	if err != nil {
@@ -32,7 +35,7 @@ func (s *ThresholdSigner) initializeSigning(
 	}
 
 	return &signingSigner{
-		groupInfo:      s.groupInfo,
+		groupInfo:      signingGroup,
 		networkBridge:  netBridge,
 		signingParty:   party,
 		signingEndChan: endChan,
@@ -101,34 +104,44 @@ func (s *signingSigner) sign(ctx context.Context) (*ecdsa.Signature, error) {
 func (s *ThresholdSigner) initializeSigningParty(
 	ctx context.Context,
 	digest *big.Int,
+	signingGroup *groupInfo,
 	netBridge *networkBridge,
 ) (
 	tssLib.Party,
 	<-chan common.SignatureData,
 	error,
 ) {
-	tssMessageChan := make(chan tss.Message, len(s.groupMemberIDs))
+	tssMessageChan := make(chan tss.Message, len(signingGroup.groupMemberIDs))
 	endChan := make(chan common.SignatureData)
 
 	currentPartyID, groupPartiesIDs, err := generatePartiesIDs(
-		s.memberID,
-		s.groupMemberIDs,
+		signingGroup.memberID,
+		signingGroup.groupMemberIDs,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate parties IDs: [%v]", err)
 	}
 
+	sortedPartiesIDs := tss.SortPartyIDs(groupPartiesIDs)
+
 	params := tss.NewParameters(
-		tss.NewPeerContext(tss.SortPartyIDs(groupPartiesIDs)),
+		tss.NewPeerContext(sortedPartiesIDs),
 		currentPartyID,
 		len(groupPartiesIDs),
-		s.dishonestThreshold,
+		signingGroup.dishonestThreshold,
 	)
 
+	// When the signature is calculated by a subset of members who generated
+	// the key, the key data has to be narrowed down to the signing members.
+	keyData := keygen.LocalPartySaveData(s.thresholdKey)
+	if len(signingGroup.groupMemberIDs) != len(s.groupMemberIDs) {
+		keyData = keygen.BuildLocalSaveDataSubset(keyData, sortedPartiesIDs)
+	}
+
 	party := signing.NewLocalParty(
 		digest,
 		params,
-		keygen.LocalPartySaveData(s.thresholdKey),
+		keyData,
 		tssMessageChan,
 		endChan,
 	)
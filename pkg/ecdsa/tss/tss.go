@@ -8,7 +8,9 @@ package tss
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/ipfs/go-log"
@@ -50,7 +52,8 @@ func GenerateThresholdSigner(
 ) (*ThresholdSigner, error) {
 	if len(groupMemberIDs) < 2 {
 		return nil, fmt.Errorf(
-			"group should have at least 2 members but got: [%d]",
+			"group should have at least 2 members but got: [%d]; "+
+				"use GenerateLocalSigner for single-member groups",
 			len(groupMemberIDs),
 		)
 	}
@@ -100,7 +103,7 @@ func GenerateThresholdSigner(
 	}
 
 	if err := readyProtocol(ctx, group, broadcastChannel); err != nil {
-		return nil, fmt.Errorf("readiness signaling protocol failed: [%v]", err)
+		return nil, readinessError{err}
 	}
 
 	// We are begining the communication with other members using pre-parameters
@@ -122,20 +125,44 @@ func GenerateThresholdSigner(
 // CalculateSignature executes a threshold multi-party signature calculation
 // protocol for the given digest. As a result the calculated ECDSA signature will
 // be returned or an error, if the signature generation failed.
+//
+// Signature is calculated by a subset of `t + 1` group members, where `t` is
+// the dishonest threshold. Members announce their presence first and once all
+// of them or at least `t + 1` of them announced, the signing group is selected
+// deterministically from the present members. Members of the signing group
+// confirm they selected the same group in the ready protocol; if they
+// observed different present members and disagree on the group, the ready
+// protocol fails. If the member has not been selected to the signing group,
+// ErrNotInSigningGroup is returned.
+//
+// Signer of a single-member group calculates the signature locally.
 func (s *ThresholdSigner) CalculateSignature(
 	parentCtx context.Context,
 	digest []byte,
 	networkProvider net.Provider,
 ) (*ecdsa.Signature, error) {
-	netBridge, err := newNetworkBridge(s.groupInfo, networkProvider)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
+	if s.isLocal() {
+		return s.calculateLocalSignature(digest)
 	}
 
 	ctx, cancel := context.WithTimeout(parentCtx, SigningProtocolTimeout)
 	defer cancel()
 
-	signingSigner, err := s.initializeSigning(ctx, digest[:], netBridge)
+	signingGroup, err := s.selectSigningGroup(ctx, networkProvider)
+	if err != nil {
+		return nil, signingGroupSelectionError{err}
+	}
+
+	if !signingGroup.isMember(s.memberID) {
+		return nil, ErrNotInSigningGroup
+	}
+
+	netBridge, err := newNetworkBridge(signingGroup, networkProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
+	}
+
+	signingSigner, err := s.initializeSigning(ctx, digest[:], signingGroup, netBridge)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize signing: [%v]", err)
 	}
@@ -145,8 +172,8 @@ func (s *ThresholdSigner) CalculateSignature(
 		return nil, err
 	}
 
-	if err := readyProtocol(ctx, s.groupInfo, broadcastChannel); err != nil {
-		return nil, fmt.Errorf("readiness signaling protocol failed: [%v]", err)
+	if err := readyProtocol(ctx, signingGroup, broadcastChannel); err != nil {
+		return nil, readinessError{err}
 	}
 
 	signature, err := signingSigner.sign(ctx)
@@ -156,3 +183,136 @@ func (s *ThresholdSigner) CalculateSignature(
 
 	return signature, err
 }
+
+// selectSigningGroup executes the announce protocol with all group members and
+// selects the signing group from members who announced their presence.
+func (s *ThresholdSigner) selectSigningGroup(
+	ctx context.Context,
+	networkProvider net.Provider,
+) (*groupInfo, error) {
+	signingGroupSize := s.dishonestThreshold + 1
+
+	// For groups where all members are required to sign there is nothing
+	// to select.
+	if signingGroupSize == len(s.groupMemberIDs) {
+		return s.groupInfo, nil
+	}
+
+	publicKey, err := s.memberID.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member public key: [%v]", err)
+	}
+
+	netBridge, err := newNetworkBridge(s.groupInfo, networkProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
+	}
+
+	broadcastChannel, err := netBridge.getBroadcastChannel()
+	if err != nil {
+		return nil, err
+	}
+
+	presentMemberIDs, err := AnnounceProtocol(
+		ctx,
+		publicKey,
+		len(s.groupMemberIDs),
+		signingGroupSize,
+		broadcastChannel,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("announce protocol failed: [%v]", err)
+	}
+
+	signingGroup, err := newSigningGroup(
+		s.groupInfo,
+		presentMemberIDs,
+		signingGroupSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infof(
+		"selected [%d] out of [%d] present members to the signing group",
+		len(signingGroup.groupMemberIDs),
+		len(presentMemberIDs),
+	)
+
+	return signingGroup, nil
+}
+
+// newSigningGroup selects the signing group of the given size from members
+// of the group who announced their presence.
+func newSigningGroup(
+	group *groupInfo,
+	presentMemberIDs []MemberID,
+	signingGroupSize int,
+) (*groupInfo, error) {
+	signingMemberIDs, err := selectSigningMembers(
+		group.groupMemberIDs,
+		presentMemberIDs,
+		signingGroupSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupInfo{
+		groupID:            signingGroupID(group.groupID, signingMemberIDs),
+		memberID:           group.memberID,
+		groupMemberIDs:     signingMemberIDs,
+		dishonestThreshold: group.dishonestThreshold,
+	}, nil
+}
+
+// signingGroupID returns the identifier of the signing group selected from
+// the group with the given identifier. The identifier depends on the selected
+// members, so members who observed different sets of present members and
+// selected different signing groups communicate over different channels.
+// The ready protocol does not complete for them and the signing fails instead
+// of being started by members who disagree on the signing group.
+func signingGroupID(groupID string, signingMemberIDs []MemberID) string {
+	hash := sha256.New()
+	for _, memberID := range signingMemberIDs {
+		hash.Write(memberID)
+	}
+
+	return fmt.Sprintf("%s-%x", groupID, hash.Sum(nil)[:8])
+}
+
+// selectSigningMembers selects signing group members from the present members.
+// Only members of the group are taken into account. Members are ordered by
+// their identifiers and the first `signingGroupSize` of them are selected so
+// that all members who observed the same set of present members select the
+// same signing group.
+func selectSigningMembers(
+	groupMemberIDs []MemberID,
+	presentMemberIDs []MemberID,
+	signingGroupSize int,
+) ([]MemberID, error) {
+	candidates := make([]MemberID, 0, len(presentMemberIDs))
+	for _, memberID := range groupMemberIDs {
+		for _, presentMemberID := range presentMemberIDs {
+			if memberID.Equal(presentMemberID) {
+				candidates = append(candidates, memberID)
+				break
+			}
+		}
+	}
+
+	if len(candidates) < signingGroupSize {
+		return nil, fmt.Errorf(
+			"not enough members present to sign; "+
+				"present: [%d], required: [%d]",
+			len(candidates),
+			signingGroupSize,
+		)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].bigInt().Cmp(candidates[j].bigInt()) < 0
+	})
+
+	return candidates[:signingGroupSize], nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -219,6 +220,175 @@ func TestGenerateKeyAndSign(t *testing.T) {
 	testutils.VerifyEthereumSignature(t, digest[:], firstSignature, firstPublicKey)
 }
 
+func TestSelectSigningMembers(t *testing.T) {
+	groupMemberIDs, err := generateMemberKeys(5)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	outsiderIDs, err := generateMemberKeys(1)
+	if err != nil {
+		t.Fatalf("failed to generate outsider key: [%v]", err)
+	}
+
+	presentMemberIDs := []MemberID{
+		groupMemberIDs[4],
+		outsiderIDs[0],
+		groupMemberIDs[1],
+		groupMemberIDs[3],
+	}
+
+	signingMemberIDs, err := selectSigningMembers(
+		groupMemberIDs,
+		presentMemberIDs,
+		3,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signingMemberIDs) != 3 {
+		t.Fatalf(
+			"unexpected signing group size\nexpected: [%v]\nactual:   [%v]",
+			3,
+			len(signingMemberIDs),
+		)
+	}
+
+	for _, signingMemberID := range signingMemberIDs {
+		if signingMemberID.Equal(outsiderIDs[0]) {
+			t.Errorf("non-member [%v] selected to signing group", signingMemberID)
+		}
+	}
+
+	// The selection must not depend on the order in which presence has been
+	// observed.
+	reversedPresentMemberIDs := make([]MemberID, len(presentMemberIDs))
+	for i, memberID := range presentMemberIDs {
+		reversedPresentMemberIDs[len(presentMemberIDs)-1-i] = memberID
+	}
+
+	reversedSigningMemberIDs, err := selectSigningMembers(
+		groupMemberIDs,
+		reversedPresentMemberIDs,
+		3,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(signingMemberIDs, reversedSigningMemberIDs) {
+		t.Errorf(
+			"unexpected signing group\nexpected: [%v]\nactual:   [%v]",
+			signingMemberIDs,
+			reversedSigningMemberIDs,
+		)
+	}
+
+	_, err = selectSigningMembers(groupMemberIDs, presentMemberIDs, 4)
+	if err == nil {
+		t.Errorf("expected error for not enough present members")
+	}
+}
+
+func TestSigningGroup_DifferentPresence(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	memberIDs, err := generateMemberKeys(3)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	sort.Slice(memberIDs, func(i, j int) bool {
+		return memberIDs[i].bigInt().Cmp(memberIDs[j].bigInt()) < 0
+	})
+
+	groupID := fmt.Sprintf("tss-test-%d", rand.Int())
+	signingGroupSize := 2
+
+	// The first member observed all members present and selects the first
+	// two of them. The other members have not observed the first member and
+	// select the other two.
+	presentMemberIDs := [][]MemberID{
+		memberIDs,
+		memberIDs[1:],
+		memberIDs[1:],
+	}
+
+	signingGroups := make([]*groupInfo, len(memberIDs))
+	for i, memberID := range memberIDs {
+		signingGroups[i], err = newSigningGroup(
+			&groupInfo{
+				groupID:            groupID,
+				memberID:           memberID,
+				groupMemberIDs:     memberIDs,
+				dishonestThreshold: signingGroupSize - 1,
+			},
+			presentMemberIDs[i],
+			signingGroupSize,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if signingGroups[0].groupID == signingGroups[1].groupID {
+		t.Errorf("members who disagree on the signing group share its identifier")
+	}
+	if signingGroups[1].groupID != signingGroups[2].groupID {
+		t.Errorf("members who agree on the signing group differ in its identifier")
+	}
+
+	// The second member is selected to both signing groups but confirms
+	// readiness only with members who selected the same signing group.
+	readyErrors := make([]error, len(memberIDs))
+
+	var readyWait sync.WaitGroup
+	readyWait.Add(len(memberIDs))
+
+	for i, signingGroup := range signingGroups {
+		go func(i int, signingGroup *groupInfo) {
+			defer readyWait.Done()
+
+			memberPublicKey, err := signingGroup.memberID.PublicKey()
+			if err != nil {
+				readyErrors[i] = err
+				return
+			}
+
+			networkPublicKey := key.NetworkPublic(*memberPublicKey)
+			netBridge, err := newNetworkBridge(
+				signingGroup,
+				newTestNetProvider(&networkPublicKey),
+			)
+			if err != nil {
+				readyErrors[i] = err
+				return
+			}
+
+			broadcastChannel, err := netBridge.getBroadcastChannel()
+			if err != nil {
+				readyErrors[i] = err
+				return
+			}
+
+			readyErrors[i] = readyProtocol(ctx, signingGroup, broadcastChannel)
+		}(i, signingGroup)
+	}
+
+	readyWait.Wait()
+
+	if readyErrors[0] == nil {
+		t.Errorf("expected ready protocol failure for member who disagrees")
+	}
+	for i := 1; i < len(memberIDs); i++ {
+		if readyErrors[i] != nil {
+			t.Errorf("unexpected ready protocol failure: [%v]", readyErrors[i])
+		}
+	}
+}
+
 func generateMemberKeys(groupSize int) ([]MemberID, error) {
 	memberIDs := []MemberID{}
 
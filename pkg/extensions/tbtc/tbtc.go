@@ -5,9 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
-	"math"
 	"math/big"
-	"math/rand"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +18,7 @@ import (
 
 	"github.com/keep-network/keep-common/pkg/chain/chainutil"
 	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/backoff"
 	chain "github.com/keep-network/keep-ecdsa/pkg/chain"
 )
 
@@ -48,16 +47,28 @@ const (
 	defaultSignerActionDelayStep = 5 * time.Minute
 )
 
-// TODO: Resume monitoring after client restart
 // Initialize initializes extension specific to the TBTC application.
-func Initialize(ctx context.Context, chain chain.TBTCHandle) error {
+// Monitoring of deposits which were in progress before the client restart
+// is resumed with the remaining part of the original timeout.
+// Chain state expectations are confirmed after the given number of blocks.
+// Failed deposit transactions are retried with delays determined by the
+// given policy.
+func Initialize(
+	ctx context.Context,
+	chain chain.TBTCHandle,
+	blockConfirmations uint64,
+	retryPolicy backoff.Policy,
+) error {
 	logger.Infof("initializing tbtc extension")
 
 	tbtc := newTBTC(chain)
+	if blockConfirmations > 0 {
+		tbtc.blockConfirmations = blockConfirmations
+	}
 
 	err := tbtc.monitorRetrievePubKey(
 		ctx,
-		exponentialBackoff,
+		retryPolicy.Delay,
 		165*time.Minute, // 15 minutes before the 3 hours on-chain timeout
 	)
 	if err != nil {
@@ -69,7 +80,7 @@ func Initialize(ctx context.Context, chain chain.TBTCHandle) error {
 
 	err = tbtc.monitorProvideRedemptionSignature(
 		ctx,
-		exponentialBackoff,
+		retryPolicy.Delay,
 		105*time.Minute, // 15 minutes before the 2 hours on-chain timeout
 	)
 	if err != nil {
@@ -82,7 +93,7 @@ func Initialize(ctx context.Context, chain chain.TBTCHandle) error {
 
 	err = tbtc.monitorProvideRedemptionProof(
 		ctx,
-		exponentialBackoff,
+		retryPolicy.Delay,
 		345*time.Minute, // 15 minutes before the 6 hours on-chain timeout
 	)
 	if err != nil {
@@ -122,12 +133,28 @@ func (t *tbtc) monitorRetrievePubKey(
 	actBackoffFn backoffFn,
 	timeout time.Duration,
 ) error {
+	monitoringName := "retrieve pubkey"
 	initialDepositState := chain.AwaitingSignerSetup
 
+	resumedDeposits := &sync.Map{}
+
 	monitoringStartFn := func(
 		handler depositEventHandler,
 	) (subscription.EventSubscription, error) {
-		return t.chain.OnDepositCreated(handler)
+		depositCreatedSubscription, err := t.chain.OnDepositCreated(handler)
+		if err != nil {
+			return nil, err
+		}
+
+		t.resumeMonitoring(
+			monitoringName,
+			initialDepositState,
+			t.pastDepositCreatedBlocks,
+			resumedDeposits,
+			handler,
+		)
+
+		return depositCreatedSubscription, nil
 	}
 
 	monitoringStopFn := func(
@@ -167,17 +194,26 @@ func (t *tbtc) monitorRetrievePubKey(
 	}
 
 	timeoutFn := func(depositAddress string) (time.Duration, error) {
+		remainingTimeout, err := t.remainingTimeout(
+			resumedDeposits,
+			depositAddress,
+			timeout,
+		)
+		if err != nil {
+			return 0, err
+		}
+
 		actionDelay, err := t.getSignerActionDelay(depositAddress)
 		if err != nil {
 			return 0, err
 		}
 
-		return timeout + actionDelay, nil
+		return remainingTimeout + actionDelay, nil
 	}
 
 	monitoringSubscription, err := t.monitorAndAct(
 		ctx,
-		"retrieve pubkey",
+		monitoringName,
 		t.shouldMonitorDeposit,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -206,14 +242,32 @@ func (t *tbtc) monitorProvideRedemptionSignature(
 	actBackoffFn backoffFn,
 	timeout time.Duration,
 ) error {
+	monitoringName := "provide redemption signature"
 	initialDepositState := chain.AwaitingWithdrawalSignature
 
+	resumedDeposits := &sync.Map{}
+
 	monitoringStartFn := func(
 		handler depositEventHandler,
 	) (subscription.EventSubscription, error) {
 		// Start right after a redemption has been requested or the redemption
 		// fee has been increased.
-		return t.chain.OnDepositRedemptionRequested(handler)
+		redemptionRequestedSubscription, err := t.chain.OnDepositRedemptionRequested(
+			handler,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		t.resumeMonitoring(
+			monitoringName,
+			initialDepositState,
+			t.pastRedemptionRequestedBlocks,
+			resumedDeposits,
+			handler,
+		)
+
+		return redemptionRequestedSubscription, nil
 	}
 
 	monitoringStopFn := func(
@@ -346,17 +400,26 @@ func (t *tbtc) monitorProvideRedemptionSignature(
 	}
 
 	timeoutFn := func(depositAddress string) (time.Duration, error) {
+		remainingTimeout, err := t.remainingTimeout(
+			resumedDeposits,
+			depositAddress,
+			timeout,
+		)
+		if err != nil {
+			return 0, err
+		}
+
 		actionDelay, err := t.getSignerActionDelay(depositAddress)
 		if err != nil {
 			return 0, err
 		}
 
-		return timeout + actionDelay, nil
+		return remainingTimeout + actionDelay, nil
 	}
 
 	monitoringSubscription, err := t.monitorAndAct(
 		ctx,
-		"provide redemption signature",
+		monitoringName,
 		t.shouldMonitorDeposit,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -385,13 +448,33 @@ func (t *tbtc) monitorProvideRedemptionProof(
 	actBackoffFn backoffFn,
 	timeout time.Duration,
 ) error {
+	monitoringName := "provide redemption proof"
 	initialDepositState := chain.AwaitingWithdrawalProof
 
 	monitoringStartFn := func(
 		handler depositEventHandler,
 	) (subscription.EventSubscription, error) {
 		// Start right after a redemption signature has been provided.
-		return t.chain.OnDepositGotRedemptionSignature(handler)
+		gotRedemptionSignatureSubscription, err := t.chain.OnDepositGotRedemptionSignature(
+			handler,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Deposits awaiting the redemption proof are found by their
+		// redemption requests. The timeout is always calculated relatively
+		// to the latest redemption request so there is no need to track
+		// resumed deposits separately.
+		t.resumeMonitoring(
+			monitoringName,
+			initialDepositState,
+			t.pastRedemptionRequestedBlocks,
+			nil,
+			handler,
+		)
+
+		return gotRedemptionSignatureSubscription, nil
 	}
 
 	monitoringStopFn := func(
@@ -566,7 +649,7 @@ func (t *tbtc) monitorProvideRedemptionProof(
 
 	monitoringSubscription, err := t.monitorAndAct(
 		ctx,
-		"provide redemption proof",
+		monitoringName,
 		t.shouldMonitorDeposit,
 		monitoringStartFn,
 		monitoringStopFn,
@@ -978,6 +1061,141 @@ func (t *tbtc) pastEventsLookupStartBlock() uint64 {
 	return currentBlock - pastEventsLookbackBlocks
 }
 
+// pastDepositCreatedBlocks returns numbers of blocks in which deposits
+// created within the past events lookup period have been created.
+func (t *tbtc) pastDepositCreatedBlocks() (map[string]uint64, error) {
+	events, err := t.chain.PastDepositCreatedEvents(
+		t.pastEventsLookupStartBlock(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[string]uint64)
+	for _, event := range events {
+		blocks[event.DepositAddress] = event.BlockNumber
+	}
+
+	return blocks, nil
+}
+
+// pastRedemptionRequestedBlocks returns numbers of blocks in which the latest
+// redemption requests occurred for deposits redeemed within the past events
+// lookup period.
+func (t *tbtc) pastRedemptionRequestedBlocks() (map[string]uint64, error) {
+	events, err := t.chain.PastAllDepositRedemptionRequestedEvents(
+		t.pastEventsLookupStartBlock(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Events are sorted by block number in ascending order so the latest
+	// request of each deposit overwrites the previous ones.
+	blocks := make(map[string]uint64)
+	for _, event := range events {
+		blocks[event.DepositAddress] = event.BlockNumber
+	}
+
+	return blocks, nil
+}
+
+type pastStartBlocksFn func() (map[string]uint64, error)
+
+// resumeMonitoring looks for deposits whose monitoring start events occurred
+// before the monitoring has been started, e.g. before the client restart,
+// and which are still in the initial state of the monitoring. The start event
+// handler is invoked for each such deposit. Numbers of blocks in which the
+// start events occurred are stored in the resumed deposits map, if provided,
+// so the monitoring timeout can be shortened by the time already elapsed.
+func (t *tbtc) resumeMonitoring(
+	monitoringName string,
+	initialDepositState chain.DepositState,
+	pastStartBlocksFn pastStartBlocksFn,
+	resumedDeposits *sync.Map,
+	handler depositEventHandler,
+) {
+	startBlocks, err := pastStartBlocksFn()
+	if err != nil {
+		logger.Errorf(
+			"could not get past start events to resume [%v] monitoring: [%v]",
+			monitoringName,
+			err,
+		)
+		return
+	}
+
+	for depositAddress, startBlock := range startBlocks {
+		if !t.shouldMonitorDeposit(depositAddress) {
+			continue
+		}
+
+		currentState, err := t.chain.CurrentState(depositAddress)
+		if err != nil {
+			logger.Errorf(
+				"could not get current state of deposit [%v] to resume "+
+					"[%v] monitoring: [%v]",
+				depositAddress,
+				monitoringName,
+				err,
+			)
+			continue
+		}
+
+		if currentState != initialDepositState {
+			continue
+		}
+
+		logger.Infof(
+			"resuming [%v] monitoring for deposit [%v]",
+			monitoringName,
+			depositAddress,
+		)
+
+		if resumedDeposits != nil {
+			resumedDeposits.Store(depositAddress, startBlock)
+		}
+
+		handler(depositAddress)
+	}
+}
+
+// remainingTimeout returns the part of the monitoring timeout which remains
+// for the given deposit. For deposits whose monitoring has been resumed,
+// the time elapsed since the block of the start event is subtracted from
+// the timeout; if the whole timeout has already elapsed, zero is returned.
+// For all other deposits the timeout is returned unchanged.
+func (t *tbtc) remainingTimeout(
+	resumedDeposits *sync.Map,
+	depositAddress string,
+	timeout time.Duration,
+) (time.Duration, error) {
+	startBlock, ok := resumedDeposits.Load(depositAddress)
+	if !ok {
+		return timeout, nil
+	}
+	resumedDeposits.Delete(depositAddress)
+
+	startTimestamp, err := t.chain.BlockTimestamp(
+		new(big.Int).SetUint64(startBlock.(uint64)),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	currentTimestamp := uint64(time.Now().Unix())
+	if currentTimestamp <= startTimestamp {
+		return timeout, nil
+	}
+
+	elapsed := time.Duration(currentTimestamp-startTimestamp) * time.Second
+	if elapsed >= timeout {
+		return 0, nil
+	}
+
+	return timeout - elapsed, nil
+}
+
 func (t *tbtc) acquireMonitoringLock(depositAddress, monitoringName string) bool {
 	_, isExistingKey := t.monitoringLocks.LoadOrStore(
 		monitoringLockKey(depositAddress, monitoringName),
@@ -1002,20 +1220,6 @@ func monitoringLockKey(
 	)
 }
 
-// Computes the exponential backoff value for given iteration.
-// For each iteration the result value will be in range:
-// - iteration 1: [2000ms, 2100ms)
-// - iteration 2: [4000ms, 4100ms)
-// - iteration 3: [8000ms, 8100ms)
-// - iteration n: [2^n * 1000ms, (2^n * 1000ms) + 100ms)
-func exponentialBackoff(iteration int) time.Duration {
-	backoffMillis := math.Pow(2, float64(iteration)) * 1000
-	// #nosec G404 (insecure random number source (rand))
-	// No need to use secure randomness for jitter value.
-	jitterMillis := rand.Intn(100)
-	return time.Duration(int(backoffMillis)+jitterMillis) * time.Millisecond
-}
-
 func toLittleEndianBytes(value *big.Int) [8]byte {
 	var valueBytes [8]byte
 	binary.LittleEndian.PutUint64(valueBytes[:], value.Uint64())
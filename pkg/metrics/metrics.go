@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
 
 	"github.com/keep-network/keep-common/pkg/metrics"
 )
@@ -23,11 +25,13 @@ const (
 func ObserveTSSPreParamsPoolSize(
 	ctx context.Context,
 	registry *metrics.Registry,
-	clientHandle *client.Handle,
+	clientHandles []*client.Handle,
 	tick time.Duration,
 ) {
 	input := func() float64 {
-		return float64(clientHandle.TSSPreParamsPoolSize())
+		return sum(clientHandles, func(clientHandle *client.Handle) float64 {
+			return float64(clientHandle.TSSPreParamsPoolSize())
+		})
 	}
 
 	observe(
@@ -39,6 +43,242 @@ func ObserveTSSPreParamsPoolSize(
 	)
 }
 
+// ObserveActiveKeeps triggers an observation process of the active_keeps
+// metric.
+func ObserveActiveKeeps(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandles []*client.Handle,
+	tick time.Duration,
+) {
+	input := func() float64 {
+		return sum(clientHandles, func(clientHandle *client.Handle) float64 {
+			return float64(clientHandle.KeepsCount())
+		})
+	}
+
+	observe(
+		ctx,
+		"active_keeps",
+		input,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
+// ObservePendingSignatures triggers an observation process of the
+// pending_signatures metric.
+func ObservePendingSignatures(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandles []*client.Handle,
+	tick time.Duration,
+) {
+	input := func() float64 {
+		return sum(clientHandles, func(clientHandle *client.Handle) float64 {
+			return float64(clientHandle.PendingSignaturesCount())
+		})
+	}
+
+	observe(
+		ctx,
+		"pending_signatures",
+		input,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
+// ObserveProtocolMetrics triggers an observation process of key generation
+// and signing metrics. All metrics are exposed as gauges observed on every
+// tick, but their values only grow from the client start and are reset on
+// restart. Durations are exposed as the accumulated number of seconds with
+// `_seconds_accumulated` suffix and the number of accumulated observations
+// with `_observations` suffix. An average duration over a time range can be
+// calculated by dividing the change of the former by the change of the latter.
+func ObserveProtocolMetrics(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandles []*client.Handle,
+	tick time.Duration,
+) {
+	protocolMetrics := map[string]func(m node.ProtocolMetrics) float64{
+		"keygen_attempts": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationAttempts)
+		},
+		"keygen_successes": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationSuccesses)
+		},
+		"keygen_timeouts": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationTimeouts)
+		},
+		"keygen_announce_failures": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationAnnounceFailures)
+		},
+		"keygen_readiness_failures": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationReadinessFailures)
+		},
+		"keygen_protocol_failures": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationProtocolFailures)
+		},
+		"keygen_duration_seconds_accumulated": func(m node.ProtocolMetrics) float64 {
+			return m.KeyGenerationDurationSum.Seconds()
+		},
+		"keygen_duration_observations": func(m node.ProtocolMetrics) float64 {
+			return float64(m.KeyGenerationDurationCount)
+		},
+		"signing_attempts": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SigningAttempts)
+		},
+		"signing_successes": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SigningSuccesses)
+		},
+		"signing_timeouts": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SigningTimeouts)
+		},
+		"signing_announce_failures": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SigningAnnounceFailures)
+		},
+		"signing_readiness_failures": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SigningReadinessFailures)
+		},
+		"signing_protocol_failures": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SigningProtocolFailures)
+		},
+		"signature_publication_retries": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SignaturePublicationRetries)
+		},
+		"signature_confirmation_duration_seconds_accumulated": func(m node.ProtocolMetrics) float64 {
+			return m.SignatureConfirmationDurationSum.Seconds()
+		},
+		"signature_confirmation_duration_observations": func(m node.ProtocolMetrics) float64 {
+			return float64(m.SignatureConfirmationDurationCount)
+		},
+	}
+
+	for name, value := range protocolMetrics {
+		value := value
+		input := func() float64 {
+			return sum(clientHandles, func(clientHandle *client.Handle) float64 {
+				return value(clientHandle.ProtocolMetrics())
+			})
+		}
+
+		observe(
+			ctx,
+			name,
+			input,
+			registry,
+			validateTick(tick, DefaultClientMetricsTick),
+		)
+	}
+}
+
+// ObserveSigningPolicyMetrics triggers an observation process of counters of
+// signing requests refused by the signing policy. Counters are exposed as
+// gauges which only grow from the client start and are reset on restart.
+func ObserveSigningPolicyMetrics(
+	ctx context.Context,
+	registry *metrics.Registry,
+	clientHandles []*client.Handle,
+	tick time.Duration,
+) {
+	policyMetrics := map[string]func(m client.SigningPolicyMetrics) float64{
+		"signing_refusals_disabled": func(m client.SigningPolicyMetrics) float64 {
+			return float64(m.SigningDisabledRefusals)
+		},
+		"signing_refusals_maintenance_window": func(m client.SigningPolicyMetrics) float64 {
+			return float64(m.MaintenanceWindowRefusals)
+		},
+		"signing_refusals_owner": func(m client.SigningPolicyMetrics) float64 {
+			return float64(m.OwnerRefusals)
+		},
+		"signing_refusals_rate_limit": func(m client.SigningPolicyMetrics) float64 {
+			return float64(m.RateLimitRefusals)
+		},
+	}
+
+	for name, value := range policyMetrics {
+		value := value
+		input := func() float64 {
+			return sum(clientHandles, func(clientHandle *client.Handle) float64 {
+				return value(clientHandle.SigningPolicyMetrics())
+			})
+		}
+
+		observe(
+			ctx,
+			name,
+			input,
+			registry,
+			validateTick(tick, DefaultClientMetricsTick),
+		)
+	}
+}
+
+// ObserveEventSubscriptions triggers an observation process of the event
+// subscriptions liveness metrics. Subscriptions of all operators run within
+// the process share the same Ethereum connection and are reported together.
+// Resubscriptions and polled events are counted since the client start.
+func ObserveEventSubscriptions(
+	ctx context.Context,
+	registry *metrics.Registry,
+	ethereumChain *ethereum.EthereumChain,
+	tick time.Duration,
+) {
+	observers := map[string]func(health ethereum.SubscriptionsHealth) float64{
+		"event_subscriptions_connected": func(
+			health ethereum.SubscriptionsHealth,
+		) float64 {
+			return float64(health.Connected)
+		},
+		"event_subscriptions_disconnected": func(
+			health ethereum.SubscriptionsHealth,
+		) float64 {
+			return float64(health.Disconnected)
+		},
+		"event_subscriptions_resubscriptions": func(
+			health ethereum.SubscriptionsHealth,
+		) float64 {
+			return float64(health.Resubscriptions)
+		},
+		"event_subscriptions_polled_events": func(
+			health ethereum.SubscriptionsHealth,
+		) float64 {
+			return float64(health.PolledEvents)
+		},
+	}
+
+	for name, value := range observers {
+		value := value
+		input := func() float64 {
+			return value(ethereumChain.SubscriptionsHealth())
+		}
+
+		observe(
+			ctx,
+			name,
+			input,
+			registry,
+			validateTick(tick, DefaultClientMetricsTick),
+		)
+	}
+}
+
+// sum adds up values of all clients. Clients of all operators run within the
+// process are reported together.
+func sum(
+	clientHandles []*client.Handle,
+	value func(clientHandle *client.Handle) float64,
+) float64 {
+	total := float64(0)
+	for _, clientHandle := range clientHandles {
+		total += value(clientHandle)
+	}
+
+	return total
+}
+
 func observe(
 	ctx context.Context,
 	name string,
@@ -0,0 +1,61 @@
+package node
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Determines how many recent errors are kept by the node.
+const maxRecentErrors = 50
+
+// ErrorRecord is an error which occurred in the key generation or signing
+// process.
+type ErrorRecord struct {
+	Time    time.Time
+	Message string
+}
+
+// recentErrorsTrack keeps a bounded list of the most recent errors.
+type recentErrorsTrack struct {
+	mutex   sync.Mutex
+	records []*ErrorRecord
+}
+
+func (ret *recentErrorsTrack) add(message string) {
+	ret.mutex.Lock()
+	defer ret.mutex.Unlock()
+
+	ret.records = append(ret.records, &ErrorRecord{
+		Time:    time.Now(),
+		Message: message,
+	})
+
+	if len(ret.records) > maxRecentErrors {
+		ret.records = ret.records[len(ret.records)-maxRecentErrors:]
+	}
+}
+
+func (ret *recentErrorsTrack) list() []*ErrorRecord {
+	ret.mutex.Lock()
+	defer ret.mutex.Unlock()
+
+	records := make([]*ErrorRecord, len(ret.records))
+	copy(records, ret.records)
+
+	return records
+}
+
+// RecentErrors returns the most recent errors which occurred in the key
+// generation and signing process, starting from the oldest one.
+func (n *Node) RecentErrors() []*ErrorRecord {
+	return n.recentErrors.list()
+}
+
+// logError logs the error and notes it as one of the recent errors.
+func (n *Node) logError(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+
+	logger.Errorf("%s", message)
+	n.recentErrors.add(message)
+}
@@ -0,0 +1,90 @@
+package node
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ProtocolMetrics contains counters and durations of key generation and
+// signing executed by the node. Counters only grow for the lifetime of the
+// node. Durations are exposed as a sum and a count of observations.
+type ProtocolMetrics struct {
+	// Number of key generation attempts, including retries.
+	KeyGenerationAttempts int64
+	// Number of signers successfully generated.
+	KeyGenerationSuccesses int64
+	// Number of key generations abandoned because of the timeout.
+	KeyGenerationTimeouts int64
+	// Number of key generation attempts failed on signer announcement.
+	KeyGenerationAnnounceFailures int64
+	// Number of key generation attempts failed on readiness signaling.
+	KeyGenerationReadinessFailures int64
+	// Number of key generation attempts failed on the protocol execution.
+	KeyGenerationProtocolFailures int64
+	// Total time of successful key generations.
+	KeyGenerationDurationSum time.Duration
+	// Number of observations summed in KeyGenerationDurationSum.
+	KeyGenerationDurationCount int64
+
+	// Number of signature calculation attempts, including retries.
+	SigningAttempts int64
+	// Number of signatures confirmed on-chain.
+	SigningSuccesses int64
+	// Number of signings abandoned because of the timeout.
+	SigningTimeouts int64
+	// Number of signing attempts failed on signing group selection.
+	SigningAnnounceFailures int64
+	// Number of signing attempts failed on readiness signaling.
+	SigningReadinessFailures int64
+	// Number of signing attempts failed on the protocol execution.
+	SigningProtocolFailures int64
+	// Number of retried signature publications.
+	SignaturePublicationRetries int64
+	// Total time from the signing start to the signature on-chain
+	// confirmation.
+	SignatureConfirmationDurationSum time.Duration
+	// Number of observations summed in SignatureConfirmationDurationSum.
+	SignatureConfirmationDurationCount int64
+}
+
+// ProtocolMetrics returns a snapshot of the key generation and signing
+// metrics.
+func (n *Node) ProtocolMetrics() ProtocolMetrics {
+	m := &n.protocolMetrics
+
+	return ProtocolMetrics{
+		KeyGenerationAttempts:          atomic.LoadInt64(&m.KeyGenerationAttempts),
+		KeyGenerationSuccesses:         atomic.LoadInt64(&m.KeyGenerationSuccesses),
+		KeyGenerationTimeouts:          atomic.LoadInt64(&m.KeyGenerationTimeouts),
+		KeyGenerationAnnounceFailures:  atomic.LoadInt64(&m.KeyGenerationAnnounceFailures),
+		KeyGenerationReadinessFailures: atomic.LoadInt64(&m.KeyGenerationReadinessFailures),
+		KeyGenerationProtocolFailures:  atomic.LoadInt64(&m.KeyGenerationProtocolFailures),
+		KeyGenerationDurationSum: time.Duration(
+			atomic.LoadInt64((*int64)(&m.KeyGenerationDurationSum)),
+		),
+		KeyGenerationDurationCount: atomic.LoadInt64(&m.KeyGenerationDurationCount),
+
+		SigningAttempts:             atomic.LoadInt64(&m.SigningAttempts),
+		SigningSuccesses:            atomic.LoadInt64(&m.SigningSuccesses),
+		SigningTimeouts:             atomic.LoadInt64(&m.SigningTimeouts),
+		SigningAnnounceFailures:     atomic.LoadInt64(&m.SigningAnnounceFailures),
+		SigningReadinessFailures:    atomic.LoadInt64(&m.SigningReadinessFailures),
+		SigningProtocolFailures:     atomic.LoadInt64(&m.SigningProtocolFailures),
+		SignaturePublicationRetries: atomic.LoadInt64(&m.SignaturePublicationRetries),
+		SignatureConfirmationDurationSum: time.Duration(
+			atomic.LoadInt64((*int64)(&m.SignatureConfirmationDurationSum)),
+		),
+		SignatureConfirmationDurationCount: atomic.LoadInt64(
+			&m.SignatureConfirmationDurationCount,
+		),
+	}
+}
+
+func increment(counter *int64) {
+	atomic.AddInt64(counter, 1)
+}
+
+func observeDuration(sum *time.Duration, count *int64, startTime time.Time) {
+	atomic.AddInt64((*int64)(sum), int64(time.Since(startTime)))
+	atomic.AddInt64(count, 1)
+}
@@ -6,10 +6,13 @@ import (
 	cecdsa "crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/keep-network/keep-common/pkg/chain/chainutil"
 
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/keep-network/keep-ecdsa/pkg/backoff"
 	"github.com/keep-network/keep-ecdsa/pkg/registry"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -23,49 +26,154 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+	"github.com/keep-network/keep-ecdsa/pkg/signing"
 )
 
 var logger = log.Logger("keep-ecdsa")
 
 const (
-	// Determines the delay which should be preserved before retrying
-	// actions within the key generation and signing process.
-	retryDelay = 1 * time.Second
-
-	// Number of blocks which should elapse before confirming
-	// the given chain state expectations.
-	blockConfirmations = uint64(12)
-
 	// Used to calculate the publication delay factor for the given signer index
 	// to avoid all signers publishing the same signature for given keep at the
 	// same time.
 	signaturePublicationDelayStep = 5 * time.Minute
+
+	// Determines how long the member waits for the signature it submitted to
+	// appear on-chain before submitting it again.
+	signatureSubmissionWaitTimeout = 10 * time.Minute
+
+	// Determines how long the member not selected to the signing group waits
+	// for the signature calculated by the signing group to appear on-chain
+	// before announcing its presence again. The signing group either
+	// calculates the signature within the signing protocol timeout or
+	// fails and announces its presence again.
+	signingGroupWaitTimeout = tss.SigningProtocolTimeout
+
+	// Determines how often the number of in-flight operations is checked
+	// when waiting for them to complete.
+	inFlightOperationsCheckInterval = 100 * time.Millisecond
 )
 
 // Node holds interfaces to interact with the blockchain and network messages
 // transport layer.
 type Node struct {
+	// Number of key generation and signing operations being currently
+	// executed. Accessed atomically, kept as the first field to guarantee
+	// 64-bit alignment.
+	inFlightOperations int64
+
 	ethereumChain   eth.Handle
 	networkProvider net.Provider
 	tssParamsPool   *tssPreParamsPool
 	tssConfig       *tss.Config
+	retryPolicies   *RetryPolicies
+	auditLog        *audit.Log
+
+	// Number of blocks which should elapse before confirming
+	// the given chain state expectations.
+	blockConfirmations uint64
+
+	// Parent context of key generation and signing operations. It is not
+	// bound to the client context, so operations can complete when the
+	// client is stopping, and it is cancelled only to abort them.
+	operationsCtx    context.Context
+	cancelOperations context.CancelFunc
+
+	recentErrors    recentErrorsTrack
+	protocolMetrics ProtocolMetrics
+}
+
+// RetryPolicies contains backoff policies determining delays before retrying
+// actions within the key generation and signing process.
+type RetryPolicies struct {
+	// Used to retry announcement and key generation protocol failures.
+	KeyGeneration backoff.Policy
+	// Used to retry signing protocol failures.
+	Signing backoff.Policy
+	// Used to retry failed or unconfirmed signature submissions.
+	Publication backoff.Policy
+	// Used to retry failed chain calls.
+	ChainCalls backoff.Policy
 }
 
 // NewNode initializes node struct with provided ethereum chain interface and
 // network provider. It also initializes TSS Pre-Parameters pool. But does not
 // start parameters generation. This should be called separately.
+//
+// Key generation and signing operations are recorded in the given audit log.
+// If the audit log is nil, operations are not recorded.
+//
+// Chain state expectations, e.g. a published signature, are confirmed after
+// the given number of blocks.
 func NewNode(
 	ethereumChain eth.Handle,
 	networkProvider net.Provider,
 	tssConfig *tss.Config,
+	retryPolicies *RetryPolicies,
+	auditLog *audit.Log,
+	blockConfirmations uint64,
 ) *Node {
+	operationsCtx, cancelOperations := context.WithCancel(context.Background())
+
 	return &Node{
-		ethereumChain:   ethereumChain,
-		networkProvider: networkProvider,
-		tssConfig:       tssConfig,
+		ethereumChain:      ethereumChain,
+		networkProvider:    networkProvider,
+		tssConfig:          tssConfig,
+		retryPolicies:      retryPolicies,
+		auditLog:           auditLog,
+		blockConfirmations: blockConfirmations,
+		operationsCtx:      operationsCtx,
+		cancelOperations:   cancelOperations,
+	}
+}
+
+// AuditLog returns the audit log in which the node records key generation
+// and signing operations.
+func (n *Node) AuditLog() *audit.Log {
+	return n.auditLog
+}
+
+// WaitForInFlightOperations blocks until all key generation and signing
+// operations being currently executed by the node complete or the timeout
+// elapses. It returns true if all operations completed before the timeout.
+func (n *Node) WaitForInFlightOperations(timeout time.Duration) bool {
+	timeoutChan := time.After(timeout)
+
+	ticker := time.NewTicker(inFlightOperationsCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		inFlightOperations := atomic.LoadInt64(&n.inFlightOperations)
+		if inFlightOperations == 0 {
+			return true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timeoutChan:
+			logger.Warningf(
+				"[%d] key generation and signing operations "+
+					"still in progress after [%v]",
+				inFlightOperations,
+				timeout,
+			)
+			return false
+		}
 	}
 }
 
+// OperationsContext returns the parent context for key generation and signing
+// operations. The context is done only once the operations are aborted.
+func (n *Node) OperationsContext() context.Context {
+	return n.operationsCtx
+}
+
+// AbortInFlightOperations cancels the context of key generation and signing
+// operations, so operations in progress give up at their next attempt and
+// new operations fail immediately.
+func (n *Node) AbortInFlightOperations() {
+	n.cancelOperations()
+}
+
 // AnnounceSignerPresence triggers the announce protocol in order to signal
 // signer presence and gather information about other signers.
 func (n *Node) AnnounceSignerPresence(
@@ -87,10 +195,13 @@ func (n *Node) AnnounceSignerPresence(
 		return nil, fmt.Errorf("failed to set broadcast channel filter: [%v]", err)
 	}
 
+	// Key generation requires all keep members to participate so we expect
+	// announcements from all of them.
 	return tss.AnnounceProtocol(
 		ctx,
 		operatorPublicKey,
 		len(keepMembersAddresses),
+		len(keepMembersAddresses),
 		broadcastChannel,
 	)
 }
@@ -121,7 +232,9 @@ func createAddressFilter(
 }
 
 // GenerateSignerForKeep generates a new threshold signer with ECDSA key pair
-// and submits the public key to the on-chain keep.
+// and submits the public key to the on-chain keep. The honest threshold
+// determines the minimum number of keep members required to cooperate in
+// order to calculate a signature.
 //
 // The attempt for generating signer is retried on failure until the provided
 // context is done.
@@ -130,14 +243,55 @@ func (n *Node) GenerateSignerForKeep(
 	operatorPublicKey *operator.PublicKey,
 	keepAddress common.Address,
 	members []common.Address,
+	honestThreshold uint64,
 	keepsRegistry *registry.Keeps,
 ) (*tss.ThresholdSigner, error) {
+	atomic.AddInt64(&n.inFlightOperations, 1)
+	defer atomic.AddInt64(&n.inFlightOperations, -1)
+
+	if honestThreshold < 1 || honestThreshold > uint64(len(members)) {
+		return nil, fmt.Errorf(
+			"invalid honest threshold [%v] for keep with [%v] members",
+			honestThreshold,
+			len(members),
+		)
+	}
+
+	// Dishonest threshold `t` is the maximum number of members which cannot
+	// produce a signature. Any subset of `t + 1` members can sign so for the
+	// honest threshold `h` the dishonest threshold is `h - 1`.
+	dishonestThreshold := uint(honestThreshold - 1)
+
 	memberID := tss.MemberIDFromPublicKey(operatorPublicKey)
+
+	n.auditLog.Record(
+		audit.KeyGenerationStarted,
+		keepAddress,
+		[32]byte{},
+		map[string]string{
+			"members":         fmt.Sprintf("%v", len(members)),
+			"honestThreshold": fmt.Sprintf("%v", honestThreshold),
+		},
+	)
+
+	// Keep with a single member doesn't need to interact with anyone else
+	// nor does it need pre-parameters to generate the key.
+	if len(members) == 1 {
+		return n.generateLocalSignerForKeep(
+			ctx,
+			memberID,
+			keepAddress,
+			keepsRegistry,
+		)
+	}
+
+	startTime := time.Now()
 	preParamsBox := params.NewBox(n.tssParamsPool.get())
 
 	attemptCounter := 0
 	for {
 		attemptCounter++
+		increment(&n.protocolMetrics.KeyGenerationAttempts)
 
 		logger.Infof(
 			"signer generation for keep [%s]; attempt [%v]",
@@ -152,7 +306,7 @@ func (n *Node) GenerateSignerForKeep(
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			time.Sleep(n.retryPolicies.ChainCalls.Delay(attemptCounter))
 			continue
 		}
 
@@ -172,6 +326,7 @@ func (n *Node) GenerateSignerForKeep(
 		// Global timeout for generating a signer exceeded.
 		// We are giving up and leaving this function.
 		if ctx.Err() != nil {
+			increment(&n.protocolMetrics.KeyGenerationTimeouts)
 			return nil, fmt.Errorf("key generation timeout exceeded")
 		}
 
@@ -189,7 +344,8 @@ func (n *Node) GenerateSignerForKeep(
 		)
 		if err != nil {
 			logger.Warningf("failed to announce signer presence: [%v]", err)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			increment(&n.protocolMetrics.KeyGenerationAnnounceFailures)
+			time.Sleep(n.retryPolicies.KeyGeneration.Delay(attemptCounter))
 			continue
 		}
 
@@ -202,49 +358,179 @@ func (n *Node) GenerateSignerForKeep(
 			keepAddress.Hex(),
 			memberID,
 			memberIDs,
-			uint(len(memberIDs)-1),
+			dishonestThreshold,
 			n.networkProvider,
 			preParamsBox,
 		)
 		if err != nil {
-			logger.Errorf("failed to generate threshold signer: [%v]", err)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			n.logError("failed to generate threshold signer: [%v]", err)
+			if tss.IsReadinessError(err) {
+				increment(&n.protocolMetrics.KeyGenerationReadinessFailures)
+			} else {
+				increment(&n.protocolMetrics.KeyGenerationProtocolFailures)
+			}
+			time.Sleep(n.retryPolicies.KeyGeneration.Delay(attemptCounter))
 			continue
 		}
 
-		// Make a snapshot of the generated signer before publishing the public
-		// key to the keep. This guarantees the signer and their key share are
-		// safely persisted before the public key is registered on-chain.
-		// Then, the snapshot can be used for signer recovery in case something
-		// bad occurs before the final signer registration will be done.
-		err = keepsRegistry.SnapshotSigner(keepAddress, signer)
+		n.recordKeyGenerationFinished(keepAddress, signer)
+
+		if err := n.submitSignerPublicKey(
+			keepAddress,
+			signer,
+			keepsRegistry,
+		); err != nil {
+			return nil, err
+		}
+
+		increment(&n.protocolMetrics.KeyGenerationSuccesses)
+		observeDuration(
+			&n.protocolMetrics.KeyGenerationDurationSum,
+			&n.protocolMetrics.KeyGenerationDurationCount,
+			startTime,
+		)
+
+		return signer, nil // key generation succeeded.
+	}
+}
+
+// generateLocalSignerForKeep generates a signer for a keep with just one
+// member. The key is generated locally as there are no other members to
+// execute the key generation protocol with.
+func (n *Node) generateLocalSignerForKeep(
+	ctx context.Context,
+	memberID tss.MemberID,
+	keepAddress common.Address,
+	keepsRegistry *registry.Keeps,
+) (*tss.ThresholdSigner, error) {
+	logger.Infof(
+		"generating local signer for single-member keep [%s]",
+		keepAddress.String(),
+	)
+
+	attemptCounter := 0
+	for {
+		attemptCounter++
+
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("key generation timeout exceeded")
+		}
+
+		isActive, err := n.ethereumChain.IsActive(keepAddress)
 		if err != nil {
-			return nil, fmt.Errorf(
-				"could not make snapshot of signer for keep [%s]: [%v]",
+			logger.Warningf(
+				"could not check if keep [%s] is still active: [%v]",
 				keepAddress.String(),
 				err,
 			)
+			time.Sleep(n.retryPolicies.ChainCalls.Delay(attemptCounter))
+			continue
 		}
 
-		// Serialize and submit public key to the keep.
-		//
-		// We don't retry in case of an error although the specific chain
-		// implementation may implement its own retry policy. This action
-		// should never fail and if it failed, something terrible happened.
-		publicKey, err := eth.SerializePublicKey(signer.PublicKey())
-		if err != nil {
-			return nil, fmt.Errorf("failed to serialize public key: [%v]", err)
+		if !isActive {
+			return nil, fmt.Errorf("keep is no longer active")
 		}
 
-		err = n.ethereumChain.SubmitKeepPublicKey(keepAddress, publicKey)
-		if err != nil {
-			return nil, fmt.Errorf("failed to submit public key: [%v]", err)
-		}
+		break
+	}
 
-		go n.monitorKeepPublicKeySubmission(keepAddress, publicKey)
+	signer, err := tss.GenerateLocalSigner(keepAddress.Hex(), memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate local signer: [%v]", err)
+	}
 
-		return signer, nil // key generation succeeded.
+	n.recordKeyGenerationFinished(keepAddress, signer)
+
+	if err := n.submitSignerPublicKey(
+		keepAddress,
+		signer,
+		keepsRegistry,
+	); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// submitSignerPublicKey makes a snapshot of the generated signer and submits
+// their public key to the keep.
+func (n *Node) submitSignerPublicKey(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+	keepsRegistry *registry.Keeps,
+) error {
+	// Make a snapshot of the generated signer before publishing the public
+	// key to the keep. This guarantees the signer and their key share are
+	// safely persisted before the public key is registered on-chain.
+	// Then, the snapshot can be used for signer recovery in case something
+	// bad occurs before the final signer registration will be done.
+	err := keepsRegistry.SnapshotSigner(keepAddress, signer)
+	if err != nil {
+		return fmt.Errorf(
+			"could not make snapshot of signer for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	// Serialize and submit public key to the keep.
+	//
+	// We don't retry in case of an error although the specific chain
+	// implementation may implement its own retry policy. This action
+	// should never fail and if it failed, something terrible happened.
+	publicKey, err := eth.SerializePublicKey(signer.PublicKey())
+	if err != nil {
+		return fmt.Errorf("failed to serialize public key: [%v]", err)
+	}
+
+	transactionHash, err := n.ethereumChain.SubmitKeepPublicKey(keepAddress, publicKey)
+	if err != nil {
+		return fmt.Errorf("failed to submit public key: [%v]", err)
+	}
+
+	n.recordPublicKeySubmitted(keepAddress, publicKey, transactionHash)
+
+	go n.monitorKeepPublicKeySubmission(keepAddress, publicKey)
+
+	return nil
+}
+
+// recordKeyGenerationFinished notes the generated signer's public key in the
+// audit log.
+func (n *Node) recordKeyGenerationFinished(
+	keepAddress common.Address,
+	signer *tss.ThresholdSigner,
+) {
+	details := make(map[string]string)
+
+	if publicKey, err := eth.SerializePublicKey(signer.PublicKey()); err == nil {
+		details["publicKey"] = hex.EncodeToString(publicKey[:])
 	}
+
+	n.auditLog.Record(
+		audit.KeyGenerationFinished,
+		keepAddress,
+		[32]byte{},
+		details,
+	)
+}
+
+// recordPublicKeySubmitted notes the public key submission transaction in the
+// audit log.
+func (n *Node) recordPublicKeySubmitted(
+	keepAddress common.Address,
+	publicKey [64]byte,
+	transactionHash common.Hash,
+) {
+	n.auditLog.Record(
+		audit.PublicKeySubmitted,
+		keepAddress,
+		[32]byte{},
+		map[string]string{
+			"publicKey":   hex.EncodeToString(publicKey[:]),
+			"transaction": transactionHash.Hex(),
+		},
+	)
 }
 
 // CalculateSignature calculates a signature over a digest with threshold
@@ -256,12 +542,34 @@ func (n *Node) CalculateSignature(
 	ctx context.Context,
 	signer *tss.ThresholdSigner,
 	digest [32]byte,
+	signingJournal *signing.Journal,
 ) error {
+	atomic.AddInt64(&n.inFlightOperations, 1)
+	defer atomic.AddInt64(&n.inFlightOperations, -1)
+
 	keepAddress := common.HexToAddress(signer.GroupID())
 
+	startTime := time.Now()
+	onConfirmed := func() {
+		recordSigningState(
+			signingJournal,
+			keepAddress,
+			digest,
+			signing.ConfirmedOnChain,
+		)
+
+		increment(&n.protocolMetrics.SigningSuccesses)
+		observeDuration(
+			&n.protocolMetrics.SignatureConfirmationDurationSum,
+			&n.protocolMetrics.SignatureConfirmationDurationCount,
+			startTime,
+		)
+	}
+
 	attemptCounter := 0
 	for {
 		attemptCounter++
+		increment(&n.protocolMetrics.SigningAttempts)
 
 		logger.Infof(
 			"calculate signature for keep [%s]; attempt [%v]",
@@ -269,24 +577,79 @@ func (n *Node) CalculateSignature(
 			attemptCounter,
 		)
 
+		if err := signingJournal.RecordAttempt(keepAddress, digest); err != nil {
+			logger.Errorf(
+				"failed to record signing attempt for digest [%+x] "+
+					"and keep [%s]: [%v]",
+				digest,
+				keepAddress.String(),
+				err,
+			)
+		}
+
 		// Global timeout for generating a signature exceeded.
 		// We are giving up and leaving this function.
 		if ctx.Err() != nil {
+			increment(&n.protocolMetrics.SigningTimeouts)
 			return fmt.Errorf("signing timeout exceeded")
 		}
 
+		// The signature could have been published in the meantime by the
+		// signing group this member has not been selected to, e.g. with
+		// a publication delay exceeding the time the member waited for it.
+		// There is no point in announcing presence again in such case.
+		if attemptCounter > 1 && n.isSignaturePublished(keepAddress, digest) {
+			onConfirmed()
+			return nil
+		}
+
 		// Calculate the signature executing threshold signing protocol with
 		// other keep members.
 		//
 		// If threshold signing fails, we retry from the beginning.
 		signature, err := signer.CalculateSignature(ctx, digest[:], n.networkProvider)
+		if err == tss.ErrNotInSigningGroup {
+			// Enough other members are present to calculate the signature
+			// without this member. We wait for the signature to be published
+			// by them. If it does not appear, the signing group attempt has
+			// most probably failed and we go back to announcing presence so
+			// that the next signing group can be selected.
+			logger.Infof(
+				"member has not been selected to calculate signature "+
+					"for keep [%s]; waiting for other members",
+				keepAddress.String(),
+			)
+			if n.waitForSignature(
+				ctx,
+				keepAddress,
+				digest,
+				signingGroupWaitTimeout,
+			) && n.confirmSignature(keepAddress, digest) {
+				onConfirmed()
+				return nil
+			}
+			logger.Infof(
+				"signature for keep [%s] has not been published by "+
+					"the signing group; announcing presence again",
+				keepAddress.String(),
+			)
+			time.Sleep(n.retryPolicies.Signing.Delay(attemptCounter))
+			continue
+		}
 		if err != nil {
-			logger.Errorf(
+			n.logError(
 				"failed to calculate signature for keep [%s]: [%v]",
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			if tss.IsSigningGroupSelectionError(err) {
+				increment(&n.protocolMetrics.SigningAnnounceFailures)
+			} else if tss.IsReadinessError(err) {
+				increment(&n.protocolMetrics.SigningReadinessFailures)
+			} else {
+				increment(&n.protocolMetrics.SigningProtocolFailures)
+			}
+			time.Sleep(n.retryPolicies.Signing.Delay(attemptCounter))
 			continue
 		}
 
@@ -297,10 +660,55 @@ func (n *Node) CalculateSignature(
 			signature.RecoveryID,
 		)
 
+		recordSigningState(signingJournal, keepAddress, digest, signing.Signed)
+
+		n.auditLog.Record(
+			audit.SignatureProduced,
+			keepAddress,
+			digest,
+			map[string]string{
+				"r":          fmt.Sprintf("%#x", signature.R),
+				"s":          fmt.Sprintf("%#x", signature.S),
+				"recoveryID": fmt.Sprintf("%d", signature.RecoveryID),
+			},
+		)
+
 		// We have the signature so now we need to publish it.
 		// This function implements internal retries so we do not need to
 		// retry here.
-		return n.publishSignature(ctx, keepAddress, digest, signature)
+		if err := n.publishSignature(
+			ctx,
+			keepAddress,
+			digest,
+			signature,
+			signingJournal,
+		); err != nil {
+			return err
+		}
+
+		onConfirmed()
+
+		return nil
+	}
+}
+
+// recordSigningState notes the given state of the signing request in the
+// journal. Failures are only logged as they should not interrupt signing.
+func recordSigningState(
+	signingJournal *signing.Journal,
+	keepAddress common.Address,
+	digest [32]byte,
+	state signing.State,
+) {
+	if err := signingJournal.Record(keepAddress, digest, state); err != nil {
+		logger.Errorf(
+			"failed to record state [%v] of signing request for "+
+				"digest [%+x] and keep [%s]: [%v]",
+			state,
+			digest,
+			keepAddress.String(),
+			err,
+		)
 	}
 }
 
@@ -319,12 +727,16 @@ func (n *Node) publishSignature(
 	keepAddress common.Address,
 	digest [32]byte,
 	signature *ecdsa.Signature,
+	signingJournal *signing.Journal,
 ) error {
 	n.waitSignaturePublicationDelay(keepAddress)
 
 	attemptCounter := 0
 	for {
 		attemptCounter++
+		if attemptCounter > 1 {
+			increment(&n.protocolMetrics.SignaturePublicationRetries)
+		}
 
 		// Global timeout for generating a signature exceeded.
 		// We are giving up and leaving this function.
@@ -338,12 +750,12 @@ func (n *Node) publishSignature(
 		// We are giving up and leaving this function.
 		isActive, err := n.ethereumChain.IsActive(keepAddress)
 		if err != nil {
-			logger.Errorf(
+			n.logError(
 				"failed to verify if keep [%s] is still active: [%v]",
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			time.Sleep(n.retryPolicies.ChainCalls.Delay(attemptCounter))
 			continue
 		}
 		if !isActive {
@@ -359,12 +771,12 @@ func (n *Node) publishSignature(
 		// If the check failed, we retry from the beginning.
 		isAwaitingSignature, err := n.ethereumChain.IsAwaitingSignature(keepAddress, digest)
 		if err != nil {
-			logger.Errorf(
+			n.logError(
 				"failed to verify if keep [%s] is still awaiting signature: [%v]",
 				keepAddress.String(),
 				err,
 			)
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+			time.Sleep(n.retryPolicies.ChainCalls.Delay(attemptCounter))
 			continue
 		}
 
@@ -381,15 +793,16 @@ func (n *Node) publishSignature(
 			attemptCounter,
 		)
 
-		if submissionErr := n.ethereumChain.SubmitSignature(keepAddress, signature); submissionErr != nil {
+		transactionHash, submissionErr := n.ethereumChain.SubmitSignature(keepAddress, signature)
+		if submissionErr != nil {
 			isAwaitingSignature, err := n.ethereumChain.IsAwaitingSignature(keepAddress, digest)
 			if err != nil {
-				logger.Errorf(
+				n.logError(
 					"failed to verify if keep [%s] is still awaiting signature: [%v]",
 					keepAddress.String(),
 					err,
 				)
-				time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+				time.Sleep(n.retryPolicies.ChainCalls.Delay(attemptCounter))
 				continue
 			}
 
@@ -402,20 +815,34 @@ func (n *Node) publishSignature(
 				return nil
 			}
 
-			// Our public key submission transaction failed. We are going to
+			// Our signature submission transaction failed. We are going to
 			// wait for some time and then retry from the beginning.
-			logger.Errorf(
+			n.logError(
 				"failed to submit signature for keep [%s]: [%v]; "+
-					"will retry after 1 minute",
+					"will retry",
 				keepAddress.String(),
 				submissionErr,
 			)
-			time.Sleep(1 * time.Minute)
+			time.Sleep(n.retryPolicies.Publication.Delay(attemptCounter))
 			continue
 		}
 
-		if !(n.waitForSignature(keepAddress, digest) && n.confirmSignature(keepAddress, digest)) {
-			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
+		recordSigningState(signingJournal, keepAddress, digest, signing.Published)
+
+		n.auditLog.Record(
+			audit.TransactionSubmitted,
+			keepAddress,
+			digest,
+			map[string]string{"transaction": transactionHash.Hex()},
+		)
+
+		if !(n.waitForSignature(
+			ctx,
+			keepAddress,
+			digest,
+			signatureSubmissionWaitTimeout,
+		) && n.confirmSignature(keepAddress, digest)) {
+			time.Sleep(n.retryPolicies.Publication.Delay(attemptCounter))
 			continue
 		}
 
@@ -431,7 +858,7 @@ func (n *Node) waitSignaturePublicationDelay(
 ) {
 	signerIndex, err := n.getSignerIndex(keepAddress)
 	if err != nil {
-		logger.Errorf(
+		n.logError(
 			"could not determine signature publication delay for keep [%s]: "+
 				"[%v]; the signature publication will not be delayed",
 			keepAddress.String(),
@@ -442,7 +869,7 @@ func (n *Node) waitSignaturePublicationDelay(
 
 	// just in case this function is not invoked in the right context
 	if signerIndex < 0 {
-		logger.Errorf(
+		n.logError(
 			"could not determine signature publication delay for keep [%s], "+
 				"signer index is less than zero; the signature publication "+
 				"will not be delayed",
@@ -477,14 +904,18 @@ func (n *Node) getSignerIndex(keepAddress common.Address) (int, error) {
 	return -1, nil
 }
 
+// waitForSignature waits until the keep no longer awaits a signature for the
+// digest. It gives up and returns false when the signature has not appeared
+// on-chain within the given timeout or when the provided context is done.
 func (n *Node) waitForSignature(
+	parentCtx context.Context,
 	keepAddress common.Address,
 	digest [32]byte,
+	waitTimeout time.Duration,
 ) bool {
-	const waitTimeout = 10 * time.Minute
 	const checkTick = 1 * time.Minute
 
-	ctx, cancelCtx := context.WithTimeout(context.Background(), waitTimeout)
+	ctx, cancelCtx := context.WithTimeout(parentCtx, waitTimeout)
 	defer cancelCtx()
 
 	checkTicker := time.NewTicker(checkTick)
@@ -507,7 +938,7 @@ func (n *Node) waitForSignature(
 				digest,
 			)
 			if err != nil {
-				logger.Errorf(
+				n.logError(
 					"failed to perform signature check while waiting "+
 						"for signature for keep [%s]: [%v]",
 					keepAddress.String(),
@@ -524,9 +955,9 @@ func (n *Node) waitForSignature(
 				return true
 			}
 		case <-ctx.Done():
-			logger.Errorf(
+			n.logError(
 				"signature for keep [%s] has not appeared on the chain "+
-					"after [%v] from submitting it",
+					"within [%v]",
 				keepAddress.String(),
 				waitTimeout,
 			)
@@ -535,6 +966,29 @@ func (n *Node) waitForSignature(
 	}
 }
 
+// isSignaturePublished checks if the keep no longer awaits a signature for
+// the digest and confirms it. Chain call failures are only logged and the
+// signature is considered as not published.
+func (n *Node) isSignaturePublished(
+	keepAddress common.Address,
+	digest [32]byte,
+) bool {
+	isAwaitingSignature, err := n.ethereumChain.IsAwaitingSignature(
+		keepAddress,
+		digest,
+	)
+	if err != nil {
+		n.logError(
+			"failed to verify if keep [%s] is still awaiting signature: [%v]",
+			keepAddress.String(),
+			err,
+		)
+		return false
+	}
+
+	return !isAwaitingSignature && n.confirmSignature(keepAddress, digest)
+}
+
 func (n *Node) confirmSignature(
 	keepAddress common.Address,
 	digest [32]byte,
@@ -546,7 +1000,7 @@ func (n *Node) confirmSignature(
 
 	currentBlock, err := n.ethereumChain.BlockCounter().CurrentBlock()
 	if err != nil {
-		logger.Errorf(
+		n.logError(
 			"could not get current block while confirming "+
 				"signature submission for keep [%s]: [%v]",
 			keepAddress.String(),
@@ -558,7 +1012,7 @@ func (n *Node) confirmSignature(
 	isSignatureConfirmed, err := chainutil.WaitForBlockConfirmations(
 		n.ethereumChain.BlockCounter(),
 		currentBlock,
-		blockConfirmations,
+		n.blockConfirmations,
 		func() (bool, error) {
 			isAwaitingSignature, err := n.ethereumChain.IsAwaitingSignature(
 				keepAddress,
@@ -572,7 +1026,7 @@ func (n *Node) confirmSignature(
 		},
 	)
 	if err != nil {
-		logger.Errorf(
+		n.logError(
 			"could not confirm signature submission for keep [%s]: [%v]",
 			keepAddress.String(),
 			err,
@@ -581,7 +1035,7 @@ func (n *Node) confirmSignature(
 	}
 
 	if !isSignatureConfirmed {
-		logger.Errorf(
+		n.logError(
 			"signature submission for keep [%s] not confirmed; "+
 				"trying to submit the signature again",
 			keepAddress.String(),
@@ -630,7 +1084,7 @@ func (n *Node) monitorKeepPublicKeySubmission(
 		},
 	)
 	if err != nil {
-		logger.Errorf(
+		n.logError(
 			"failed on watching conflicting public key event for keep [%s]: [%v]",
 			keepAddress.String(),
 			err,
@@ -665,7 +1119,7 @@ func (n *Node) monitorKeepPublicKeySubmission(
 			// key for this node. It is clear that something is wrong with the
 			// operator that published the conflicting key and it is safer to
 			// abandon this keep.
-			logger.Errorf(
+			n.logError(
 				"member [%x] has submitted conflicting public key for keep [%s]: [%x]",
 				event.SubmittingMember,
 				keepAddress.String(),
@@ -696,7 +1150,7 @@ func (n *Node) monitorKeepPublicKeySubmission(
 			// the same event can be delivered multiple times.
 			keepPublicKey, err := n.ethereumChain.GetPublicKey(keepAddress)
 			if err != nil {
-				logger.Errorf(
+				n.logError(
 					"failed to get keep public key during "+
 						"public key submission monitoring for keep [%s]: [%v]",
 					keepAddress.String(),
@@ -707,7 +1161,7 @@ func (n *Node) monitorKeepPublicKeySubmission(
 				if len(keepPublicKey) > 0 {
 					currentBlock, err := n.ethereumChain.BlockCounter().CurrentBlock()
 					if err != nil {
-						logger.Errorf(
+						n.logError(
 							"failed to get the current block while "+
 								"performing public key submission confirmation "+
 								"for keep [%s]: [%v]",
@@ -720,7 +1174,7 @@ func (n *Node) monitorKeepPublicKeySubmission(
 					isConfirmed, err := chainutil.WaitForBlockConfirmations(
 						n.ethereumChain.BlockCounter(),
 						currentBlock,
-						blockConfirmations,
+						n.blockConfirmations,
 						func() (bool, error) {
 							key, err := n.ethereumChain.GetPublicKey(
 								keepAddress,
@@ -733,7 +1187,7 @@ func (n *Node) monitorKeepPublicKeySubmission(
 						},
 					)
 					if err != nil {
-						logger.Errorf(
+						n.logError(
 							"failed to perform keep public key "+
 								"confirmation during public key submission "+
 								"monitoring for keep [%s]: [%v]",
@@ -762,9 +1216,9 @@ func (n *Node) monitorKeepPublicKeySubmission(
 				publicKey,
 			)
 
-			err = n.ethereumChain.SubmitKeepPublicKey(keepAddress, publicKey)
+			transactionHash, err := n.ethereumChain.SubmitKeepPublicKey(keepAddress, publicKey)
 			if err != nil {
-				logger.Errorf(
+				n.logError(
 					"keep [%s] still does not have a confirmed public key "+
 						"and resubmission by this member failed with: [%v]",
 					keepAddress.String(),
@@ -772,6 +1226,8 @@ func (n *Node) monitorKeepPublicKeySubmission(
 				)
 				return
 			}
+
+			n.recordPublicKeySubmitted(keepAddress, publicKey, transactionHash)
 		}
 	}
 }
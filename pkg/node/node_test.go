@@ -0,0 +1,115 @@
+package node
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestWaitForInFlightOperations(t *testing.T) {
+	node := &Node{}
+
+	atomic.AddInt64(&node.inFlightOperations, 2)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		atomic.AddInt64(&node.inFlightOperations, -1)
+		time.Sleep(200 * time.Millisecond)
+		atomic.AddInt64(&node.inFlightOperations, -1)
+	}()
+
+	if !node.WaitForInFlightOperations(5 * time.Second) {
+		t.Errorf("operations should complete before the timeout")
+	}
+}
+
+func TestWaitForInFlightOperations_Timeout(t *testing.T) {
+	node := &Node{}
+
+	atomic.AddInt64(&node.inFlightOperations, 1)
+
+	if node.WaitForInFlightOperations(300 * time.Millisecond) {
+		t.Errorf("operations should not complete before the timeout")
+	}
+}
+
+func TestWaitForInFlightOperations_NoOperations(t *testing.T) {
+	node := &Node{}
+
+	if !node.WaitForInFlightOperations(0) {
+		t.Errorf("there should be no operations to wait for")
+	}
+}
+
+func TestWaitForSignature_ContextDone(t *testing.T) {
+	node := &Node{}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		300*time.Millisecond,
+	)
+	defer cancel()
+
+	done := make(chan bool)
+	go func() {
+		done <- node.waitForSignature(
+			ctx,
+			common.HexToAddress("0x1"),
+			[32]byte{},
+			signatureSubmissionWaitTimeout,
+		)
+	}()
+
+	select {
+	case isPublished := <-done:
+		if isPublished {
+			t.Errorf("signature should not be published")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("wait should end when the context is done")
+	}
+}
+
+func TestProtocolMetrics(t *testing.T) {
+	node := &Node{}
+
+	increment(&node.protocolMetrics.SigningAttempts)
+	increment(&node.protocolMetrics.SigningAttempts)
+	observeDuration(
+		&node.protocolMetrics.SignatureConfirmationDurationSum,
+		&node.protocolMetrics.SignatureConfirmationDurationCount,
+		time.Now().Add(-1*time.Minute),
+	)
+
+	metrics := node.ProtocolMetrics()
+
+	if metrics.SigningAttempts != 2 {
+		t.Errorf(
+			"unexpected number of signing attempts\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			2,
+			metrics.SigningAttempts,
+		)
+	}
+
+	if metrics.SignatureConfirmationDurationCount != 1 {
+		t.Errorf(
+			"unexpected number of duration observations\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			1,
+			metrics.SignatureConfirmationDurationCount,
+		)
+	}
+
+	if metrics.SignatureConfirmationDurationSum < 1*time.Minute {
+		t.Errorf(
+			"unexpected duration sum [%v]",
+			metrics.SignatureConfirmationDurationSum,
+		)
+	}
+}
@@ -0,0 +1,292 @@
+package registry
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+// BackupVersion is the version of the backup archive format produced by
+// CreateBackup.
+const BackupVersion = 1
+
+var membershipFileName = regexp.MustCompile("^/?membership_[0-9a-f]{1,40}$")
+
+// backupArchive is the envelope of the backup. Memberships are encrypted to
+// the recipient public key so the envelope itself is safe to store anywhere.
+type backupArchive struct {
+	Version    int       `json:"version"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Recipient  string    `json:"recipient"`
+	Ciphertext []byte    `json:"ciphertext"`
+}
+
+type backupMembership struct {
+	KeepAddress string `json:"keepAddress"`
+	Name        string `json:"name"`
+	Content     []byte `json:"content"`
+}
+
+// BackupSummary describes the content of a backup archive.
+type BackupSummary struct {
+	Version     int
+	CreatedAt   time.Time
+	Memberships int
+	Keeps       []common.Address
+}
+
+// CreateBackup reads all membership files from the given persistence handle
+// and encrypts them to the recipient public key using ECIES over secp256k1.
+// It returns a serialized, versioned backup archive.
+func CreateBackup(
+	persistence persistence.Handle,
+	recipient *ecdsa.PublicKey,
+) ([]byte, *BackupSummary, error) {
+	memberships, err := readMemberships(persistence)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plaintext, err := json.Marshal(memberships)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal memberships: [%v]", err)
+	}
+
+	ciphertext, err := ecies.Encrypt(
+		rand.Reader,
+		ecies.ImportECDSAPublic(recipient),
+		plaintext,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt memberships: [%v]", err)
+	}
+
+	archive := &backupArchive{
+		Version:    BackupVersion,
+		CreatedAt:  time.Now().UTC(),
+		Recipient:  fmt.Sprintf("%x", crypto.CompressPubkey(recipient)),
+		Ciphertext: ciphertext,
+	}
+
+	archiveBytes, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal backup archive: [%v]", err)
+	}
+
+	return archiveBytes, summarize(archive, memberships), nil
+}
+
+// RestoreBackup decrypts the backup archive with the recipient private key,
+// validates all memberships it contains and saves them using the given
+// persistence handle. The persistence handle is expected to contain no
+// memberships; restoring into a data directory in use is refused.
+func RestoreBackup(
+	archiveBytes []byte,
+	recipient *ecdsa.PrivateKey,
+	persistence persistence.Handle,
+) (*BackupSummary, error) {
+	archive, memberships, err := openBackup(archiveBytes, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := readMemberships(persistence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the data directory: [%v]", err)
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf(
+			"data directory already contains [%d] memberships; "+
+				"backup can be restored only into a fresh data directory",
+			len(existing),
+		)
+	}
+
+	for _, membership := range memberships {
+		if err := persistence.Save(
+			membership.Content,
+			membership.KeepAddress,
+			membership.Name,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"failed to save membership [%v] of keep [%v]: [%v]",
+				membership.Name,
+				membership.KeepAddress,
+				err,
+			)
+		}
+	}
+
+	return summarize(archive, memberships), nil
+}
+
+func openBackup(
+	archiveBytes []byte,
+	recipient *ecdsa.PrivateKey,
+) (*backupArchive, []*backupMembership, error) {
+	archive := &backupArchive{}
+	if err := json.Unmarshal(archiveBytes, archive); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal backup archive: [%v]", err)
+	}
+
+	if archive.Version != BackupVersion {
+		return nil, nil, fmt.Errorf(
+			"unsupported backup archive version [%d]; expected [%d]",
+			archive.Version,
+			BackupVersion,
+		)
+	}
+
+	recipientPublicKey := fmt.Sprintf(
+		"%x",
+		crypto.CompressPubkey(&recipient.PublicKey),
+	)
+	if archive.Recipient != recipientPublicKey {
+		return nil, nil, fmt.Errorf(
+			"backup archive is encrypted to [%s], not to the provided key [%s]",
+			archive.Recipient,
+			recipientPublicKey,
+		)
+	}
+
+	plaintext, err := ecies.ImportECDSA(recipient).Decrypt(
+		archive.Ciphertext,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt backup archive: [%v]", err)
+	}
+
+	var memberships []*backupMembership
+	if err := json.Unmarshal(plaintext, &memberships); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal memberships: [%v]", err)
+	}
+
+	for _, membership := range memberships {
+		if err := membership.validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid backup archive: [%v]", err)
+		}
+	}
+
+	return archive, memberships, nil
+}
+
+func (bm *backupMembership) validate() error {
+	if !common.IsHexAddress(bm.KeepAddress) {
+		return fmt.Errorf(
+			"keep address [%v] is not valid ethereum address",
+			bm.KeepAddress,
+		)
+	}
+
+	if !membershipFileName.MatchString(bm.Name) {
+		return fmt.Errorf(
+			"invalid membership file name [%v] for keep [%v]",
+			bm.Name,
+			bm.KeepAddress,
+		)
+	}
+
+	signer := &tss.ThresholdSigner{}
+	if err := signer.Unmarshal(bm.Content); err != nil {
+		return fmt.Errorf(
+			"failed to unmarshal signer from membership [%v] of keep [%v]: [%v]",
+			bm.Name,
+			bm.KeepAddress,
+			err,
+		)
+	}
+
+	return nil
+}
+
+func readMemberships(handle persistence.Handle) ([]*backupMembership, error) {
+	memberships := make([]*backupMembership, 0)
+	contentErrors := make([]error, 0)
+
+	dataChannel, errorsChannel := handle.ReadAll()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for descriptor := range dataChannel {
+			content, err := descriptor.Content()
+			if err != nil {
+				contentErrors = append(contentErrors, fmt.Errorf(
+					"failed to decode content from file [%v] in directory [%v]: [%v]",
+					descriptor.Name(),
+					descriptor.Directory(),
+					err,
+				))
+				continue
+			}
+
+			memberships = append(memberships, &backupMembership{
+				KeepAddress: descriptor.Directory(),
+				Name:        descriptor.Name(),
+				Content:     content,
+			})
+		}
+
+		wg.Done()
+	}()
+
+	var readErrors []error
+	go func() {
+		for err := range errorsChannel {
+			readErrors = append(readErrors, err)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	errors := append(contentErrors, readErrors...)
+	if len(errors) > 0 {
+		return nil, fmt.Errorf(
+			"failed to read [%d] membership files; first error: [%v]",
+			len(errors),
+			errors[0],
+		)
+	}
+
+	return memberships, nil
+}
+
+func summarize(
+	archive *backupArchive,
+	memberships []*backupMembership,
+) *BackupSummary {
+	keeps := make([]common.Address, 0)
+	seen := make(map[common.Address]bool)
+
+	for _, membership := range memberships {
+		keepAddress := common.HexToAddress(membership.KeepAddress)
+		if !seen[keepAddress] {
+			seen[keepAddress] = true
+			keeps = append(keeps, keepAddress)
+		}
+	}
+
+	return &BackupSummary{
+		Version:     archive.Version,
+		CreatedAt:   archive.CreatedAt,
+		Memberships: len(memberships),
+		Keeps:       keeps,
+	}
+}
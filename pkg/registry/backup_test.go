@@ -0,0 +1,208 @@
+package registry
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	recipient, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, backupSummary, err := CreateBackup(
+		&persistenceHandleMock{},
+		&recipient.PublicKey,
+	)
+	if err != nil {
+		t.Fatalf("failed to create backup: [%v]", err)
+	}
+
+	if backupSummary.Memberships != 2 {
+		t.Errorf(
+			"unexpected number of memberships in backup\nexpected: [%d]\nactual:   [%d]",
+			2,
+			backupSummary.Memberships,
+		)
+	}
+
+	restoredPersistence := newMemoryHandleMock()
+
+	restoreSummary, err := RestoreBackup(archive, recipient, restoredPersistence)
+	if err != nil {
+		t.Fatalf("failed to restore backup: [%v]", err)
+	}
+
+	if !reflect.DeepEqual(backupSummary, restoreSummary) {
+		t.Errorf(
+			"unexpected restore summary\nexpected: [%+v]\nactual:   [%+v]",
+			backupSummary,
+			restoreSummary,
+		)
+	}
+
+	kr := NewKeepsRegistry(NewPersistentStorage(restoredPersistence, newMemoryHandleMock()))
+	kr.LoadExistingKeeps()
+
+	expectedKeeps := []common.Address{keepAddress1, keepAddress2}
+	for _, keepAddress := range expectedKeeps {
+		if !kr.HasSigner(keepAddress) {
+			t.Errorf("signer for keep [%s] not restored", keepAddress.String())
+		}
+	}
+}
+
+func TestBackupAndRestore_DiskHandle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	newStorage := func(name string) (persistence.Handle, Storage) {
+		handle := newDiskHandle(t, filepath.Join(dir, name))
+		snapshotHandle := newDiskHandle(t, filepath.Join(dir, name+"-snapshots"))
+		return handle, NewPersistentStorage(handle, snapshotHandle)
+	}
+
+	sourceHandle, sourceStorage := newStorage("source")
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+	keepAddresses := []common.Address{keepAddress1, keepAddress2}
+	for i, keepAddress := range keepAddresses {
+		if err := sourceStorage.Save(keepAddress, signers[i]); err != nil {
+			t.Fatalf("failed to save signer: [%v]", err)
+		}
+	}
+
+	recipient, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, backupSummary, err := CreateBackup(sourceHandle, &recipient.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to create backup: [%v]", err)
+	}
+
+	if backupSummary.Memberships != len(keepAddresses) {
+		t.Errorf(
+			"unexpected number of memberships in backup\nexpected: [%d]\nactual:   [%d]",
+			len(keepAddresses),
+			backupSummary.Memberships,
+		)
+	}
+
+	restoredHandle, restoredStorage := newStorage("restored")
+
+	if _, err := RestoreBackup(archive, recipient, restoredHandle); err != nil {
+		t.Fatalf("failed to restore backup: [%v]", err)
+	}
+
+	kr := NewKeepsRegistry(restoredStorage)
+	kr.LoadExistingKeeps()
+
+	for i, keepAddress := range keepAddresses {
+		signer, err := kr.GetSigner(keepAddress)
+		if err != nil {
+			t.Fatalf("signer for keep [%s] not restored: [%v]", keepAddress.String(), err)
+		}
+
+		if !reflect.DeepEqual(signers[i].PublicKey(), signer.PublicKey()) {
+			t.Errorf(
+				"unexpected public key of restored signer for keep [%s]",
+				keepAddress.String(),
+			)
+		}
+	}
+}
+
+func newDiskHandle(t *testing.T, path string) persistence.Handle {
+	if err := os.MkdirAll(path, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	handle, err := persistence.NewDiskHandle(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return handle
+}
+
+func TestRestoreBackup_OtherRecipient(t *testing.T) {
+	recipient, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, _, err := CreateBackup(&persistenceHandleMock{}, &recipient.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to create backup: [%v]", err)
+	}
+
+	restoredPersistence := newMemoryHandleMock()
+
+	_, err = RestoreBackup(archive, otherKey, restoredPersistence)
+	if err == nil || !strings.Contains(err.Error(), "not to the provided key") {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	if len(restoredPersistence.files) != 0 {
+		t.Errorf("no memberships should be restored")
+	}
+}
+
+func TestRestoreBackup_NotFreshDataDirectory(t *testing.T) {
+	recipient, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, _, err := CreateBackup(&persistenceHandleMock{}, &recipient.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to create backup: [%v]", err)
+	}
+
+	persistenceMock := &persistenceHandleMock{}
+
+	_, err = RestoreBackup(archive, recipient, persistenceMock)
+	if err == nil || !strings.Contains(err.Error(), "fresh data directory") {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	if len(persistenceMock.persistedGroups) != 0 {
+		t.Errorf("no memberships should be restored")
+	}
+}
+
+func TestRestoreBackup_UnsupportedVersion(t *testing.T) {
+	recipient, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := []byte(`{"version": 2}`)
+
+	_, err = RestoreBackup(archive, recipient, newMemoryHandleMock())
+	if err == nil || !strings.Contains(err.Error(), "unsupported backup archive version") {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ipfs/go-log"
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
 
@@ -17,15 +16,16 @@ type Keeps struct {
 	myKeepsMutex *sync.RWMutex
 	myKeeps      map[common.Address]*tss.ThresholdSigner
 
-	storage storage
+	storage Storage
 }
 
-// NewKeepsRegistry returns an empty keeps registry.
-func NewKeepsRegistry(persistence persistence.Handle) *Keeps {
+// NewKeepsRegistry returns an empty keeps registry. Signers and their
+// snapshots are persisted in the given storage.
+func NewKeepsRegistry(storage Storage) *Keeps {
 	return &Keeps{
 		myKeepsMutex: &sync.RWMutex{},
 		myKeeps:      make(map[common.Address]*tss.ThresholdSigner),
-		storage:      newStorage(persistence),
+		storage:      storage,
 	}
 }
 
@@ -45,7 +45,7 @@ func (k *Keeps) RegisterSigner(
 		)
 	}
 
-	err := k.storage.save(keepAddress, signer)
+	err := k.storage.Save(keepAddress, signer)
 	if err != nil {
 		return fmt.Errorf(
 			"could not persist signer for keep [%s] in the storage: [%v]",
@@ -56,14 +56,28 @@ func (k *Keeps) RegisterSigner(
 
 	k.myKeeps[keepAddress] = signer
 
+	// The snapshot is no longer needed once the signer is registered. It is
+	// archived rather than removed to serve as an additional backup.
+	if err := k.storage.ArchiveSnapshot(keepAddress); err != nil {
+		logger.Warningf(
+			"could not archive snapshot of signer for keep [%s]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
 	return nil
 }
 
+// SnapshotSigner persists a snapshot of the signer for the given keep. The
+// snapshot should be made before the signer's public key is published, so the
+// signer can be recovered if the client fails before the signer is
+// registered.
 func (k *Keeps) SnapshotSigner(
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 ) error {
-	return k.storage.snapshot(keepAddress, signer)
+	return k.storage.Snapshot(keepAddress, signer)
 }
 
 // UnregisterKeep archives threeshold signer info for the given keep address.
@@ -71,7 +85,7 @@ func (k *Keeps) UnregisterKeep(keepAddress common.Address) {
 	k.myKeepsMutex.Lock()
 	defer k.myKeepsMutex.Unlock()
 
-	err := k.storage.archive(keepAddress.String())
+	err := k.storage.Archive(keepAddress)
 	if err != nil {
 		logger.Errorf("could not archive keep to the storage: [%v]", err)
 	}
@@ -119,13 +133,53 @@ func (k *Keeps) GetKeepsAddresses() []common.Address {
 	return keepsAddresses
 }
 
+// UnregisteredSnapshots returns signers from snapshots for which no signer is
+// registered. Such snapshots are left when the client fails after the snapshot
+// is made but before the signer is registered. If there is more than one
+// snapshot for the keep, the last one read is returned.
+func (k *Keeps) UnregisteredSnapshots() map[common.Address]*tss.ThresholdSigner {
+	k.myKeepsMutex.RLock()
+	defer k.myKeepsMutex.RUnlock()
+
+	snapshots := make(map[common.Address]*tss.ThresholdSigner)
+
+	keepSignersChannel, errorsChannel := k.storage.ReadSnapshots()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for keepSigner := range keepSignersChannel {
+			if _, exists := k.myKeeps[keepSigner.KeepAddress]; exists {
+				continue
+			}
+
+			snapshots[keepSigner.KeepAddress] = keepSigner.Signer
+		}
+
+		wg.Done()
+	}()
+
+	go func() {
+		for err := range errorsChannel {
+			logger.Errorf("could not load signer snapshot: [%v]", err)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	return snapshots
+}
+
 // LoadExistingKeeps iterates over all signers stored on disk and loads them
 // into memory
 func (k *Keeps) LoadExistingKeeps() {
 	k.myKeepsMutex.Lock()
 	defer k.myKeepsMutex.Unlock()
 
-	keepSignersChannel, errorsChannel := k.storage.readAll()
+	keepSignersChannel, errorsChannel := k.storage.ReadAll()
 
 	// Two goroutines read from signers and errors channels and either adds
 	// signers to the keeps registry or outputs an error to stderr.
@@ -138,16 +192,16 @@ func (k *Keeps) LoadExistingKeeps() {
 
 	go func() {
 		for keepSigner := range keepSignersChannel {
-			if _, exists := k.myKeeps[keepSigner.keepAddress]; exists {
+			if _, exists := k.myKeeps[keepSigner.KeepAddress]; exists {
 				logger.Errorf(
 					"signer for keep [%s] already loaded; "+
 						"possible duplicate in the storage layer",
-					keepSigner.keepAddress.String(),
+					keepSigner.KeepAddress.String(),
 				)
 				continue
 			}
 
-			k.myKeeps[keepSigner.keepAddress] = keepSigner.signer
+			k.myKeeps[keepSigner.KeepAddress] = keepSigner.Signer
 		}
 
 		wg.Done()
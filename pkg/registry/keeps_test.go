@@ -28,7 +28,7 @@ var (
 
 func TestRegisterSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, newSnapshotHandleMock()))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -74,7 +74,7 @@ func TestRegisterSigner(t *testing.T) {
 
 func TestRegisterSignerDuplicate(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, newSnapshotHandleMock()))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -102,7 +102,8 @@ func TestRegisterSignerDuplicate(t *testing.T) {
 
 func TestSnapshotSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	snapshotMock := newSnapshotHandleMock()
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, snapshotMock))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -125,29 +126,92 @@ func TestSnapshotSigner(t *testing.T) {
 		t.Fatalf("failed to snapshot signer: [%v]", err)
 	}
 
-	if len(persistenceMock.snapshots) != 1 {
+	if len(snapshotMock.files) != 1 {
 		t.Errorf(
 			"unexpected number of persisted groups\nexpected: [%d]\nactual:   [%d]",
 			1,
-			len(persistenceMock.snapshots),
+			len(snapshotMock.files),
 		)
 	}
 
 	if !reflect.DeepEqual(
 		expectedFile,
-		persistenceMock.snapshots[0],
+		snapshotMock.files[keepAddress1.String()],
 	) {
 		t.Errorf(
 			"unexpected persisted group\nexpected: [%+v]\nactual:   [%+v]",
 			expectedFile,
-			persistenceMock.snapshots[0],
+			snapshotMock.files[keepAddress1.String()],
+		)
+	}
+
+	if len(persistenceMock.snapshots) != 0 {
+		t.Errorf(
+			"snapshot should not be stored in the signers storage",
+		)
+	}
+}
+
+func TestUnregisteredSnapshots(t *testing.T) {
+	persistenceMock := &persistenceHandleMock{}
+	snapshotMock := newSnapshotHandleMock()
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, snapshotMock))
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signer: [%v]", err)
+	}
+
+	// keepAddress1 and keepAddress2 signers are loaded from the storage
+	kr.LoadExistingKeeps()
+
+	if err := kr.SnapshotSigner(keepAddress1, signers[0]); err != nil {
+		t.Fatalf("failed to snapshot signer: [%v]", err)
+	}
+	if err := kr.SnapshotSigner(keepAddress3, signers[2]); err != nil {
+		t.Fatalf("failed to snapshot signer: [%v]", err)
+	}
+
+	snapshots := kr.UnregisteredSnapshots()
+
+	if len(snapshots) != 1 {
+		t.Fatalf(
+			"unexpected number of snapshots\nexpected: [%d]\nactual:   [%d]",
+			1,
+			len(snapshots),
+		)
+	}
+
+	if !reflect.DeepEqual(signers[2], snapshots[keepAddress3]) {
+		t.Errorf(
+			"unexpected snapshot\nexpected: [%v]\nactual:   [%v]",
+			signers[2],
+			snapshots[keepAddress3],
+		)
+	}
+
+	// registering the signer archives its snapshot
+	if err := kr.RegisterSigner(keepAddress3, snapshots[keepAddress3]); err != nil {
+		t.Fatalf("failed to register signer: [%v]", err)
+	}
+
+	if len(kr.UnregisteredSnapshots()) != 0 {
+		t.Errorf("snapshot should be archived after the signer registration")
+	}
+
+	expectedArchived := []string{keepAddress3.String()}
+	if !reflect.DeepEqual(expectedArchived, snapshotMock.archived) {
+		t.Errorf(
+			"unexpected archived snapshots\nexpected: [%v]\nactual:   [%v]",
+			expectedArchived,
+			snapshotMock.archived,
 		)
 	}
 }
 
 func TestUnregisterSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, newSnapshotHandleMock()))
 
 	signer1, err := newTestSigner(0)
 	if err != nil {
@@ -180,7 +244,7 @@ func TestUnregisterSigner(t *testing.T) {
 
 func TestGetSigner(t *testing.T) {
 	persistenceMock := &persistenceHandleMock{}
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, newSnapshotHandleMock()))
 
 	signers, err := testSigners()
 	if err != nil {
@@ -246,7 +310,7 @@ func TestLoadExistingGroups(t *testing.T) {
 	signer1 := signers[0]
 	signer2 := signers[1]
 
-	kr := NewKeepsRegistry(persistenceMock)
+	kr := NewKeepsRegistry(NewPersistentStorage(persistenceMock, newSnapshotHandleMock()))
 
 	if len(kr.GetKeepsAddresses()) != 0 {
 		t.Fatal("unexpected keeps number at start")
@@ -348,6 +412,112 @@ func (phm *persistenceHandleMock) Archive(directory string) error {
 	return nil
 }
 
+type snapshotHandleMock struct {
+	files    map[string]*testFileInfo // <directory, file>
+	archived []string
+}
+
+func newSnapshotHandleMock() *snapshotHandleMock {
+	return &snapshotHandleMock{
+		files: make(map[string]*testFileInfo),
+	}
+}
+
+func (shm *snapshotHandleMock) Save(data []byte, directory string, name string) error {
+	shm.files[directory] = &testFileInfo{data, directory, name}
+	return nil
+}
+
+func (shm *snapshotHandleMock) Snapshot(data []byte, directory string, name string) error {
+	return fmt.Errorf("unexpected snapshot of snapshot")
+}
+
+func (shm *snapshotHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	outputData := make(chan persistence.DataDescriptor, len(shm.files))
+	outputErrors := make(chan error)
+
+	for _, file := range shm.files {
+		outputData <- &testDataDescriptor{file.name, file.directory, file.data}
+	}
+
+	close(outputData)
+	close(outputErrors)
+
+	return outputData, outputErrors
+}
+
+func (shm *snapshotHandleMock) Archive(directory string) error {
+	if _, ok := shm.files[directory]; !ok {
+		return fmt.Errorf("directory [%v] does not exist", directory)
+	}
+
+	delete(shm.files, directory)
+	shm.archived = append(shm.archived, directory)
+
+	return nil
+}
+
+// memoryHandleMock keeps all saved files in memory. Unlike
+// snapshotHandleMock, it can hold more than one file in a directory.
+type memoryHandleMock struct {
+	files    map[string]map[string]*testFileInfo // <directory, <name, file>>
+	archived []string
+}
+
+func newMemoryHandleMock() *memoryHandleMock {
+	return &memoryHandleMock{
+		files: make(map[string]map[string]*testFileInfo),
+	}
+}
+
+func (mhm *memoryHandleMock) Save(data []byte, directory string, name string) error {
+	if _, ok := mhm.files[directory]; !ok {
+		mhm.files[directory] = make(map[string]*testFileInfo)
+	}
+
+	mhm.files[directory][name] = &testFileInfo{data, directory, name}
+	return nil
+}
+
+func (mhm *memoryHandleMock) Snapshot(data []byte, directory string, name string) error {
+	return fmt.Errorf("unexpected snapshot in memory handle")
+}
+
+func (mhm *memoryHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	outputData := make(chan persistence.DataDescriptor, mhm.count())
+	outputErrors := make(chan error)
+
+	for _, directory := range mhm.files {
+		for _, file := range directory {
+			outputData <- &testDataDescriptor{file.name, file.directory, file.data}
+		}
+	}
+
+	close(outputData)
+	close(outputErrors)
+
+	return outputData, outputErrors
+}
+
+func (mhm *memoryHandleMock) Archive(directory string) error {
+	if _, ok := mhm.files[directory]; !ok {
+		return fmt.Errorf("directory [%v] does not exist", directory)
+	}
+
+	delete(mhm.files, directory)
+	mhm.archived = append(mhm.archived, directory)
+
+	return nil
+}
+
+func (mhm *memoryHandleMock) count() int {
+	count := 0
+	for _, directory := range mhm.files {
+		count += len(directory)
+	}
+	return count
+}
+
 type testDataDescriptor struct {
 	name      string
 	directory string
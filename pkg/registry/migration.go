@@ -0,0 +1,301 @@
+package registry
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+// MigrationResult describes the outcome of the storage migration.
+type MigrationResult struct {
+	Signers   int
+	Snapshots int
+	Archived  int
+}
+
+// MigrateStorage copies all signers, snapshots of signers and archived
+// signers from the source storage to the target storage. The target storage
+// is expected to contain no signers and no snapshots. Archived signers are
+// saved in the target storage and archived there again. If the migration
+// fails, all signers and snapshots already copied to the target storage are
+// archived in the target storage, so the target storage contains no signers
+// and no snapshots again and the migration can be retried. The source storage
+// is left untouched.
+func MigrateStorage(source Storage, target Storage) (*MigrationResult, error) {
+	existingSigners, err := collectKeepSigners(target.ReadAll())
+	if err != nil {
+		return nil, fmt.Errorf("could not read target signers: [%v]", err)
+	}
+	existingSnapshots, err := collectKeepSigners(target.ReadSnapshots())
+	if err != nil {
+		return nil, fmt.Errorf("could not read target snapshots: [%v]", err)
+	}
+	if len(existingSigners) > 0 || len(existingSnapshots) > 0 {
+		return nil, fmt.Errorf(
+			"target storage already contains [%d] signers and [%d] snapshots",
+			len(existingSigners),
+			len(existingSnapshots),
+		)
+	}
+
+	signers, err := collectKeepSigners(source.ReadAll())
+	if err != nil {
+		return nil, fmt.Errorf("could not read source signers: [%v]", err)
+	}
+	snapshots, err := collectKeepSigners(source.ReadSnapshots())
+	if err != nil {
+		return nil, fmt.Errorf("could not read source snapshots: [%v]", err)
+	}
+	archived, err := collectKeepSigners(source.ReadArchived())
+	if err != nil {
+		return nil, fmt.Errorf("could not read source archived signers: [%v]", err)
+	}
+
+	migration := &storageMigration{
+		target:        target,
+		savedKeeps:    make(map[common.Address]bool),
+		snapshotKeeps: make(map[common.Address]bool),
+	}
+
+	if err := migration.migrate(signers, snapshots, archived); err != nil {
+		if rollbackErr := migration.rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf(
+				"%v; could not clean up target storage: [%v]",
+				err,
+				rollbackErr,
+			)
+		}
+
+		return nil, err
+	}
+
+	return &MigrationResult{
+		Signers:   len(signers),
+		Snapshots: len(snapshots),
+		Archived:  len(archived),
+	}, nil
+}
+
+// MigrateLegacySnapshots copies snapshots of signers made by the legacy
+// persistence handle, which kept snapshots along with signers, to the
+// storage, so signers can be recovered from them. Only snapshots of keeps
+// which have neither a signer nor an archived signer in the storage are
+// copied. If there are several snapshots for the keep, the last one read is
+// copied. Legacy snapshots are left untouched, so the migration is repeated
+// on every call; copying a snapshot again overwrites it with the same
+// content. It returns the number of copied snapshots.
+func MigrateLegacySnapshots(
+	legacySnapshots persistence.Handle,
+	storage Storage,
+) (int, error) {
+	snapshots, err := collectKeepSigners(readSigners(legacySnapshots))
+	if err != nil {
+		return 0, fmt.Errorf("could not read legacy snapshots: [%v]", err)
+	}
+
+	storedKeeps := make(map[common.Address]bool)
+	for _, read := range []func() (<-chan *KeepSigner, <-chan error){
+		storage.ReadAll,
+		storage.ReadArchived,
+	} {
+		keepSigners, err := collectKeepSigners(read())
+		if err != nil {
+			return 0, fmt.Errorf("could not read signers: [%v]", err)
+		}
+
+		for _, keepSigner := range keepSigners {
+			storedKeeps[keepSigner.KeepAddress] = true
+		}
+	}
+
+	lastSnapshots := make(map[common.Address]*KeepSigner)
+	for _, snapshot := range snapshots {
+		if !storedKeeps[snapshot.KeepAddress] {
+			lastSnapshots[snapshot.KeepAddress] = snapshot
+		}
+	}
+
+	for keepAddress, snapshot := range lastSnapshots {
+		if err := storage.Snapshot(keepAddress, snapshot.Signer); err != nil {
+			return 0, fmt.Errorf(
+				"could not save snapshot for keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+		}
+	}
+
+	return len(lastSnapshots), nil
+}
+
+// storageMigration copies signers to the target storage and keeps track of
+// keeps which signers and snapshots have been copied, so they can be cleaned
+// up if the migration fails.
+type storageMigration struct {
+	target        Storage
+	savedKeeps    map[common.Address]bool
+	snapshotKeeps map[common.Address]bool
+}
+
+func (sm *storageMigration) migrate(
+	signers []*KeepSigner,
+	snapshots []*KeepSigner,
+	archived []*KeepSigner,
+) error {
+	// Archived signers are migrated first, so they are not archived along
+	// with signers of the same keep which are not archived.
+	archivedKeeps := make([]common.Address, 0)
+	for _, keepSigner := range archived {
+		if !sm.savedKeeps[keepSigner.KeepAddress] {
+			archivedKeeps = append(archivedKeeps, keepSigner.KeepAddress)
+		}
+
+		if err := sm.save(keepSigner); err != nil {
+			return err
+		}
+	}
+
+	for _, keepAddress := range archivedKeeps {
+		if err := sm.target.Archive(keepAddress); err != nil {
+			return fmt.Errorf(
+				"could not archive signers of keep [%s]: [%v]",
+				keepAddress.String(),
+				err,
+			)
+		}
+
+		delete(sm.savedKeeps, keepAddress)
+	}
+
+	for _, keepSigner := range signers {
+		if err := sm.save(keepSigner); err != nil {
+			return err
+		}
+	}
+
+	for _, keepSigner := range snapshots {
+		sm.snapshotKeeps[keepSigner.KeepAddress] = true
+
+		if err := sm.target.Snapshot(keepSigner.KeepAddress, keepSigner.Signer); err != nil {
+			return fmt.Errorf(
+				"could not save snapshot for keep [%s]: [%v]",
+				keepSigner.KeepAddress.String(),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (sm *storageMigration) save(keepSigner *KeepSigner) error {
+	sm.savedKeeps[keepSigner.KeepAddress] = true
+
+	if err := sm.target.Save(keepSigner.KeepAddress, keepSigner.Signer); err != nil {
+		return fmt.Errorf(
+			"could not save signer for keep [%s]: [%v]",
+			keepSigner.KeepAddress.String(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// rollback archives signers and snapshots copied to the target storage.
+// Signers are archived instead of being removed, so no key share is ever lost.
+// Keeps are marked as copied before their signers are saved, so keeps for
+// which saving failed are archived as well; archiving a keep which has nothing
+// in the target storage is not considered a failure.
+func (sm *storageMigration) rollback() error {
+	errors := make([]error, 0)
+
+	for keepAddress := range sm.savedKeeps {
+		if err := sm.target.Archive(keepAddress); err != nil {
+			if sm.isStored(keepAddress, sm.target.ReadAll) {
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	for keepAddress := range sm.snapshotKeeps {
+		if err := sm.target.ArchiveSnapshot(keepAddress); err != nil {
+			if sm.isStored(keepAddress, sm.target.ReadSnapshots) {
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf(
+			"failed to archive [%d] keeps; first error: [%v]",
+			len(errors),
+			errors[0],
+		)
+	}
+
+	return nil
+}
+
+// isStored checks if the target storage still holds signers of the keep.
+// It assumes the keep is stored if signers could not be read.
+func (sm *storageMigration) isStored(
+	keepAddress common.Address,
+	read func() (<-chan *KeepSigner, <-chan error),
+) bool {
+	keepSigners, err := collectKeepSigners(read())
+	if err != nil {
+		return true
+	}
+
+	for _, keepSigner := range keepSigners {
+		if keepSigner.KeepAddress == keepAddress {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectKeepSigners reads all signers from the channels. It fails if any
+// signer could not be read, so no signer is silently lost during migration.
+func collectKeepSigners(
+	keepSignersChannel <-chan *KeepSigner,
+	errorsChannel <-chan error,
+) ([]*KeepSigner, error) {
+	keepSigners := make([]*KeepSigner, 0)
+	errors := make([]error, 0)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for keepSigner := range keepSignersChannel {
+			keepSigners = append(keepSigners, keepSigner)
+		}
+
+		wg.Done()
+	}()
+
+	go func() {
+		for err := range errorsChannel {
+			errors = append(errors, err)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return nil, fmt.Errorf(
+			"failed to read [%d] signers; first error: [%v]",
+			len(errors),
+			errors[0],
+		)
+	}
+
+	return keepSigners, nil
+}
@@ -0,0 +1,209 @@
+package registry
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/storage"
+)
+
+func TestMigrateStorage(t *testing.T) {
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+
+	sourceArchivePersistence := newMemoryHandleMock()
+	source := NewPersistentStorageWithArchive(
+		&persistenceHandleMock{},
+		newMemoryHandleMock(),
+		sourceArchivePersistence,
+	)
+	if err := source.Snapshot(keepAddress3, signers[2]); err != nil {
+		t.Fatalf("failed to snapshot signer: [%v]", err)
+	}
+
+	archivedKeep := common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c")
+	archivedSigner, err := signers[0].Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal signer: [%v]", err)
+	}
+	err = sourceArchivePersistence.Save(
+		archivedSigner,
+		archivedKeep.String(),
+		"/membership_0",
+	)
+	if err != nil {
+		t.Fatalf("failed to archive signer: [%v]", err)
+	}
+
+	targetPersistence := newMemoryHandleMock()
+	targetSnapshotPersistence := newMemoryHandleMock()
+	target := NewPersistentStorage(targetPersistence, targetSnapshotPersistence)
+
+	result, err := MigrateStorage(source, target)
+	if err != nil {
+		t.Fatalf("failed to migrate storage: [%v]", err)
+	}
+
+	if result.Signers != 2 || result.Snapshots != 1 || result.Archived != 1 {
+		t.Errorf("unexpected migration result: [%+v]", result)
+	}
+
+	for _, keepAddress := range []common.Address{keepAddress1, keepAddress2} {
+		if _, ok := targetPersistence.files[keepAddress.String()]; !ok {
+			t.Errorf("signer for keep [%s] not migrated", keepAddress.String())
+		}
+	}
+
+	if _, ok := targetSnapshotPersistence.files[keepAddress3.String()]; !ok {
+		t.Errorf("snapshot for keep [%s] not migrated", keepAddress3.String())
+	}
+
+	if _, ok := targetPersistence.files[archivedKeep.String()]; ok {
+		t.Errorf("archived signer for keep [%s] not archived", archivedKeep.String())
+	}
+	expectedArchived := []string{archivedKeep.String()}
+	if !reflect.DeepEqual(expectedArchived, targetPersistence.archived) {
+		t.Errorf(
+			"unexpected archived keeps\nexpected: [%v]\nactual:   [%v]",
+			expectedArchived,
+			targetPersistence.archived,
+		)
+	}
+}
+
+func TestMigrateStorage_CleansUpTargetOnFailure(t *testing.T) {
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+
+	source := NewPersistentStorage(&persistenceHandleMock{}, newMemoryHandleMock())
+	if err := source.Snapshot(keepAddress3, signers[2]); err != nil {
+		t.Fatalf("failed to snapshot signer: [%v]", err)
+	}
+
+	targetPersistence := newMemoryHandleMock()
+	targetSnapshotPersistence := &failingHandleMock{newMemoryHandleMock()}
+	target := NewPersistentStorage(targetPersistence, targetSnapshotPersistence)
+
+	if _, err := MigrateStorage(source, target); err == nil {
+		t.Fatal("expected migration failure")
+	}
+
+	if count := targetPersistence.count(); count != 0 {
+		t.Errorf("unexpected number of signers left in target: [%d]", count)
+	}
+	if len(targetPersistence.archived) != 2 {
+		t.Errorf(
+			"unexpected number of archived keeps: [%d]",
+			len(targetPersistence.archived),
+		)
+	}
+}
+
+func TestMigrateStorage_TargetNotEmpty(t *testing.T) {
+	source := NewPersistentStorage(&persistenceHandleMock{}, newMemoryHandleMock())
+	target := NewPersistentStorage(&persistenceHandleMock{}, newMemoryHandleMock())
+
+	if _, err := MigrateStorage(source, target); err == nil {
+		t.Fatal("expected migration failure")
+	}
+}
+
+func TestMigrateLegacySnapshots(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "legacy-snapshots-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatalf("failed to get signers: [%v]", err)
+	}
+
+	// Legacy persistence handle made snapshots next to signers with
+	// a timestamp suffix appended to the snapshot file name.
+	legacyHandle := newDiskHandle(t, dataDir)
+	legacyStorage := NewPersistentStorageWithArchive(
+		legacyHandle,
+		newMemoryHandleMock(),
+		storage.NewDiskArchiveHandle(dataDir),
+	)
+
+	// Signer of the first keep is registered and signer of the second keep
+	// is archived. Snapshots of the third keep are not registered.
+	for i, keepAddress := range []common.Address{
+		keepAddress1,
+		keepAddress2,
+		keepAddress3,
+	} {
+		signerBytes, err := signers[i].Marshal()
+		if err != nil {
+			t.Fatalf("failed to marshal signer: [%v]", err)
+		}
+
+		err = legacyHandle.Snapshot(
+			signerBytes,
+			keepAddress.String(),
+			fmt.Sprintf("/membership_%.40s", signers[i].MemberID().String()),
+		)
+		if err != nil {
+			t.Fatalf("failed to snapshot signer: [%v]", err)
+		}
+	}
+	if err := legacyStorage.Save(keepAddress1, signers[0]); err != nil {
+		t.Fatalf("failed to save signer: [%v]", err)
+	}
+	if err := legacyStorage.Save(keepAddress2, signers[1]); err != nil {
+		t.Fatalf("failed to save signer: [%v]", err)
+	}
+	if err := legacyStorage.Archive(keepAddress2); err != nil {
+		t.Fatalf("failed to archive signer: [%v]", err)
+	}
+
+	migrated, err := MigrateLegacySnapshots(
+		storage.NewDiskSnapshotHandle(dataDir),
+		legacyStorage,
+	)
+	if err != nil {
+		t.Fatalf("failed to migrate legacy snapshots: [%v]", err)
+	}
+	if migrated != 1 {
+		t.Errorf("unexpected number of migrated snapshots: [%d]", migrated)
+	}
+
+	kr := NewKeepsRegistry(legacyStorage)
+	kr.LoadExistingKeeps()
+
+	snapshots := kr.UnregisteredSnapshots()
+	if len(snapshots) != 1 {
+		t.Fatalf("unexpected number of unregistered snapshots: [%d]", len(snapshots))
+	}
+	snapshot, ok := snapshots[keepAddress3]
+	if !ok {
+		t.Fatalf("snapshot for keep [%s] not recovered", keepAddress3.String())
+	}
+	if !reflect.DeepEqual(signers[2].PublicKey(), snapshot.PublicKey()) {
+		t.Errorf(
+			"unexpected public key\nexpected: [%v]\nactual:   [%v]",
+			signers[2].PublicKey(),
+			snapshot.PublicKey(),
+		)
+	}
+}
+
+// failingHandleMock fails to save any file.
+type failingHandleMock struct {
+	*memoryHandleMock
+}
+
+func (fhm *failingHandleMock) Save(data []byte, directory string, name string) error {
+	return fmt.Errorf("save failed")
+}
@@ -9,24 +9,73 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
 
-type storage interface {
-	save(keepAddress common.Address, signer *tss.ThresholdSigner) error
-	snapshot(keepAddress common.Address, signer *tss.ThresholdSigner) error
-	readAll() (<-chan *keepSigner, <-chan error)
-	archive(keepAddress string) error
+// Storage persists signers of keeps the client is a member of and their
+// snapshots. Implementations may use any backend but have to keep snapshots
+// apart from signers, so they can be read back independently.
+type Storage interface {
+	// Save persists the signer of the given keep.
+	Save(keepAddress common.Address, signer *tss.ThresholdSigner) error
+	// Snapshot persists a snapshot of the signer of the given keep.
+	Snapshot(keepAddress common.Address, signer *tss.ThresholdSigner) error
+	// ReadAll reads all persisted signers.
+	ReadAll() (<-chan *KeepSigner, <-chan error)
+	// ReadSnapshots reads all persisted snapshots of signers.
+	ReadSnapshots() (<-chan *KeepSigner, <-chan error)
+	// ReadArchived reads all archived signers.
+	ReadArchived() (<-chan *KeepSigner, <-chan error)
+	// Archive archives signers of the given keep.
+	Archive(keepAddress common.Address) error
+	// ArchiveSnapshot archives snapshots of signers of the given keep.
+	ArchiveSnapshot(keepAddress common.Address) error
 }
 
+// KeepSigner is a signer of the keep read from the storage.
+type KeepSigner struct {
+	KeepAddress common.Address
+	Signer      *tss.ThresholdSigner
+}
+
+// persistentStorage stores signers using the given persistence handle.
+// Snapshots of signers are stored using a separate handle, so they can be
+// read back independently of signers. Archived signers are read using the
+// archive handle, if one is given.
 type persistentStorage struct {
-	handle persistence.Handle
+	handle         persistence.Handle
+	snapshotHandle persistence.Handle
+	archiveHandle  persistence.Handle
 }
 
-func newStorage(persistence persistence.Handle) storage {
+// NewPersistentStorage returns a storage which persists signers using the
+// given persistence handle and their snapshots using the given snapshot
+// persistence handle. Both handles must point to different locations.
+func NewPersistentStorage(
+	persistence persistence.Handle,
+	snapshotPersistence persistence.Handle,
+) Storage {
 	return &persistentStorage{
-		handle: persistence,
+		handle:         persistence,
+		snapshotHandle: snapshotPersistence,
 	}
 }
 
-func (ps *persistentStorage) save(
+// NewPersistentStorageWithArchive returns a storage which persists signers
+// and their snapshots the same way as the storage returned by
+// NewPersistentStorage and reads archived signers using the given archive
+// persistence handle. The archive handle has to read signers archived by
+// the signers persistence handle.
+func NewPersistentStorageWithArchive(
+	persistence persistence.Handle,
+	snapshotPersistence persistence.Handle,
+	archivePersistence persistence.Handle,
+) Storage {
+	return &persistentStorage{
+		handle:         persistence,
+		snapshotHandle: snapshotPersistence,
+		archiveHandle:  archivePersistence,
+	}
+}
+
+func (ps *persistentStorage) Save(
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 ) error {
@@ -44,7 +93,7 @@ func (ps *persistentStorage) save(
 	)
 }
 
-func (ps *persistentStorage) snapshot(
+func (ps *persistentStorage) Snapshot(
 	keepAddress common.Address,
 	signer *tss.ThresholdSigner,
 ) error {
@@ -53,7 +102,7 @@ func (ps *persistentStorage) snapshot(
 		return fmt.Errorf("failed to marshal signer: [%v]", err)
 	}
 
-	return ps.handle.Snapshot(
+	return ps.snapshotHandle.Save(
 		signerBytes,
 		keepAddress.String(),
 		// Take just the first 20 bytes of member ID so that we don't produce
@@ -62,16 +111,35 @@ func (ps *persistentStorage) snapshot(
 	)
 }
 
-type keepSigner struct {
-	keepAddress common.Address
-	signer      *tss.ThresholdSigner
+func (ps *persistentStorage) ReadAll() (<-chan *KeepSigner, <-chan error) {
+	return readSigners(ps.handle)
+}
+
+func (ps *persistentStorage) ReadSnapshots() (<-chan *KeepSigner, <-chan error) {
+	return readSigners(ps.snapshotHandle)
+}
+
+// ReadArchived reads archived signers using the archive handle. No signers
+// are returned if the storage has no archive handle.
+func (ps *persistentStorage) ReadArchived() (<-chan *KeepSigner, <-chan error) {
+	if ps.archiveHandle == nil {
+		outputKeepSigner := make(chan *KeepSigner)
+		outputErrors := make(chan error)
+
+		close(outputKeepSigner)
+		close(outputErrors)
+
+		return outputKeepSigner, outputErrors
+	}
+
+	return readSigners(ps.archiveHandle)
 }
 
-func (ps *persistentStorage) readAll() (<-chan *keepSigner, <-chan error) {
-	outputKeepSigner := make(chan *keepSigner)
+func readSigners(handle persistence.Handle) (<-chan *KeepSigner, <-chan error) {
+	outputKeepSigner := make(chan *KeepSigner)
 	outputErrors := make(chan error)
 
-	inputData, inputErrors := ps.handle.ReadAll()
+	inputData, inputErrors := handle.ReadAll()
 
 	// We have two goroutines reading from data and errors channels at the same
 	// time. The reason for that is because we don't know in what order
@@ -138,9 +206,9 @@ func (ps *persistentStorage) readAll() (<-chan *keepSigner, <-chan error) {
 				continue
 			}
 
-			outputKeepSigner <- &keepSigner{
-				keepAddress: keepAddress,
-				signer:      signer,
+			outputKeepSigner <- &KeepSigner{
+				KeepAddress: keepAddress,
+				Signer:      signer,
 			}
 		}
 
@@ -150,6 +218,10 @@ func (ps *persistentStorage) readAll() (<-chan *keepSigner, <-chan error) {
 	return outputKeepSigner, outputErrors
 }
 
-func (ps *persistentStorage) archive(keepAddress string) error {
-	return ps.handle.Archive(keepAddress)
+func (ps *persistentStorage) Archive(keepAddress common.Address) error {
+	return ps.handle.Archive(keepAddress.String())
+}
+
+func (ps *persistentStorage) ArchiveSnapshot(keepAddress common.Address) error {
+	return ps.snapshotHandle.Archive(keepAddress.String())
 }
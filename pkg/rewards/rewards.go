@@ -0,0 +1,180 @@
+// Package rewards contains tools for KEEP rewards distributed to members of
+// closed keeps by the ECDSARewards contract. Rewards are allocated to the
+// beneficiary of the operator in rewards intervals and they have to be
+// withdrawn separately for each interval.
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+	eth "github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+var logger = log.Logger("keep-rewards")
+
+// Interval holds rewards of the operator in a single rewards interval.
+type Interval struct {
+	Number   uint64
+	Finished bool
+
+	Allocated    *big.Int
+	Withdrawn    *big.Int
+	Withdrawable *big.Int
+}
+
+// Withdrawal holds a submitted withdrawal of rewards for a single interval.
+type Withdrawal struct {
+	Interval        uint64
+	Amount          *big.Int
+	TransactionHash common.Hash
+}
+
+// Intervals returns rewards of the operator in all rewards intervals up to
+// and including the current one.
+func Intervals(rewards eth.Rewards, operator common.Address) ([]*Interval, error) {
+	currentInterval, err := rewards.CurrentRewardsInterval()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current rewards interval: [%v]", err)
+	}
+
+	intervals := make([]*Interval, 0, currentInterval+1)
+	for number := uint64(0); number <= currentInterval; number++ {
+		allocated, err := rewards.AllocatedRewards(number, operator)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get allocated rewards for interval [%d]: [%v]",
+				number,
+				err,
+			)
+		}
+
+		withdrawn, err := rewards.WithdrawnRewards(number, operator)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get withdrawn rewards for interval [%d]: [%v]",
+				number,
+				err,
+			)
+		}
+
+		withdrawable, err := rewards.WithdrawableRewards(number, operator)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not get withdrawable rewards for interval [%d]: [%v]",
+				number,
+				err,
+			)
+		}
+
+		intervals = append(intervals, &Interval{
+			Number:       number,
+			Finished:     number < currentInterval,
+			Allocated:    allocated,
+			Withdrawn:    withdrawn,
+			Withdrawable: withdrawable,
+		})
+	}
+
+	return intervals, nil
+}
+
+// WithdrawAll withdraws rewards of the operator from all finished rewards
+// intervals with withdrawable rewards. A failed withdrawal does not stop
+// withdrawals for the remaining intervals; submitted withdrawals are returned
+// together with an error listing intervals which could not be withdrawn.
+func WithdrawAll(
+	rewards eth.Rewards,
+	operator common.Address,
+) ([]*Withdrawal, error) {
+	currentInterval, err := rewards.CurrentRewardsInterval()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current rewards interval: [%v]", err)
+	}
+
+	withdrawals := make([]*Withdrawal, 0)
+	failedIntervals := make([]uint64, 0)
+
+	for number := uint64(0); number < currentInterval; number++ {
+		withdrawable, err := rewards.WithdrawableRewards(number, operator)
+		if err != nil {
+			logger.Errorf(
+				"could not get withdrawable rewards for interval [%d]: [%v]",
+				number,
+				err,
+			)
+			failedIntervals = append(failedIntervals, number)
+			continue
+		}
+
+		if withdrawable.Sign() == 0 {
+			continue
+		}
+
+		transactionHash, err := rewards.WithdrawRewards(number, operator)
+		if err != nil {
+			logger.Errorf(
+				"could not withdraw rewards for interval [%d]: [%v]",
+				number,
+				err,
+			)
+			failedIntervals = append(failedIntervals, number)
+			continue
+		}
+
+		withdrawals = append(withdrawals, &Withdrawal{
+			Interval:        number,
+			Amount:          withdrawable,
+			TransactionHash: transactionHash,
+		})
+	}
+
+	if len(failedIntervals) > 0 {
+		return withdrawals, fmt.Errorf(
+			"could not withdraw rewards for intervals %v",
+			failedIntervals,
+		)
+	}
+
+	return withdrawals, nil
+}
+
+// AutoClaim withdraws rewards of the operator from all finished rewards
+// intervals right away and then with the given period until the context is
+// done.
+func AutoClaim(
+	ctx context.Context,
+	rewards eth.Rewards,
+	operator common.Address,
+	period time.Duration,
+) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		withdrawals, err := WithdrawAll(rewards, operator)
+		if err != nil {
+			logger.Errorf("failed to claim rewards: [%v]", err)
+		}
+
+		for _, withdrawal := range withdrawals {
+			logger.Infof(
+				"submitted withdrawal of rewards [%v] for interval [%d] "+
+					"in transaction [%s]",
+				withdrawal.Amount,
+				withdrawal.Interval,
+				withdrawal.TransactionHash.String(),
+			)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var operator = common.HexToAddress("0x65ea55c1f10491038425725dc00dffeab2a1e28a")
+
+func TestIntervals(t *testing.T) {
+	rewards := newRewardsMock(2)
+	rewards.allocated[0] = big.NewInt(100)
+	rewards.withdrawn[0] = big.NewInt(100)
+	rewards.allocated[1] = big.NewInt(50)
+
+	intervals, err := Intervals(rewards, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedIntervals := []*Interval{
+		{
+			Number:       0,
+			Finished:     true,
+			Allocated:    big.NewInt(100),
+			Withdrawn:    big.NewInt(100),
+			Withdrawable: big.NewInt(0),
+		},
+		{
+			Number:       1,
+			Finished:     true,
+			Allocated:    big.NewInt(50),
+			Withdrawn:    big.NewInt(0),
+			Withdrawable: big.NewInt(50),
+		},
+		{
+			Number:       2,
+			Finished:     false,
+			Allocated:    big.NewInt(0),
+			Withdrawn:    big.NewInt(0),
+			Withdrawable: big.NewInt(0),
+		},
+	}
+
+	if len(expectedIntervals) != len(intervals) {
+		t.Fatalf(
+			"unexpected number of intervals\nexpected: [%d]\nactual:   [%d]",
+			len(expectedIntervals),
+			len(intervals),
+		)
+	}
+
+	for i, expectedInterval := range expectedIntervals {
+		if !equalIntervals(expectedInterval, intervals[i]) {
+			t.Errorf(
+				"unexpected interval [%d]\nexpected: [%+v]\nactual:   [%+v]",
+				i,
+				expectedInterval,
+				intervals[i],
+			)
+		}
+	}
+}
+
+// equalIntervals compares intervals by values of their amounts, as equal
+// big.Int values may differ in their internal representation.
+func equalIntervals(interval1, interval2 *Interval) bool {
+	return interval1.Number == interval2.Number &&
+		interval1.Finished == interval2.Finished &&
+		interval1.Allocated.Cmp(interval2.Allocated) == 0 &&
+		interval1.Withdrawn.Cmp(interval2.Withdrawn) == 0 &&
+		interval1.Withdrawable.Cmp(interval2.Withdrawable) == 0
+}
+
+func TestWithdrawAll(t *testing.T) {
+	rewards := newRewardsMock(3)
+	rewards.allocated[0] = big.NewInt(100)
+	rewards.allocated[2] = big.NewInt(30)
+	rewards.withdrawn[2] = big.NewInt(10)
+
+	withdrawals, err := WithdrawAll(rewards, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(withdrawals) != 2 {
+		t.Fatalf(
+			"unexpected number of withdrawals\nexpected: [2]\nactual:   [%d]",
+			len(withdrawals),
+		)
+	}
+
+	if withdrawals[0].Interval != 0 || withdrawals[0].Amount.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("unexpected first withdrawal: [%+v]", withdrawals[0])
+	}
+	if withdrawals[1].Interval != 2 || withdrawals[1].Amount.Cmp(big.NewInt(20)) != 0 {
+		t.Errorf("unexpected second withdrawal: [%+v]", withdrawals[1])
+	}
+
+	// All rewards have been withdrawn so nothing should be withdrawn again.
+	withdrawals, err = WithdrawAll(rewards, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(withdrawals) != 0 {
+		t.Errorf(
+			"unexpected number of withdrawals\nexpected: [0]\nactual:   [%d]",
+			len(withdrawals),
+		)
+	}
+}
+
+func TestWithdrawAll_SkipsCurrentInterval(t *testing.T) {
+	rewards := newRewardsMock(1)
+	rewards.allocated[1] = big.NewInt(100)
+
+	withdrawals, err := WithdrawAll(rewards, operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(withdrawals) != 0 {
+		t.Errorf(
+			"unexpected number of withdrawals\nexpected: [0]\nactual:   [%d]",
+			len(withdrawals),
+		)
+	}
+}
+
+func TestWithdrawAll_ContinuesOnFailure(t *testing.T) {
+	rewards := newRewardsMock(2)
+	rewards.allocated[0] = big.NewInt(100)
+	rewards.allocated[1] = big.NewInt(50)
+	rewards.failingWithdrawals[0] = true
+
+	withdrawals, err := WithdrawAll(rewards, operator)
+
+	expectedError := fmt.Errorf("could not withdraw rewards for intervals [0]")
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedError,
+			err,
+		)
+	}
+
+	if len(withdrawals) != 1 || withdrawals[0].Interval != 1 {
+		t.Errorf("unexpected withdrawals: [%+v]", withdrawals)
+	}
+}
+
+type rewardsMock struct {
+	currentInterval    uint64
+	allocated          map[uint64]*big.Int
+	withdrawn          map[uint64]*big.Int
+	failingWithdrawals map[uint64]bool
+}
+
+func newRewardsMock(currentInterval uint64) *rewardsMock {
+	return &rewardsMock{
+		currentInterval:    currentInterval,
+		allocated:          make(map[uint64]*big.Int),
+		withdrawn:          make(map[uint64]*big.Int),
+		failingWithdrawals: make(map[uint64]bool),
+	}
+}
+
+func (rm *rewardsMock) CurrentRewardsInterval() (uint64, error) {
+	return rm.currentInterval, nil
+}
+
+func (rm *rewardsMock) AllocatedRewards(
+	interval uint64,
+	operator common.Address,
+) (*big.Int, error) {
+	if allocated, ok := rm.allocated[interval]; ok {
+		return new(big.Int).Set(allocated), nil
+	}
+	return big.NewInt(0), nil
+}
+
+func (rm *rewardsMock) WithdrawnRewards(
+	interval uint64,
+	operator common.Address,
+) (*big.Int, error) {
+	if withdrawn, ok := rm.withdrawn[interval]; ok {
+		return new(big.Int).Set(withdrawn), nil
+	}
+	return big.NewInt(0), nil
+}
+
+func (rm *rewardsMock) WithdrawableRewards(
+	interval uint64,
+	operator common.Address,
+) (*big.Int, error) {
+	allocated, _ := rm.AllocatedRewards(interval, operator)
+	withdrawn, _ := rm.WithdrawnRewards(interval, operator)
+
+	return allocated.Sub(allocated, withdrawn), nil
+}
+
+func (rm *rewardsMock) WithdrawRewards(
+	interval uint64,
+	operator common.Address,
+) (common.Hash, error) {
+	if rm.failingWithdrawals[interval] {
+		return common.Hash{}, fmt.Errorf("withdrawal failed")
+	}
+
+	allocated, _ := rm.AllocatedRewards(interval, operator)
+	rm.withdrawn[interval] = allocated
+
+	return common.BigToHash(big.NewInt(int64(interval + 1))), nil
+}
@@ -0,0 +1,311 @@
+// Package signing contains a persistent journal of signing requests handled
+// by the client.
+package signing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+var logger = log.Logger("keep-signing-journal")
+
+const requestFileName = "/request"
+
+// State represents a stage of the signing request lifecycle.
+type State int
+
+const (
+	// Confirmed means the signing request has been confirmed on-chain and
+	// the client accepted it.
+	Confirmed State = iota
+	// Signing means the signature calculation is in progress.
+	Signing
+	// Signed means the signature has been calculated.
+	Signed
+	// Published means the signature submission transaction has been sent.
+	Published
+	// ConfirmedOnChain means the signature for the requested digest has been
+	// accepted by the keep and confirmed. This is the final state.
+	ConfirmedOnChain
+	// Pending means the signing request has been confirmed on-chain and
+	// awaits the evaluation by the signing policy or has been refused or
+	// deferred by it. It is declared last, so values of states persisted
+	// before it was introduced do not change.
+	Pending
+)
+
+func (s State) String() string {
+	switch s {
+	case Confirmed:
+		return "confirmed"
+	case Signing:
+		return "signing"
+	case Signed:
+		return "signed"
+	case Published:
+		return "published"
+	case ConfirmedOnChain:
+		return "confirmed on-chain"
+	case Pending:
+		return "pending"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// Request is a signing request noted in the journal.
+type Request struct {
+	KeepAddress common.Address
+	Digest      [32]byte
+	State       State
+	Attempts    int
+	UpdatedAt   time.Time
+}
+
+// persistedRequest is a serializable form of the request.
+type persistedRequest struct {
+	KeepAddress string
+	Digest      string
+	State       State
+	Attempts    int
+	UpdatedAt   time.Time
+}
+
+// Journal is a disk-backed record of signing requests handled by the client
+// and their lifecycle. Each request is stored in a separate directory of the
+// persistence layer, so the journal should be backed by an encrypted handle
+// distinct from the one used by the keeps registry. Requests which reached
+// the final state or were abandoned are archived.
+type Journal struct {
+	handle persistence.Handle
+
+	requestsMutex *sync.Mutex
+	requests      map[string]*Request
+}
+
+// NewJournal returns an empty journal backed by the given persistence handle.
+// Requests persisted before have to be loaded with Load.
+func NewJournal(handle persistence.Handle) *Journal {
+	return &Journal{
+		handle:        handle,
+		requestsMutex: &sync.Mutex{},
+		requests:      make(map[string]*Request),
+	}
+}
+
+// Load reads all unfinished requests from the persistence layer into memory.
+// Requests which could not be read are logged and skipped.
+func (j *Journal) Load() {
+	j.requestsMutex.Lock()
+	defer j.requestsMutex.Unlock()
+
+	dataChannel, errorsChannel := j.handle.ReadAll()
+
+	// Data and errors channels are not buffered and we don't know in what
+	// order they are written, so both are read at the same time.
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for descriptor := range dataChannel {
+			request, err := readRequest(descriptor)
+			if err != nil {
+				logger.Errorf(
+					"could not load signing request from directory [%v]: [%v]",
+					descriptor.Directory(),
+					err,
+				)
+				continue
+			}
+
+			j.requests[requestKey(request.KeepAddress, request.Digest)] = request
+		}
+
+		wg.Done()
+	}()
+
+	go func() {
+		for err := range errorsChannel {
+			logger.Errorf("could not load signing request: [%v]", err)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	logger.Infof(
+		"loaded [%d] unfinished signing requests from the journal",
+		len(j.requests),
+	)
+}
+
+// Record notes the given state of the signing request. Requests which reached
+// the final state are archived.
+func (j *Journal) Record(
+	keepAddress common.Address,
+	digest [32]byte,
+	state State,
+) error {
+	j.requestsMutex.Lock()
+	defer j.requestsMutex.Unlock()
+
+	attempts := 0
+	if request, ok := j.requests[requestKey(keepAddress, digest)]; ok {
+		attempts = request.Attempts
+	}
+
+	return j.save(keepAddress, digest, state, attempts)
+}
+
+// RecordAttempt notes a new attempt of the signature calculation for the
+// signing request and moves it to the Signing state.
+func (j *Journal) RecordAttempt(keepAddress common.Address, digest [32]byte) error {
+	j.requestsMutex.Lock()
+	defer j.requestsMutex.Unlock()
+
+	attempts := 1
+	if request, ok := j.requests[requestKey(keepAddress, digest)]; ok {
+		attempts = request.Attempts + 1
+	}
+
+	return j.save(keepAddress, digest, Signing, attempts)
+}
+
+func (j *Journal) save(
+	keepAddress common.Address,
+	digest [32]byte,
+	state State,
+	attempts int,
+) error {
+	key := requestKey(keepAddress, digest)
+
+	request := &Request{
+		KeepAddress: keepAddress,
+		Digest:      digest,
+		State:       state,
+		Attempts:    attempts,
+		UpdatedAt:   time.Now(),
+	}
+
+	requestBytes, err := json.Marshal(&persistedRequest{
+		KeepAddress: keepAddress.String(),
+		Digest:      hex.EncodeToString(digest[:]),
+		State:       state,
+		Attempts:    attempts,
+		UpdatedAt:   request.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal signing request: [%v]", err)
+	}
+
+	if err := j.handle.Save(requestBytes, key, requestFileName); err != nil {
+		return fmt.Errorf("failed to save signing request: [%v]", err)
+	}
+
+	if state == ConfirmedOnChain {
+		delete(j.requests, key)
+		return j.archive(key)
+	}
+
+	j.requests[key] = request
+
+	return nil
+}
+
+// Abandon archives the signing request without reaching the final state,
+// e.g. when the keep is no longer awaiting the signature.
+func (j *Journal) Abandon(keepAddress common.Address, digest [32]byte) error {
+	j.requestsMutex.Lock()
+	defer j.requestsMutex.Unlock()
+
+	key := requestKey(keepAddress, digest)
+
+	if _, ok := j.requests[key]; !ok {
+		return nil
+	}
+
+	delete(j.requests, key)
+
+	return j.archive(key)
+}
+
+// Unfinished returns all unfinished signing requests.
+func (j *Journal) Unfinished() []*Request {
+	j.requestsMutex.Lock()
+	defer j.requestsMutex.Unlock()
+
+	requests := make([]*Request, 0, len(j.requests))
+	for _, request := range j.requests {
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
+// UnfinishedForKeep returns unfinished signing requests of the given keep.
+func (j *Journal) UnfinishedForKeep(keepAddress common.Address) []*Request {
+	requests := make([]*Request, 0)
+	for _, request := range j.Unfinished() {
+		if request.KeepAddress == keepAddress {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+func (j *Journal) archive(key string) error {
+	if err := j.handle.Archive(key); err != nil {
+		return fmt.Errorf("failed to archive signing request: [%v]", err)
+	}
+
+	return nil
+}
+
+func readRequest(descriptor persistence.DataDescriptor) (*Request, error) {
+	content, err := descriptor.Content()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode content: [%v]", err)
+	}
+
+	persisted := &persistedRequest{}
+	if err := json.Unmarshal(content, persisted); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signing request: [%v]", err)
+	}
+
+	if !common.IsHexAddress(persisted.KeepAddress) {
+		return nil, fmt.Errorf(
+			"[%v] is not valid keep address",
+			persisted.KeepAddress,
+		)
+	}
+
+	digestBytes, err := hex.DecodeString(persisted.Digest)
+	if err != nil || len(digestBytes) != 32 {
+		return nil, fmt.Errorf("[%v] is not valid digest", persisted.Digest)
+	}
+
+	var digest [32]byte
+	copy(digest[:], digestBytes)
+
+	return &Request{
+		KeepAddress: common.HexToAddress(persisted.KeepAddress),
+		Digest:      digest,
+		State:       persisted.State,
+		Attempts:    persisted.Attempts,
+		UpdatedAt:   persisted.UpdatedAt,
+	}, nil
+}
+
+// requestKey returns the name of the directory in which the request is stored.
+func requestKey(keepAddress common.Address, digest [32]byte) string {
+	return fmt.Sprintf("%s_%x", keepAddress.String(), digest)
+}
@@ -0,0 +1,248 @@
+package signing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+var (
+	keepAddress1 = common.HexToAddress("0x770a9E2F2Aa1eC2d3Ca916Fc3e6A55058A898632")
+	keepAddress2 = common.HexToAddress("0x8B3BccB3A3994681A1C1584DE4b4E8b23ed1Ed6d")
+
+	digest1 = [32]byte{1}
+	digest2 = [32]byte{2}
+)
+
+func TestRecordAndLoad(t *testing.T) {
+	persistenceMock := newPersistenceHandleMock()
+
+	journal := NewJournal(persistenceMock)
+
+	if err := journal.Record(keepAddress1, digest1, Confirmed); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Record(keepAddress1, digest1, Signed); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Record(keepAddress2, digest2, Published); err != nil {
+		t.Fatal(err)
+	}
+
+	restoredJournal := NewJournal(persistenceMock)
+	restoredJournal.Load()
+
+	assertUnfinished(
+		t,
+		restoredJournal.UnfinishedForKeep(keepAddress1),
+		map[[32]byte]State{digest1: Signed},
+	)
+	assertUnfinished(
+		t,
+		restoredJournal.UnfinishedForKeep(keepAddress2),
+		map[[32]byte]State{digest2: Published},
+	)
+}
+
+func TestRecordFinalStateArchivesRequest(t *testing.T) {
+	persistenceMock := newPersistenceHandleMock()
+
+	journal := NewJournal(persistenceMock)
+
+	if err := journal.Record(keepAddress1, digest1, Published); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Record(keepAddress1, digest1, ConfirmedOnChain); err != nil {
+		t.Fatal(err)
+	}
+
+	assertUnfinished(t, journal.Unfinished(), map[[32]byte]State{})
+
+	expectedArchived := []string{requestKey(keepAddress1, digest1)}
+	if !reflect.DeepEqual(expectedArchived, persistenceMock.archived) {
+		t.Errorf(
+			"unexpected archived directories\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedArchived,
+			persistenceMock.archived,
+		)
+	}
+
+	restoredJournal := NewJournal(persistenceMock)
+	restoredJournal.Load()
+
+	assertUnfinished(t, restoredJournal.Unfinished(), map[[32]byte]State{})
+}
+
+func TestRecordAttempt(t *testing.T) {
+	persistenceMock := newPersistenceHandleMock()
+
+	journal := NewJournal(persistenceMock)
+
+	if err := journal.Record(keepAddress1, digest1, Confirmed); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.RecordAttempt(keepAddress1, digest1); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.RecordAttempt(keepAddress1, digest1); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Record(keepAddress1, digest1, Signed); err != nil {
+		t.Fatal(err)
+	}
+
+	restoredJournal := NewJournal(persistenceMock)
+	restoredJournal.Load()
+
+	requests := restoredJournal.UnfinishedForKeep(keepAddress1)
+	if len(requests) != 1 {
+		t.Fatalf(
+			"unexpected number of requests\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			1,
+			len(requests),
+		)
+	}
+
+	if requests[0].State != Signed {
+		t.Errorf(
+			"unexpected state\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			Signed,
+			requests[0].State,
+		)
+	}
+
+	if requests[0].Attempts != 2 {
+		t.Errorf(
+			"unexpected number of attempts\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			2,
+			requests[0].Attempts,
+		)
+	}
+}
+
+func TestAbandon(t *testing.T) {
+	persistenceMock := newPersistenceHandleMock()
+
+	journal := NewJournal(persistenceMock)
+
+	if err := journal.Record(keepAddress1, digest1, Signing); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Record(keepAddress1, digest2, Signing); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := journal.Abandon(keepAddress1, digest1); err != nil {
+		t.Fatal(err)
+	}
+
+	// abandoning an unknown request should be a no-op
+	if err := journal.Abandon(keepAddress2, digest1); err != nil {
+		t.Fatal(err)
+	}
+
+	assertUnfinished(
+		t,
+		journal.Unfinished(),
+		map[[32]byte]State{digest2: Signing},
+	)
+
+	if len(persistenceMock.archived) != 1 {
+		t.Errorf(
+			"unexpected number of archived directories\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			1,
+			len(persistenceMock.archived),
+		)
+	}
+}
+
+func assertUnfinished(
+	t *testing.T,
+	requests []*Request,
+	expected map[[32]byte]State,
+) {
+	actual := make(map[[32]byte]State)
+	for _, request := range requests {
+		actual[request.Digest] = request.State
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf(
+			"unexpected unfinished requests\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expected,
+			actual,
+		)
+	}
+}
+
+type persistenceHandleMock struct {
+	files    map[string][]byte // <directory, content>
+	archived []string
+}
+
+func newPersistenceHandleMock() *persistenceHandleMock {
+	return &persistenceHandleMock{
+		files: make(map[string][]byte),
+	}
+}
+
+func (phm *persistenceHandleMock) Save(data []byte, directory string, name string) error {
+	phm.files[directory] = data
+	return nil
+}
+
+func (phm *persistenceHandleMock) Snapshot(data []byte, directory string, name string) error {
+	return nil
+}
+
+func (phm *persistenceHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	outputData := make(chan persistence.DataDescriptor, len(phm.files))
+	outputErrors := make(chan error)
+
+	for directory, content := range phm.files {
+		outputData <- &testDataDescriptor{requestFileName, directory, content}
+	}
+
+	close(outputData)
+	close(outputErrors)
+
+	return outputData, outputErrors
+}
+
+func (phm *persistenceHandleMock) Archive(directory string) error {
+	delete(phm.files, directory)
+	phm.archived = append(phm.archived, directory)
+	return nil
+}
+
+type testDataDescriptor struct {
+	name      string
+	directory string
+	content   []byte
+}
+
+func (tdd *testDataDescriptor) Name() string {
+	return tdd.name
+}
+
+func (tdd *testDataDescriptor) Directory() string {
+	return tdd.directory
+}
+
+func (tdd *testDataDescriptor) Content() ([]byte, error) {
+	return tdd.content, nil
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+// diskArchiveDirectory is the directory within the data directory where the
+// disk persistence moves archived directories.
+const diskArchiveDirectory = "archive"
+
+// NewDiskArchiveHandle returns a read-only persistence handle reading
+// directories archived by the disk persistence handle of the given data
+// directory.
+func NewDiskArchiveHandle(dataDir string) persistence.Handle {
+	archiveDir := filepath.Join(dataDir, diskArchiveDirectory)
+
+	return &readOnlyHandle{
+		readAll: func() (<-chan persistence.DataDescriptor, <-chan error) {
+			return readDiskDirectory(archiveDir, nil)
+		},
+	}
+}
+
+// readOnlyHandle is a read-only persistence handle of data written by another
+// handle, e.g. archived data. The data can be read but can not be modified.
+type readOnlyHandle struct {
+	readAll func() (<-chan persistence.DataDescriptor, <-chan error)
+}
+
+func (roh *readOnlyHandle) Save(data []byte, directory string, name string) error {
+	return fmt.Errorf("handle is read-only")
+}
+
+func (roh *readOnlyHandle) Snapshot(data []byte, directory string, name string) error {
+	return fmt.Errorf("handle is read-only")
+}
+
+func (roh *readOnlyHandle) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	return roh.readAll()
+}
+
+func (roh *readOnlyHandle) Archive(directory string) error {
+	return fmt.Errorf("handle is read-only")
+}
+
+// readDiskDirectory reads all files from subdirectories of the given
+// directory the same way the disk persistence reads its current directory.
+// Names of files are transformed with the given function, if one is given.
+// No files are returned if the directory does not exist.
+func readDiskDirectory(
+	path string,
+	fileName func(string) string,
+) (<-chan persistence.DataDescriptor, <-chan error) {
+	dataChannel := make(chan persistence.DataDescriptor)
+	errorChannel := make(chan error)
+
+	go func() {
+		defer close(dataChannel)
+		defer close(errorChannel)
+
+		directories, err := ioutil.ReadDir(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				errorChannel <- fmt.Errorf(
+					"could not read the directory [%v]: [%v]",
+					path,
+					err,
+				)
+			}
+			return
+		}
+
+		for _, directory := range directories {
+			if !directory.IsDir() {
+				continue
+			}
+
+			directoryPath := filepath.Join(path, directory.Name())
+
+			files, err := ioutil.ReadDir(directoryPath)
+			if err != nil {
+				errorChannel <- fmt.Errorf(
+					"could not read the directory [%v]: [%v]",
+					directoryPath,
+					err,
+				)
+				continue
+			}
+
+			for _, file := range files {
+				name := file.Name()
+				if fileName != nil {
+					name = fileName(name)
+				}
+
+				dataChannel <- &diskDescriptor{
+					name:      name,
+					directory: directory.Name(),
+					path:      filepath.Join(directoryPath, file.Name()),
+				}
+			}
+		}
+	}()
+
+	return dataChannel, errorChannel
+}
+
+type diskDescriptor struct {
+	name      string
+	directory string
+	path      string
+}
+
+func (dd *diskDescriptor) Name() string {
+	return dd.name
+}
+
+func (dd *diskDescriptor) Directory() string {
+	return dd.directory
+}
+
+func (dd *diskDescriptor) Content() ([]byte, error) {
+	// #nosec G304 (file path provided as taint input)
+	// This line reads a file from the archive of the data directory.
+	// There is no user input.
+	return ioutil.ReadFile(dd.path)
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+func TestDiskArchiveHandle(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	handle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handle.Save([]byte("signer-1"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := handle.Save([]byte("signer-2"), "keep-2", "/membership_2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := handle.Archive("keep-2"); err != nil {
+		t.Fatal(err)
+	}
+
+	archiveHandle := NewDiskArchiveHandle(dataDir)
+
+	assertContent(
+		t,
+		map[string]string{"keep-2membership_2": "signer-2"},
+		archiveHandle,
+	)
+
+	if err := archiveHandle.Save([]byte("signer-3"), "keep-3", "/membership_3"); err == nil {
+		t.Errorf("expected save failure")
+	}
+}
+
+func TestDiskArchiveHandle_NoArchive(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	assertContent(t, map[string]string{}, NewDiskArchiveHandle(dataDir))
+}
@@ -0,0 +1,618 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+// keyValueStoreMagic is the header of every key/value store file. It
+// identifies the file format and its version.
+const keyValueStoreMagic = "KEEPKV01"
+
+// keyValueStoreLockSuffix is the suffix of the lock file created next to the
+// key/value store file. The file is locked while the store is open.
+const keyValueStoreLockSuffix = ".lock"
+
+// compactionThreshold is the minimum number of records in the file after
+// which the store is compacted on open if most of the records are obsolete.
+const compactionThreshold = 1000
+
+// errIncompleteRecord is returned when the record runs past the end of the
+// file. Only the last record can be incomplete, if the process crashed while
+// the record was being written.
+var errIncompleteRecord = fmt.Errorf("record is incomplete")
+
+// keySeparator separates the directory and the name in keys of entries
+// stored by persistence handles.
+const keySeparator = "\x00"
+
+// Buckets of entries stored by persistence handles within the namespace.
+const (
+	currentBucket  = "current"
+	archiveBucket  = "archive"
+	snapshotBucket = "snapshot"
+)
+
+// KeyValueStore is an embedded key/value store keeping all data in a single
+// append-only file. Every modification is a transaction written to the file
+// as a single checksummed record and synced to disk before it is applied in
+// memory, so a modification is either fully applied or not applied at all.
+// Records torn by a crash are discarded when the store is opened; any other
+// corrupted record makes the store refuse to open. The store
+// is locked for exclusive use by a single process while it is open.
+type KeyValueStore struct {
+	mutex    sync.RWMutex
+	path     string
+	file     *os.File
+	lockFile *os.File
+	buckets  map[string]map[string][]byte
+	records  int
+}
+
+type keyValueOperation struct {
+	Bucket string `json:"b"`
+	Key    string `json:"k"`
+	Value  []byte `json:"v,omitempty"`
+	Delete bool   `json:"d,omitempty"`
+}
+
+// OpenKeyValueStore opens the key/value store from the given file. The file
+// is created if it does not exist.
+func OpenKeyValueStore(path string) (*KeyValueStore, error) {
+	lockFile, err := lock(path + keyValueStoreLockSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &KeyValueStore{
+		path:     path,
+		lockFile: lockFile,
+		buckets:  make(map[string]map[string][]byte),
+	}
+
+	if err := store.load(); err != nil {
+		store.unlock()
+		return nil, err
+	}
+
+	if store.records > compactionThreshold && store.records > 2*store.size() {
+		if err := store.compact(); err != nil {
+			logger.Warningf(
+				"could not compact key/value store [%v]: [%v]",
+				path,
+				err,
+			)
+		}
+	}
+
+	return store, nil
+}
+
+// Close closes the store file and releases the lock.
+func (kvs *KeyValueStore) Close() error {
+	kvs.mutex.Lock()
+	defer kvs.mutex.Unlock()
+
+	err := kvs.file.Close()
+	kvs.unlock()
+
+	return err
+}
+
+// Handle returns a persistence handle storing data in the given namespace of
+// the store. Each operation of the handle is executed in a single
+// transaction.
+func (kvs *KeyValueStore) Handle(namespace string) persistence.Handle {
+	return &keyValueHandle{
+		store:     kvs,
+		namespace: namespace,
+	}
+}
+
+// ArchiveHandle returns a read-only persistence handle reading entries
+// archived by the handle of the given namespace.
+func (kvs *KeyValueStore) ArchiveHandle(namespace string) persistence.Handle {
+	return &readOnlyHandle{
+		readAll: func() (<-chan persistence.DataDescriptor, <-chan error) {
+			handle := &keyValueHandle{store: kvs, namespace: namespace}
+			return handle.readBucket(archiveBucket)
+		},
+	}
+}
+
+func (kvs *KeyValueStore) load() error {
+	file, err := os.OpenFile(kvs.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open key/value store file: [%v]", err)
+	}
+
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("could not read key/value store file: [%v]", err)
+	}
+
+	if len(content) == 0 {
+		if err := writeAndSync(file, []byte(keyValueStoreMagic)); err != nil {
+			file.Close()
+			return fmt.Errorf("could not initialize key/value store file: [%v]", err)
+		}
+
+		kvs.file = file
+		return nil
+	}
+
+	if !bytes.HasPrefix(content, []byte(keyValueStoreMagic)) {
+		file.Close()
+		return fmt.Errorf("[%v] is not a key/value store file", kvs.path)
+	}
+
+	offset := len(keyValueStoreMagic)
+	for offset < len(content) {
+		operations, recordLength, err := decodeRecord(content[offset:])
+		if err != nil && err != errIncompleteRecord {
+			// A complete record which can not be decoded has not been torn
+			// by a crash. Discarding it, and all records following it, would
+			// silently lose confirmed transactions.
+			file.Close()
+			return fmt.Errorf(
+				"corrupted record at offset [%d] in key/value store [%v]: [%v]",
+				offset,
+				kvs.path,
+				err,
+			)
+		}
+		if err != nil {
+			// Only the last record can be torn by a crash during the write.
+			// The transaction has not been confirmed to the writer, so it is
+			// safe to discard it.
+			logger.Warningf(
+				"discarding [%d] bytes of incomplete record in "+
+					"key/value store [%v]: [%v]",
+				len(content)-offset,
+				kvs.path,
+				err,
+			)
+
+			if err := file.Truncate(int64(offset)); err != nil {
+				file.Close()
+				return fmt.Errorf("could not discard incomplete record: [%v]", err)
+			}
+			break
+		}
+
+		kvs.apply(operations)
+		offset += recordLength
+	}
+
+	if _, err := file.Seek(int64(offset), io.SeekStart); err != nil {
+		file.Close()
+		return err
+	}
+
+	kvs.file = file
+
+	return nil
+}
+
+// commit writes the transaction to the file and applies it in memory.
+func (kvs *KeyValueStore) commit(operations []*keyValueOperation) error {
+	record, err := encodeRecord(operations)
+	if err != nil {
+		return err
+	}
+
+	if err := writeAndSync(kvs.file, record); err != nil {
+		return fmt.Errorf("could not write transaction: [%v]", err)
+	}
+
+	kvs.apply(operations)
+
+	return nil
+}
+
+func (kvs *KeyValueStore) apply(operations []*keyValueOperation) {
+	for _, operation := range operations {
+		bucket, ok := kvs.buckets[operation.Bucket]
+		if !ok {
+			bucket = make(map[string][]byte)
+			kvs.buckets[operation.Bucket] = bucket
+		}
+
+		if operation.Delete {
+			delete(bucket, operation.Key)
+		} else {
+			bucket[operation.Key] = operation.Value
+		}
+	}
+
+	kvs.records++
+}
+
+func (kvs *KeyValueStore) size() int {
+	size := 0
+	for _, bucket := range kvs.buckets {
+		size += len(bucket)
+	}
+	return size
+}
+
+// entries returns all entries of the store as put operations sorted by
+// buckets and keys.
+func (kvs *KeyValueStore) entries() []*keyValueOperation {
+	operations := make([]*keyValueOperation, 0, kvs.size())
+
+	for bucketName, bucket := range kvs.buckets {
+		for key, value := range bucket {
+			operations = append(operations, &keyValueOperation{
+				Bucket: bucketName,
+				Key:    key,
+				Value:  value,
+			})
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Bucket != operations[j].Bucket {
+			return operations[i].Bucket < operations[j].Bucket
+		}
+		return operations[i].Key < operations[j].Key
+	})
+
+	return operations
+}
+
+// compact rewrites the store file so it contains only current entries in
+// a single record. The new file replaces the old one atomically.
+func (kvs *KeyValueStore) compact() error {
+	compactedPath := kvs.path + ".compact"
+
+	// Remove leftovers of a compaction interrupted before the rename.
+	if err := os.Remove(compactedPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := writeKeyValueStoreFile(compactedPath, kvs.entries()); err != nil {
+		return err
+	}
+
+	if err := os.Rename(compactedPath, kvs.path); err != nil {
+		os.Remove(compactedPath)
+		return err
+	}
+
+	file, err := os.OpenFile(kvs.path, os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("could not reopen compacted file: [%v]", err)
+	}
+
+	kvs.file.Close()
+	kvs.file = file
+	kvs.records = 1
+
+	return nil
+}
+
+func (kvs *KeyValueStore) unlock() {
+	if err := syscall.Flock(int(kvs.lockFile.Fd()), syscall.LOCK_UN); err != nil {
+		logger.Warningf("could not unlock key/value store [%v]: [%v]", kvs.path, err)
+	}
+	kvs.lockFile.Close()
+}
+
+// writeKeyValueStoreFile writes a new store file with all the given
+// operations in a single record.
+func writeKeyValueStoreFile(path string, operations []*keyValueOperation) error {
+	record, err := encodeRecord(operations)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeAndSync(file, append([]byte(keyValueStoreMagic), record...))
+}
+
+func lock(path string) (*os.File, error) {
+	lockFile, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not create lock file: [%v]", err)
+	}
+
+	if err := syscall.Flock(
+		int(lockFile.Fd()),
+		syscall.LOCK_EX|syscall.LOCK_NB,
+	); err != nil {
+		lockFile.Close()
+		return nil, fmt.Errorf(
+			"could not lock [%v]; the store is in use by another process: [%v]",
+			path,
+			err,
+		)
+	}
+
+	return lockFile, nil
+}
+
+// encodeRecord encodes the transaction as a record consisting of the payload
+// length, the payload checksum and the payload itself.
+func encodeRecord(operations []*keyValueOperation) ([]byte, error) {
+	payload, err := json.Marshal(operations)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal transaction: [%v]", err)
+	}
+
+	record := make([]byte, 8+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+	copy(record[8:], payload)
+
+	return record, nil
+}
+
+// decodeRecord decodes the transaction from the beginning of the data. It
+// returns the transaction and the length of the record. If the record runs
+// past the end of the data, errIncompleteRecord is returned.
+func decodeRecord(data []byte) ([]*keyValueOperation, int, error) {
+	if len(data) < 8 {
+		return nil, 0, errIncompleteRecord
+	}
+
+	payloadLength := int(binary.BigEndian.Uint32(data[0:4]))
+	checksum := binary.BigEndian.Uint32(data[4:8])
+
+	if len(data) < 8+payloadLength {
+		return nil, 0, errIncompleteRecord
+	}
+
+	payload := data[8 : 8+payloadLength]
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, 0, fmt.Errorf("record checksum mismatch")
+	}
+
+	var operations []*keyValueOperation
+	if err := json.Unmarshal(payload, &operations); err != nil {
+		return nil, 0, fmt.Errorf("could not unmarshal transaction: [%v]", err)
+	}
+
+	return operations, 8 + payloadLength, nil
+}
+
+func writeAndSync(file *os.File, data []byte) error {
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+
+	return file.Sync()
+}
+
+// isKeyValueStoreFile checks whether the file is a key/value store file.
+func isKeyValueStoreFile(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	header := make([]byte, len(keyValueStoreMagic))
+	if _, err := io.ReadFull(file, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return string(header) == keyValueStoreMagic, nil
+}
+
+// keyValueHandle is a persistence handle storing data in the namespace of
+// the key/value store. Entries are kept in current, archive and snapshot
+// buckets the same way the disk persistence keeps them in directories.
+type keyValueHandle struct {
+	store     *KeyValueStore
+	namespace string
+}
+
+func (kvh *keyValueHandle) Save(data []byte, directory string, name string) error {
+	kvh.store.mutex.Lock()
+	defer kvh.store.mutex.Unlock()
+
+	return kvh.store.commit([]*keyValueOperation{{
+		Bucket: kvh.bucket(currentBucket),
+		Key:    directory + keySeparator + name,
+		Value:  data,
+	}})
+}
+
+func (kvh *keyValueHandle) Snapshot(data []byte, directory string, name string) error {
+	kvh.store.mutex.Lock()
+	defer kvh.store.mutex.Unlock()
+
+	return kvh.store.commit([]*keyValueOperation{{
+		Bucket: kvh.bucket(snapshotBucket),
+		Key: fmt.Sprintf(
+			"%s%s%s_%d",
+			directory,
+			keySeparator,
+			name,
+			time.Now().UnixNano(),
+		),
+		Value: data,
+	}})
+}
+
+func (kvh *keyValueHandle) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	return kvh.readBucket(currentBucket)
+}
+
+func (kvh *keyValueHandle) readBucket(
+	name string,
+) (<-chan persistence.DataDescriptor, <-chan error) {
+	kvh.store.mutex.RLock()
+	defer kvh.store.mutex.RUnlock()
+
+	bucket := kvh.store.buckets[kvh.bucket(name)]
+
+	keys := make([]string, 0, len(bucket))
+	for key := range bucket {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	dataChannel := make(chan persistence.DataDescriptor, len(keys))
+	errorChannel := make(chan error)
+
+	for _, key := range keys {
+		directory, name := splitKey(key)
+		dataChannel <- &keyValueDescriptor{
+			name:      name,
+			directory: directory,
+			content:   bucket[key],
+		}
+	}
+
+	close(dataChannel)
+	close(errorChannel)
+
+	return dataChannel, errorChannel
+}
+
+func (kvh *keyValueHandle) Archive(directory string) error {
+	kvh.store.mutex.Lock()
+	defer kvh.store.mutex.Unlock()
+
+	currentBucketName := kvh.bucket(currentBucket)
+	archiveBucketName := kvh.bucket(archiveBucket)
+
+	operations := make([]*keyValueOperation, 0)
+	for key, value := range kvh.store.buckets[currentBucketName] {
+		if keyDirectory, _ := splitKey(key); keyDirectory != directory {
+			continue
+		}
+
+		operations = append(
+			operations,
+			&keyValueOperation{
+				Bucket: currentBucketName,
+				Key:    key,
+				Delete: true,
+			},
+			&keyValueOperation{
+				Bucket: archiveBucketName,
+				Key:    key,
+				Value:  value,
+			},
+		)
+	}
+
+	if len(operations) == 0 {
+		return fmt.Errorf("directory [%v] does not exist", directory)
+	}
+
+	return kvh.store.commit(operations)
+}
+
+func (kvh *keyValueHandle) bucket(name string) string {
+	return kvh.namespace + "/" + name
+}
+
+func splitKey(key string) (string, string) {
+	parts := strings.SplitN(key, keySeparator, 2)
+	if len(parts) != 2 {
+		return "", key
+	}
+
+	return parts[0], parts[1]
+}
+
+type keyValueDescriptor struct {
+	name      string
+	directory string
+	content   []byte
+}
+
+func (kvd *keyValueDescriptor) Name() string {
+	return kvd.name
+}
+
+func (kvd *keyValueDescriptor) Directory() string {
+	return kvd.directory
+}
+
+func (kvd *keyValueDescriptor) Content() ([]byte, error) {
+	return kvd.content, nil
+}
+
+// reencryptKeyValueStore re-encrypts all values of the key/value store into
+// a new store file.
+func reencryptKeyValueStore(
+	sourcePath string,
+	targetPath string,
+	oldPassphrase string,
+	newPassphrase string,
+) (int, error) {
+	source, err := OpenKeyValueStore(sourcePath)
+	if err != nil {
+		return 0, err
+	}
+	defer source.Close()
+
+	source.mutex.RLock()
+	entries := source.entries()
+	source.mutex.RUnlock()
+
+	reencrypted := make([]*keyValueOperation, 0, len(entries))
+	for _, entry := range entries {
+		plaintext, err := decrypt(entry.Value, oldPassphrase)
+		if err != nil {
+			return 0, fmt.Errorf(
+				"could not decrypt entry [%q] with the old passphrase: [%v]",
+				entry.Key,
+				err,
+			)
+		}
+
+		ciphertext, err := encrypt(plaintext, newPassphrase)
+		if err != nil {
+			return 0, fmt.Errorf(
+				"could not encrypt entry [%q] with the new passphrase: [%v]",
+				entry.Key,
+				err,
+			)
+		}
+
+		reencrypted = append(reencrypted, &keyValueOperation{
+			Bucket: entry.Bucket,
+			Key:    entry.Key,
+			Value:  ciphertext,
+		})
+	}
+
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0700); err != nil {
+		return 0, err
+	}
+
+	if err := writeKeyValueStoreFile(targetPath, reencrypted); err != nil {
+		return 0, err
+	}
+
+	return len(reencrypted), nil
+}
@@ -0,0 +1,306 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+func TestKeyValueStore_SaveAndReadAll(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dataDir, "keeps.db")
+
+	store, err := OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signers := store.Handle("signers")
+	snapshots := store.Handle("snapshots")
+
+	if err := signers.Save([]byte("signer-1"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := signers.Save([]byte("signer-2"), "keep-2", "/membership_2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := snapshots.Save([]byte("snapshot-3"), "keep-3", "/membership_3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := signers.Archive("keep-2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the store to make sure all transactions have been persisted.
+	store, err = OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	assertContent(
+		t,
+		map[string]string{"keep-1/membership_1": "signer-1"},
+		store.Handle("signers"),
+	)
+	assertContent(
+		t,
+		map[string]string{"keep-3/membership_3": "snapshot-3"},
+		store.Handle("snapshots"),
+	)
+
+	archived := store.buckets["signers/"+archiveBucket]
+	if len(archived) != 1 {
+		t.Errorf("unexpected number of archived entries: [%v]", len(archived))
+	}
+
+	assertContent(
+		t,
+		map[string]string{"keep-2/membership_2": "signer-2"},
+		store.ArchiveHandle("signers"),
+	)
+}
+
+func TestKeyValueStore_ArchiveNotExisting(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	store, err := OpenKeyValueStore(filepath.Join(dataDir, "keeps.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	if err := store.Handle("signers").Archive("keep-1"); err == nil {
+		t.Errorf("expected archive failure")
+	}
+}
+
+func TestKeyValueStore_DiscardsTornRecord(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dataDir, "keeps.db")
+
+	store, err := OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Handle("signers").Save([]byte("signer-1"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash in the middle of a transaction write.
+	record, err := encodeRecord([]*keyValueOperation{{
+		Bucket: "signers/" + currentBucket,
+		Key:    "keep-2" + keySeparator + "/membership_2",
+		Value:  []byte("signer-2"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write(record[:len(record)-3]); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	store, err = OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handle := store.Handle("signers")
+
+	assertContent(t, map[string]string{"keep-1/membership_1": "signer-1"}, handle)
+
+	// New transactions are written after the discarded record.
+	if err := handle.Save([]byte("signer-3"), "keep-3", "/membership_3"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	assertContent(
+		t,
+		map[string]string{
+			"keep-1/membership_1": "signer-1",
+			"keep-3/membership_3": "signer-3",
+		},
+		store.Handle("signers"),
+	)
+}
+
+func TestKeyValueStore_RefusesCorruptedRecord(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dataDir, "keeps.db")
+
+	store, err := OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handle := store.Handle("signers")
+	if err := handle.Save([]byte("signer-1"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := handle.Save([]byte("signer-2"), "keep-2", "/membership_2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Flip a bit in the payload of the first record. The record is complete
+	// and followed by another one, so it has not been torn by a crash.
+	content[len(keyValueStoreMagic)+8] ^= 0x01
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = OpenKeyValueStore(path)
+	if err == nil || !strings.Contains(err.Error(), "corrupted record") {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	// The file must be left intact for inspection.
+	afterOpen, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(content, afterOpen) {
+		t.Errorf("key/value store file has been modified")
+	}
+}
+
+func TestKeyValueStore_ExclusiveLock(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dataDir, "keeps.db")
+
+	store, err := OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenKeyValueStore(path); err == nil {
+		t.Fatal("expected lock failure")
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatalf("could not open store after it has been closed: [%v]", err)
+	}
+	store.Close()
+}
+
+func TestRekey_KeyValueStore(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dataDir, "keeps.db")
+
+	store, err := OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted := persistence.NewEncryptedPersistence(
+		store.Handle("signers"),
+		oldPassphrase,
+	)
+	if err := encrypted.Save([]byte("signer-1"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Rekey(dataDir, oldPassphrase, newPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Files != 1 {
+		t.Errorf("unexpected number of re-encrypted entries: [%v]", result.Files)
+	}
+
+	store, err = OpenKeyValueStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	assertContent(
+		t,
+		map[string]string{"keep-1/membership_1": "signer-1"},
+		persistence.NewEncryptedPersistence(store.Handle("signers"), newPassphrase),
+	)
+}
+
+func assertContent(t *testing.T, expected map[string]string, handle persistence.Handle) {
+	actual := make(map[string]string)
+
+	dataChannel, errorChannel := handle.ReadAll()
+	for descriptor := range dataChannel {
+		content, err := descriptor.Content()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual[descriptor.Directory()+descriptor.Name()] = string(content)
+	}
+	for err := range errorChannel {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf(
+			"unexpected content\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			actual,
+		)
+	}
+}
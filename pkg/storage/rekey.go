@@ -0,0 +1,336 @@
+// Package storage contains tools for maintenance of the data stored by the
+// client on disk.
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+)
+
+var logger = log.Logger("keep-storage")
+
+// RekeyResult describes the outcome of the data directory re-encryption.
+type RekeyResult struct {
+	// Number of re-encrypted files and key/value store entries.
+	Files int
+	// Location of the data directory content encrypted with the old
+	// passphrase. It should be removed once the client is confirmed to work
+	// with the new passphrase.
+	PreviousDataDir string
+}
+
+// Rekey re-encrypts all files stored in the data directory, including
+// archived ones, replacing the old passphrase with the new one. Files are
+// re-encrypted into a staging directory next to the data directory and
+// verified there. The data directory is replaced with the staging directory
+// only when all files have been re-encrypted successfully, so if any file
+// can not be decrypted with the old passphrase the data directory is left
+// untouched. The client must not be running during re-encryption.
+func Rekey(dataDir, oldPassphrase, newPassphrase string) (*RekeyResult, error) {
+	dataDir = filepath.Clean(dataDir)
+
+	dataDirInfo, err := os.Stat(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not access data directory: [%v]", err)
+	}
+	if !dataDirInfo.IsDir() {
+		return nil, fmt.Errorf("[%v] is not a directory", dataDir)
+	}
+
+	stagingDir := dataDir + ".rekey"
+	if _, err := os.Stat(stagingDir); !os.IsNotExist(err) {
+		return nil, fmt.Errorf(
+			"staging directory [%v] already exists; "+
+				"remove it if it is a leftover of a failed re-encryption",
+			stagingDir,
+		)
+	}
+
+	if err := os.Mkdir(stagingDir, dataDirInfo.Mode().Perm()); err != nil {
+		return nil, fmt.Errorf("could not create staging directory: [%v]", err)
+	}
+
+	files, err := reencryptTree(dataDir, stagingDir, oldPassphrase, newPassphrase)
+	if err != nil {
+		if removeErr := os.RemoveAll(stagingDir); removeErr != nil {
+			logger.Errorf(
+				"could not remove staging directory [%v]: [%v]",
+				stagingDir,
+				removeErr,
+			)
+		}
+		return nil, err
+	}
+
+	previousDataDir := fmt.Sprintf("%s.old-%d", dataDir, time.Now().Unix())
+
+	if err := os.Rename(dataDir, previousDataDir); err != nil {
+		return nil, fmt.Errorf(
+			"could not move data directory aside; "+
+				"re-encrypted files are left in [%v]: [%v]",
+			stagingDir,
+			err,
+		)
+	}
+
+	if err := os.Rename(stagingDir, dataDir); err != nil {
+		if restoreErr := os.Rename(previousDataDir, dataDir); restoreErr != nil {
+			return nil, fmt.Errorf(
+				"could not replace data directory: [%v]; "+
+					"could not restore data directory from [%v]: [%v]",
+				err,
+				previousDataDir,
+				restoreErr,
+			)
+		}
+
+		return nil, fmt.Errorf("could not replace data directory: [%v]", err)
+	}
+
+	return &RekeyResult{
+		Files:           files,
+		PreviousDataDir: previousDataDir,
+	}, nil
+}
+
+// reencryptTree re-encrypts all files from the source directory tree into
+// the target directory preserving the directory structure. Key/value store
+// files are re-encrypted entry by entry. Audit log files are not encrypted so
+// they are copied as they are. It returns the number of re-encrypted files
+// and key/value store entries.
+func reencryptTree(
+	sourceDir string,
+	targetDir string,
+	oldPassphrase string,
+	newPassphrase string,
+) (int, error) {
+	files := 0
+
+	err := filepath.Walk(
+		sourceDir,
+		func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			relativePath, err := filepath.Rel(sourceDir, path)
+			if err != nil {
+				return err
+			}
+
+			targetPath := filepath.Join(targetDir, relativePath)
+
+			switch {
+			case relativePath == ".":
+				return nil
+			case info.IsDir():
+				return os.Mkdir(targetPath, info.Mode().Perm())
+			case !info.Mode().IsRegular():
+				return fmt.Errorf("[%v] is not a regular file", path)
+			case strings.HasSuffix(path, keyValueStoreLockSuffix):
+				// Lock files have no content and are recreated on open.
+				return nil
+			}
+
+			isAuditLog, err := audit.IsLogFile(path)
+			if err != nil {
+				return err
+			}
+
+			if isAuditLog {
+				if err := copyFile(path, targetPath, info.Mode().Perm()); err != nil {
+					return fmt.Errorf(
+						"could not copy audit log [%v]: [%v]",
+						path,
+						err,
+					)
+				}
+
+				return nil
+			}
+
+			isKeyValueStore, err := isKeyValueStoreFile(path)
+			if err != nil {
+				return err
+			}
+
+			if isKeyValueStore {
+				entries, err := reencryptKeyValueStore(
+					path,
+					targetPath,
+					oldPassphrase,
+					newPassphrase,
+				)
+				if err != nil {
+					return fmt.Errorf(
+						"could not re-encrypt key/value store [%v]: [%v]",
+						path,
+						err,
+					)
+				}
+
+				files += entries
+				return nil
+			}
+
+			if err := reencryptFile(
+				path,
+				targetPath,
+				info.Mode().Perm(),
+				oldPassphrase,
+				newPassphrase,
+			); err != nil {
+				return fmt.Errorf(
+					"could not re-encrypt file [%v]: [%v]",
+					path,
+					err,
+				)
+			}
+
+			files++
+
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return files, nil
+}
+
+func reencryptFile(
+	sourcePath string,
+	targetPath string,
+	mode os.FileMode,
+	oldPassphrase string,
+	newPassphrase string,
+) error {
+	ciphertext, err := ioutil.ReadFile(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := decrypt(ciphertext, oldPassphrase)
+	if err != nil {
+		return fmt.Errorf("could not decrypt with the old passphrase: [%v]", err)
+	}
+
+	newCiphertext, err := encrypt(plaintext, newPassphrase)
+	if err != nil {
+		return fmt.Errorf("could not encrypt with the new passphrase: [%v]", err)
+	}
+
+	// Make sure the file can be read back before the old one is replaced.
+	verifiedPlaintext, err := decrypt(newCiphertext, newPassphrase)
+	if err != nil {
+		return fmt.Errorf("could not decrypt with the new passphrase: [%v]", err)
+	}
+	if !bytes.Equal(plaintext, verifiedPlaintext) {
+		return fmt.Errorf("re-encrypted content does not match the original")
+	}
+
+	return ioutil.WriteFile(targetPath, newCiphertext, mode)
+}
+
+func copyFile(sourcePath string, targetPath string, mode os.FileMode) error {
+	content, err := ioutil.ReadFile(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(targetPath, content, mode)
+}
+
+func encrypt(plaintext []byte, passphrase string) ([]byte, error) {
+	handle := &blobHandle{}
+
+	err := persistence.NewEncryptedPersistence(handle, passphrase).Save(
+		plaintext,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return handle.data, nil
+}
+
+func decrypt(ciphertext []byte, passphrase string) ([]byte, error) {
+	handle := &blobHandle{data: ciphertext}
+
+	dataChannel, errorChannel := persistence.NewEncryptedPersistence(
+		handle,
+		passphrase,
+	).ReadAll()
+
+	var plaintext []byte
+	var err error
+	for descriptor := range dataChannel {
+		plaintext, err = descriptor.Content()
+	}
+	for readErr := range errorChannel {
+		err = readErr
+	}
+
+	return plaintext, err
+}
+
+// blobHandle is a persistence handle holding a single file content in memory.
+// It is used to encrypt and decrypt files the same way the encrypted
+// persistence does, regardless of their location in the data directory.
+type blobHandle struct {
+	data []byte
+}
+
+func (bh *blobHandle) Save(data []byte, directory string, name string) error {
+	bh.data = data
+	return nil
+}
+
+func (bh *blobHandle) Snapshot(data []byte, directory string, name string) error {
+	bh.data = data
+	return nil
+}
+
+func (bh *blobHandle) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	dataChannel := make(chan persistence.DataDescriptor, 1)
+	errorChannel := make(chan error)
+
+	dataChannel <- &blobDescriptor{bh.data}
+
+	close(dataChannel)
+	close(errorChannel)
+
+	return dataChannel, errorChannel
+}
+
+func (bh *blobHandle) Archive(directory string) error {
+	return nil
+}
+
+type blobDescriptor struct {
+	data []byte
+}
+
+func (bd *blobDescriptor) Name() string {
+	return ""
+}
+
+func (bd *blobDescriptor) Directory() string {
+	return ""
+}
+
+func (bd *blobDescriptor) Content() ([]byte, error) {
+	return bd.data, nil
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+)
+
+const (
+	oldPassphrase = "old-passphrase"
+	newPassphrase = "new-passphrase"
+)
+
+func TestRekey(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	handle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := persistence.NewEncryptedPersistence(handle, oldPassphrase)
+
+	if err := encrypted.Save([]byte("current"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := encrypted.Save([]byte("archived"), "keep-2", "/membership_2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := encrypted.Archive("keep-2"); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Rekey(dataDir, oldPassphrase, newPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Files != 2 {
+		t.Errorf(
+			"unexpected number of re-encrypted files\nexpected: [%d]\nactual:   [%d]",
+			2,
+			result.Files,
+		)
+	}
+
+	expectedContents := map[string]bool{"current": true, "archived": true}
+	actualContents := make(map[string]bool)
+
+	err = filepath.Walk(dataDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		ciphertext, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if _, err := decrypt(ciphertext, oldPassphrase); err == nil {
+			t.Errorf("file [%v] can be decrypted with the old passphrase", path)
+		}
+
+		plaintext, err := decrypt(ciphertext, newPassphrase)
+		if err != nil {
+			return err
+		}
+
+		actualContents[string(plaintext)] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expectedContents, actualContents) {
+		t.Errorf(
+			"unexpected re-encrypted contents\nexpected: [%v]\nactual:   [%v]",
+			expectedContents,
+			actualContents,
+		)
+	}
+}
+
+func TestRekey_WrongOldPassphrase(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	handle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := persistence.NewEncryptedPersistence(handle, oldPassphrase)
+
+	if err := encrypted.Save([]byte("current"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Rekey(dataDir, "wrong-passphrase", newPassphrase); err == nil {
+		t.Fatal("expected re-encryption failure")
+	}
+
+	if _, err := os.Stat(dataDir + ".rekey"); !os.IsNotExist(err) {
+		t.Errorf("staging directory should be removed")
+	}
+
+	dataChannel, errorChannel := encrypted.ReadAll()
+	for descriptor := range dataChannel {
+		content, err := descriptor.Content()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(content) != "current" {
+			t.Errorf("unexpected content [%s]", content)
+		}
+	}
+	for err := range errorChannel {
+		t.Fatal(err)
+	}
+}
+
+func TestRekey_AuditLog(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	auditLogPath := filepath.Join(dataDir, "audit.log")
+
+	auditLog, err := audit.Open(auditLogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auditLog.Record(audit.KeyGenerationStarted, common.Address{1}, [32]byte{}, nil)
+	auditLog.Close()
+
+	expectedContent, err := ioutil.ReadFile(auditLogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Rekey(dataDir, oldPassphrase, newPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Files != 0 {
+		t.Errorf("unexpected number of re-encrypted files: [%v]", result.Files)
+	}
+
+	actualContent, err := ioutil.ReadFile(auditLogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expectedContent, actualContent) {
+		t.Errorf("audit log has been modified")
+	}
+}
+
+// newDataDir creates a data directory within a temporary parent directory,
+// so the staging and previous data directories are removed on cleanup too.
+func newDataDir(t *testing.T) (string, func()) {
+	parentDir, err := ioutil.TempDir("", "rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataDir := filepath.Join(parentDir, "data")
+	if err := os.Mkdir(dataDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	return dataDir, func() { os.RemoveAll(parentDir) }
+}
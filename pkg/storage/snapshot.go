@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+// diskSnapshotDirectory is the directory within the data directory where the
+// disk persistence stores snapshots.
+const diskSnapshotDirectory = "snapshot"
+
+// NewDiskSnapshotHandle returns a read-only persistence handle reading
+// snapshots made by the disk persistence handle of the given data directory.
+// The disk persistence appends a timestamp suffix to names of snapshot files;
+// the suffix is stripped, so names are the same as names of saved files.
+func NewDiskSnapshotHandle(dataDir string) persistence.Handle {
+	snapshotDir := filepath.Join(dataDir, diskSnapshotDirectory)
+
+	return &readOnlyHandle{
+		readAll: func() (<-chan persistence.DataDescriptor, <-chan error) {
+			return readDiskDirectory(snapshotDir, stripSnapshotSuffix)
+		},
+	}
+}
+
+// stripSnapshotSuffix removes the `.<timestamp>` suffix from the name of
+// a snapshot file. Names without the suffix are returned unchanged.
+func stripSnapshotSuffix(name string) string {
+	separatorIndex := strings.LastIndex(name, ".")
+	if separatorIndex < 0 {
+		return name
+	}
+
+	if _, err := strconv.ParseInt(name[separatorIndex+1:], 10, 64); err != nil {
+		return name
+	}
+
+	return name[:separatorIndex]
+}
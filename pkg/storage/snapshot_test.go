@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+func TestDiskSnapshotHandle(t *testing.T) {
+	dataDir, cleanup := newDataDir(t)
+	defer cleanup()
+
+	handle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handle.Save([]byte("signer-1"), "keep-1", "/membership_1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := handle.Snapshot([]byte("signer-2"), "keep-2", "/membership_2"); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotHandle := NewDiskSnapshotHandle(dataDir)
+
+	assertContent(
+		t,
+		map[string]string{"keep-2membership_2": "signer-2"},
+		snapshotHandle,
+	)
+
+	if err := snapshotHandle.Snapshot([]byte("signer-3"), "keep-3", "/membership_3"); err == nil {
+		t.Errorf("expected snapshot failure")
+	}
+}
+
+func TestStripSnapshotSuffix(t *testing.T) {
+	var tests = map[string]struct {
+		name         string
+		expectedName string
+	}{
+		"timestamp suffix": {
+			name:         "membership_0123.1602849600000",
+			expectedName: "membership_0123",
+		},
+		"no suffix": {
+			name:         "membership_0123",
+			expectedName: "membership_0123",
+		},
+		"non-numeric suffix": {
+			name:         "membership_0123.tmp",
+			expectedName: "membership_0123.tmp",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			name := stripSnapshotSuffix(test.name)
+			if name != test.expectedName {
+				t.Errorf(
+					"unexpected name\nexpected: [%v]\nactual:   [%v]",
+					test.expectedName,
+					name,
+				)
+			}
+		})
+	}
+}
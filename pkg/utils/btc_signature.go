@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"bytes"
+	cecdsa "crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// WitnessSignature is a signature found in a witness of a P2WPKH transaction
+// input.
+//
+// Bitcoin signs a double SHA-256 hash of the BIP-143 sighash preimage. Digest
+// holds the signed hash and Preimage holds the single SHA-256 hash of the
+// sighash preimage, so that `sha256(Preimage) == Digest`.
+type WitnessSignature struct {
+	InputIndex int
+	PublicKey  *cecdsa.PublicKey
+	R          *big.Int
+	S          *big.Int
+	Digest     [32]byte
+	Preimage   []byte
+}
+
+// ExtractWitnessSignatures extracts signatures from witnesses of all P2WPKH
+// inputs of the given transaction which were signed with SIGHASH_ALL. Values
+// of the outputs spent by the transaction inputs have to be provided in the
+// order of the inputs, as they are committed to by the signatures.
+func ExtractWitnessSignatures(
+	msgTx *wire.MsgTx,
+	inputValues []int64,
+) ([]*WitnessSignature, error) {
+	if len(inputValues) != len(msgTx.TxIn) {
+		return nil, fmt.Errorf(
+			"expected values of [%d] inputs but got [%d]",
+			len(msgTx.TxIn),
+			len(inputValues),
+		)
+	}
+
+	signatures := make([]*WitnessSignature, 0)
+
+	for inputIndex, txIn := range msgTx.TxIn {
+		// P2WPKH witness consists of a signature and a compressed public key.
+		if len(txIn.Witness) != 2 ||
+			len(txIn.Witness[1]) != btcec.PubKeyBytesLenCompressed {
+			continue
+		}
+
+		rawSignature := txIn.Witness[0]
+		if len(rawSignature) == 0 ||
+			txscript.SigHashType(rawSignature[len(rawSignature)-1]) != txscript.SigHashAll {
+			continue
+		}
+
+		signature, err := btcec.ParseDERSignature(
+			rawSignature[:len(rawSignature)-1],
+			btcec.S256(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to parse signature of input [%d]: [%v]",
+				inputIndex,
+				err,
+			)
+		}
+
+		publicKey, err := btcec.ParsePubKey(txIn.Witness[1], btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to parse public key of input [%d]: [%v]",
+				inputIndex,
+				err,
+			)
+		}
+
+		sighashPreimage, err := witnessSighashPreimage(
+			msgTx,
+			inputIndex,
+			btcutil.Hash160(txIn.Witness[1]),
+			inputValues[inputIndex],
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to calculate sighash preimage of input [%d]: [%v]",
+				inputIndex,
+				err,
+			)
+		}
+
+		preimage := sha256.Sum256(sighashPreimage)
+
+		signatures = append(signatures, &WitnessSignature{
+			InputIndex: inputIndex,
+			PublicKey:  publicKey.ToECDSA(),
+			R:          signature.R,
+			S:          signature.S,
+			Digest:     sha256.Sum256(preimage[:]),
+			Preimage:   preimage[:],
+		})
+	}
+
+	return signatures, nil
+}
+
+// witnessSighashPreimage serializes the BIP-143 sighash preimage of the P2WPKH
+// input with the given index, for the SIGHASH_ALL type.
+func witnessSighashPreimage(
+	msgTx *wire.MsgTx,
+	inputIndex int,
+	publicKeyHash []byte,
+	inputValue int64,
+) ([]byte, error) {
+	var prevOuts, sequences, outputs bytes.Buffer
+
+	for _, txIn := range msgTx.TxIn {
+		prevOuts.Write(txIn.PreviousOutPoint.Hash[:])
+		if err := binary.Write(
+			&prevOuts,
+			binary.LittleEndian,
+			txIn.PreviousOutPoint.Index,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := binary.Write(
+			&sequences,
+			binary.LittleEndian,
+			txIn.Sequence,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, txOut := range msgTx.TxOut {
+		if err := wire.WriteTxOut(&outputs, 0, msgTx.Version, txOut); err != nil {
+			return nil, err
+		}
+	}
+
+	txIn := msgTx.TxIn[inputIndex]
+
+	// Script code of a P2WPKH input is the corresponding P2PKH script.
+	scriptCode, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).
+		AddData(publicKeyHash).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		return nil, err
+	}
+
+	var preimage bytes.Buffer
+
+	fields := []interface{}{
+		msgTx.Version,
+		chainhash.DoubleHashB(prevOuts.Bytes()),
+		chainhash.DoubleHashB(sequences.Bytes()),
+		txIn.PreviousOutPoint.Hash[:],
+		txIn.PreviousOutPoint.Index,
+	}
+	for _, field := range fields {
+		if err := binary.Write(&preimage, binary.LittleEndian, field); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := wire.WriteVarBytes(&preimage, 0, scriptCode); err != nil {
+		return nil, err
+	}
+
+	fields = []interface{}{
+		inputValue,
+		txIn.Sequence,
+		chainhash.DoubleHashB(outputs.Bytes()),
+		msgTx.LockTime,
+		uint32(txscript.SigHashAll),
+	}
+	for _, field := range fields {
+		if err := binary.Write(&preimage, binary.LittleEndian, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return preimage.Bytes(), nil
+}
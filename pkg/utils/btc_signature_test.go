@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"bytes"
+	cecdsa "crypto/ecdsa"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+func TestExtractWitnessSignatures(t *testing.T) {
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := privateKey.PubKey().SerializeCompressed()
+
+	scriptCode, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).
+		AddData(btcutil.Hash160(publicKey)).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputValues := []int64{10000, 25000}
+
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(
+		wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil,
+	))
+	msgTx.AddTxIn(wire.NewTxIn(
+		wire.NewOutPoint(&chainhash.Hash{2}, 3), nil, nil,
+	))
+	msgTx.AddTxOut(wire.NewTxOut(30000, []byte{0x00, 0x14, 0x01}))
+
+	sigHashes := txscript.NewTxSigHashes(msgTx)
+	for i, value := range inputValues {
+		signature, err := txscript.RawTxInWitnessSignature(
+			msgTx,
+			sigHashes,
+			i,
+			value,
+			scriptCode,
+			txscript.SigHashAll,
+			privateKey,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		msgTx.TxIn[i].Witness = wire.TxWitness{signature, publicKey}
+	}
+
+	signatures, err := ExtractWitnessSignatures(msgTx, inputValues)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signatures) != len(inputValues) {
+		t.Fatalf(
+			"unexpected number of signatures\nexpected: [%d]\nactual:   [%d]",
+			len(inputValues),
+			len(signatures),
+		)
+	}
+
+	for i, signature := range signatures {
+		if signature.InputIndex != i {
+			t.Errorf(
+				"unexpected input index\nexpected: [%d]\nactual:   [%d]",
+				i,
+				signature.InputIndex,
+			)
+		}
+
+		expectedDigest, err := txscript.CalcWitnessSigHash(
+			scriptCode,
+			sigHashes,
+			txscript.SigHashAll,
+			msgTx,
+			i,
+			inputValues[i],
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(expectedDigest, signature.Digest[:]) {
+			t.Errorf(
+				"unexpected digest\nexpected: [%x]\nactual:   [%x]",
+				expectedDigest,
+				signature.Digest,
+			)
+		}
+
+		preimageHash := sha256.Sum256(signature.Preimage)
+		if preimageHash != signature.Digest {
+			t.Errorf("preimage does not hash to the digest")
+		}
+
+		if !cecdsa.Verify(
+			signature.PublicKey,
+			signature.Digest[:],
+			signature.R,
+			signature.S,
+		) {
+			t.Errorf("invalid signature for input [%d]", i)
+		}
+	}
+}
+
+func TestExtractWitnessSignaturesInvalidInputValues(t *testing.T) {
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(
+		wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil,
+	))
+
+	_, err := ExtractWitnessSignatures(msgTx, []int64{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}